@@ -0,0 +1,262 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// ErrNoCurrentContext is returned when no current context is selected.
+var ErrNoCurrentContext = errors.New("no current admin context selected")
+
+// AdminContextTLS - TLS settings of an admin context.
+type AdminContextTLS struct {
+	// CAFile is a PEM bundle of CAs trusted in addition to the system roots.
+	CAFile string `json:"caFile,omitempty"`
+	// CertFile and KeyFile of a client certificate, if the server
+	// requires mutual TLS.
+	CertFile           string `json:"certFile,omitempty"`
+	KeyFile            string `json:"keyFile,omitempty"`
+	ServerName         string `json:"serverName,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// AdminContext - endpoint and credentials of a cluster.
+type AdminContext struct {
+	Endpoint     string           `json:"endpoint"`
+	Secure       bool             `json:"secure"`
+	AccessKey    string           `json:"accessKey"`
+	SecretKey    string           `json:"secretKey"`
+	SessionToken string           `json:"sessionToken,omitempty"`
+	ReadOnly     bool             `json:"readOnly,omitempty"`
+	TLS          *AdminContextTLS `json:"tls,omitempty"`
+}
+
+// AdminContexts - a contexts file listing clusters by name, similar
+// to a kubeconfig.
+type AdminContexts struct {
+	CurrentContext string                  `json:"currentContext"`
+	Contexts       map[string]AdminContext `json:"contexts"`
+}
+
+// DefaultAdminContextsPath - returns $MADMIN_CONTEXTS, or
+// ~/.madmin/contexts.json if unset.
+func DefaultAdminContextsPath() (string, error) {
+	if path := os.Getenv("MADMIN_CONTEXTS"); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".madmin", "contexts.json"), nil
+}
+
+// LoadAdminContexts - reads a contexts file, the default location is
+// used if path is empty. A missing file yields empty contexts.
+func LoadAdminContexts(path string) (*AdminContexts, error) {
+	if path == "" {
+		var err error
+		if path, err = DefaultAdminContextsPath(); err != nil {
+			return nil, err
+		}
+	}
+	c := &AdminContexts{Contexts: make(map[string]AdminContext)}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("unable to parse admin contexts %s: %w", path, err)
+	}
+	if c.Contexts == nil {
+		c.Contexts = make(map[string]AdminContext)
+	}
+	return c, nil
+}
+
+// Save - writes the contexts file atomically, readable by the owner only
+// since it holds credentials.
+func (c *AdminContexts) Save(path string) error {
+	if path == "" {
+		var err error
+		if path, err = DefaultAdminContextsPath(); err != nil {
+			return err
+		}
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Names - returns the sorted context names.
+func (c *AdminContexts) Names() []string {
+	names := make([]string, 0, len(c.Contexts))
+	for name := range c.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetContext - adds or replaces a context.
+func (c *AdminContexts) SetContext(name string, ctx AdminContext) error {
+	if name == "" {
+		return ErrInvalidArgument("Context name cannot be empty.")
+	}
+	if ctx.Endpoint == "" {
+		return ErrInvalidArgument("Context endpoint cannot be empty.")
+	}
+	if c.Contexts == nil {
+		c.Contexts = make(map[string]AdminContext)
+	}
+	c.Contexts[name] = ctx
+	return nil
+}
+
+// DeleteContext - removes a context, unselecting it if it is current.
+func (c *AdminContexts) DeleteContext(name string) {
+	delete(c.Contexts, name)
+	if c.CurrentContext == name {
+		c.CurrentContext = ""
+	}
+}
+
+// SwitchContext - selects the current context.
+func (c *AdminContexts) SwitchContext(name string) error {
+	if _, ok := c.Contexts[name]; !ok {
+		return ErrInvalidArgument("Admin context " + name + " not found.")
+	}
+	c.CurrentContext = name
+	return nil
+}
+
+// Current - returns the name and settings of the current context.
+func (c *AdminContexts) Current() (string, AdminContext, error) {
+	if c.CurrentContext == "" {
+		return "", AdminContext{}, ErrNoCurrentContext
+	}
+	ctx, ok := c.Contexts[c.CurrentContext]
+	if !ok {
+		return "", AdminContext{}, ErrInvalidArgument("Current admin context " + c.CurrentContext + " not found.")
+	}
+	return c.CurrentContext, ctx, nil
+}
+
+// NewAdminClient - returns an admin client for the current context.
+func (c *AdminContexts) NewAdminClient() (*AdminClient, error) {
+	_, ctx, err := c.Current()
+	if err != nil {
+		return nil, err
+	}
+	return ctx.NewAdminClient()
+}
+
+// NewAdminClient - returns an admin client for the context. TLS settings
+// require DefaultTransport to return an *http.Transport.
+func (c AdminContext) NewAdminClient() (*AdminClient, error) {
+	var options []Option
+	if c.Secure && c.TLS != nil {
+		cfg, err := c.TLS.config()
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, func(adm *AdminClient) error {
+			return adm.updateTransport("TLS", func(tr *http.Transport) error {
+				tr.TLSClientConfig = cfg
+				return nil
+			})
+		})
+	}
+	return NewWithOptions(c.Endpoint, &Options{
+		Creds:    credentials.NewStaticV4(c.AccessKey, c.SecretKey, c.SessionToken),
+		Secure:   c.Secure,
+		ReadOnly: c.ReadOnly,
+	}, options...)
+}
+
+func (t AdminContextTLS) config() (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         t.ServerName,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+	if t.CAFile != "" {
+		pem, err := ioutil.ReadFile(t.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", t.CAFile)
+		}
+		cfg.RootCAs = pool
+	}
+	if t.CertFile != "" || t.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// SwitchAdminContext - selects the current context in the contexts
+// file at path, the default location is used if path is empty.
+func SwitchAdminContext(path, name string) error {
+	if path == "" {
+		var err error
+		if path, err = DefaultAdminContextsPath(); err != nil {
+			return err
+		}
+	}
+	c, err := LoadAdminContexts(path)
+	if err != nil {
+		return err
+	}
+	if err = c.SwitchContext(name); err != nil {
+		return err
+	}
+	return c.Save(path)
+}
@@ -0,0 +1,108 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAdminContexts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "admin-contexts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "nested", "contexts.json")
+
+	c, err := LoadAdminContexts(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = c.Current(); err != ErrNoCurrentContext {
+		t.Fatalf("expected ErrNoCurrentContext, got %v", err)
+	}
+	if err = c.SetContext("prod", AdminContext{Endpoint: "minio.prod:9000", Secure: true, AccessKey: "a", SecretKey: "b"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.SetContext("dev", AdminContext{Endpoint: "localhost:9000", AccessKey: "a", SecretKey: "b", ReadOnly: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.SwitchContext("staging"); err == nil {
+		t.Fatal("expected unknown context to be rejected")
+	}
+	if err = c.SwitchContext("prod"); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = SwitchAdminContext(path, "dev"); err != nil {
+		t.Fatal(err)
+	}
+	c, err = LoadAdminContexts(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := c.Names(); len(names) != 2 || names[0] != "dev" {
+		t.Errorf("unexpected names %v", names)
+	}
+	adm, err := c.NewAdminClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adm.endpointURL.Host != "localhost:9000" || adm.secure || !adm.IsReadOnly() {
+		t.Errorf("unexpected client for dev context %s", adm.endpointURL)
+	}
+
+	c.DeleteContext("dev")
+	if c.CurrentContext != "" {
+		t.Error("deleting the current context must unselect it")
+	}
+}
+
+func TestAdminContextTLS(t *testing.T) {
+	c := AdminContext{
+		Endpoint:  "minio.example.com:9000",
+		AccessKey: "minioadmin",
+		SecretKey: "minioadmin",
+		Secure:    true,
+		TLS:       &AdminContextTLS{ServerName: "minio.internal"},
+	}
+	adm, err := c.NewAdminClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr, ok := adm.httpClient.Transport.(*http.Transport)
+	if !ok || tr.TLSClientConfig == nil || tr.TLSClientConfig.ServerName != "minio.internal" {
+		t.Errorf("expected the TLS settings to be applied, got %v", adm.httpClient.Transport)
+	}
+
+	// TLS settings are never silently dropped.
+	defaultTransport := DefaultTransport
+	defer func() { DefaultTransport = defaultTransport }()
+	DefaultTransport = func(secure bool) http.RoundTripper {
+		return roundTripperFunc(http.DefaultTransport.RoundTrip)
+	}
+	if _, err = c.NewAdminClient(); err == nil {
+		t.Error("expected an error for a transport other than *http.Transport")
+	}
+}
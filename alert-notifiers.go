@@ -0,0 +1,216 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// AlertNotifier delivers remediation alerts to an external system.
+type AlertNotifier interface {
+	Notify(ctx context.Context, alert RemediationAlert) error
+}
+
+// NotifyAction - remediation action forwarding the alert to all
+// notifiers, the first delivery error is returned.
+func NotifyAction(notifiers ...AlertNotifier) RemediationAction {
+	return func(ctx context.Context, alert RemediationAlert) error {
+		var firstErr error
+		for _, n := range notifiers {
+			if err := n.Notify(ctx, alert); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		return firstErr
+	}
+}
+
+func alertTitle(alert RemediationAlert) string {
+	title := "MinIO alert: " + alert.Rule
+	if alert.DryRun {
+		title += " (dry-run)"
+	}
+	return title
+}
+
+func postAlertJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("alert delivery to %s failed with status %s", req.URL.Host, resp.Status)
+	}
+	return nil
+}
+
+// SlackNotifier posts alerts to a Slack incoming webhook.
+type SlackNotifier struct {
+	WebhookURL string
+	// ConsoleURL is linked from every message, if set.
+	ConsoleURL string
+	HTTPClient *http.Client
+}
+
+// Notify - implements AlertNotifier.
+func (s SlackNotifier) Notify(ctx context.Context, alert RemediationAlert) error {
+	text := "*" + alertTitle(alert) + "*\n" + alert.Detail +
+		"\nFiring since " + alert.FiringSince.UTC().Format(time.RFC3339)
+	if s.ConsoleURL != "" {
+		text += "\n<" + s.ConsoleURL + "|Open MinIO Console>"
+	}
+	return postAlertJSON(ctx, s.HTTPClient, s.WebhookURL, map[string]string{"text": text})
+}
+
+// TeamsNotifier posts alerts to a Microsoft Teams incoming webhook.
+type TeamsNotifier struct {
+	WebhookURL string
+	// ConsoleURL is linked from every message, if set.
+	ConsoleURL string
+	HTTPClient *http.Client
+}
+
+type teamsTarget struct {
+	OS  string `json:"os"`
+	URI string `json:"uri"`
+}
+
+type teamsAction struct {
+	Type    string        `json:"@type"`
+	Name    string        `json:"name"`
+	Targets []teamsTarget `json:"targets"`
+}
+
+type teamsMessageCard struct {
+	Type            string        `json:"@type"`
+	Context         string        `json:"@context"`
+	Summary         string        `json:"summary"`
+	ThemeColor      string        `json:"themeColor"`
+	Title           string        `json:"title"`
+	Text            string        `json:"text"`
+	PotentialAction []teamsAction `json:"potentialAction,omitempty"`
+}
+
+// Notify - implements AlertNotifier.
+func (t TeamsNotifier) Notify(ctx context.Context, alert RemediationAlert) error {
+	card := teamsMessageCard{
+		Type:       "MessageCard",
+		Context:    "https://schema.org/extensions",
+		Summary:    alertTitle(alert),
+		ThemeColor: "C4314B",
+		Title:      alertTitle(alert),
+		Text:       alert.Detail + "<br>Firing since " + alert.FiringSince.UTC().Format(time.RFC3339),
+	}
+	if t.ConsoleURL != "" {
+		card.PotentialAction = []teamsAction{{
+			Type:    "OpenUri",
+			Name:    "Open MinIO Console",
+			Targets: []teamsTarget{{OS: "default", URI: t.ConsoleURL}},
+		}}
+	}
+	return postAlertJSON(ctx, t.HTTPClient, t.WebhookURL, card)
+}
+
+// PagerDutyEventsURL is the PagerDuty Events API v2 endpoint.
+const PagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// PagerDutyNotifier triggers incidents through the PagerDuty Events API v2,
+// alerts of the same rule are deduplicated into one incident.
+type PagerDutyNotifier struct {
+	RoutingKey string
+	// Severity is one of critical, error, warning or info, defaults to error.
+	Severity string
+	// Source identifies the cluster, e.g. its endpoint.
+	Source string
+	// ConsoleURL is linked from every incident, if set.
+	ConsoleURL string
+	// EventsURL defaults to PagerDutyEventsURL.
+	EventsURL  string
+	HTTPClient *http.Client
+}
+
+type pagerDutyLink struct {
+	Href string `json:"href"`
+	Text string `json:"text"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string           `json:"summary"`
+	Source        string           `json:"source"`
+	Severity      string           `json:"severity"`
+	Timestamp     string           `json:"timestamp"`
+	CustomDetails RemediationAlert `json:"custom_details"`
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	DedupKey    string           `json:"dedup_key"`
+	Payload     pagerDutyPayload `json:"payload"`
+	Links       []pagerDutyLink  `json:"links,omitempty"`
+}
+
+// Notify - implements AlertNotifier.
+func (p PagerDutyNotifier) Notify(ctx context.Context, alert RemediationAlert) error {
+	severity := strings.ToLower(p.Severity)
+	if severity == "" {
+		severity = "error"
+	}
+	source := p.Source
+	if source == "" {
+		source = "minio"
+	}
+	eventsURL := p.EventsURL
+	if eventsURL == "" {
+		eventsURL = PagerDutyEventsURL
+	}
+	event := pagerDutyEvent{
+		RoutingKey:  p.RoutingKey,
+		EventAction: "trigger",
+		DedupKey:    source + "/" + alert.Rule,
+		Payload: pagerDutyPayload{
+			Summary:       alertTitle(alert) + ": " + alert.Detail,
+			Source:        source,
+			Severity:      severity,
+			Timestamp:     alert.Time.UTC().Format(time.RFC3339),
+			CustomDetails: alert,
+		},
+	}
+	if p.ConsoleURL != "" {
+		event.Links = []pagerDutyLink{{Href: p.ConsoleURL, Text: "Open MinIO Console"}}
+	}
+	return postAlertJSON(ctx, p.HTTPClient, eventsURL, event)
+}
@@ -0,0 +1,76 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAlertNotifiers(t *testing.T) {
+	var bodies []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		bodies = append(bodies, body)
+		if r.URL.Path == "/pagerduty" {
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer ts.Close()
+
+	alert := RemediationAlert{
+		Rule:        "backlog",
+		Detail:      "heal backlog is 200 buckets, threshold 100",
+		FiringSince: time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC),
+		Time:        time.Date(2021, 5, 1, 0, 10, 0, 0, time.UTC),
+	}
+	action := NotifyAction(
+		SlackNotifier{WebhookURL: ts.URL + "/slack", ConsoleURL: "https://console"},
+		TeamsNotifier{WebhookURL: ts.URL + "/teams"},
+		PagerDutyNotifier{RoutingKey: "key", Source: "cluster1", EventsURL: ts.URL + "/pagerduty"},
+	)
+	if err := action(context.Background(), alert); err != nil {
+		t.Fatal(err)
+	}
+	if len(bodies) != 3 {
+		t.Fatalf("expected 3 deliveries, got %d", len(bodies))
+	}
+	if text := bodies[0]["text"].(string); text != "*MinIO alert: backlog*\nheal backlog is 200 buckets, threshold 100\nFiring since 2021-05-01T00:00:00Z\n<https://console|Open MinIO Console>" {
+		t.Errorf("unexpected slack text %q", text)
+	}
+	if bodies[1]["@type"] != "MessageCard" {
+		t.Errorf("unexpected teams card %v", bodies[1])
+	}
+	if bodies[2]["dedup_key"] != "cluster1/backlog" || bodies[2]["event_action"] != "trigger" {
+		t.Errorf("unexpected pagerduty event %v", bodies[2])
+	}
+
+	failing := SlackNotifier{WebhookURL: ts.URL + "/slack"}
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	if err := failing.Notify(context.Background(), alert); err == nil {
+		t.Fatal("expected delivery error")
+	}
+}
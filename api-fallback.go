@@ -0,0 +1,155 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+)
+
+// apiFallback - an older API equivalent to a current one.
+type apiFallback struct {
+	relPath string
+	// convert translates the older response body to the current
+	// format, nil if both are the same.
+	convert func(body []byte) ([]byte, error)
+}
+
+// apiFallbacks - older equivalents of APIs, keyed by HTTP method and
+// path relative to the admin prefix.
+var apiFallbacks = map[string]apiFallback{
+	http.MethodGet + " " + adminAPIPrefix + "/storageinfo": {
+		relPath: adminAPIPrefixV2 + "/storageinfo",
+		convert: convertStorageInfoV2,
+	},
+	http.MethodGet + " " + adminAPIPrefix + "/datausageinfo": {
+		relPath: adminAPIPrefixV2 + "/datausageinfo",
+	},
+}
+
+// SetAPIFallback - enables or disables retrying the older equivalent
+// of an API the server does not support, so that a client can manage
+// servers running older releases. Enabled by default.
+func (adm *AdminClient) SetAPIFallback(enabled bool) {
+	adm.noAPIFallback = !enabled
+}
+
+// apiFallbackFor - returns the fallback of a request the server
+// rejected as unknown or not implemented.
+func (adm AdminClient) apiFallbackFor(method string, reqData requestData, statusCode int, errCode string) (apiFallback, bool) {
+	if adm.noAPIFallback {
+		return apiFallback{}, false
+	}
+	if statusCode != http.StatusNotFound && statusCode != http.StatusNotImplemented && errCode != "NotImplemented" {
+		return apiFallback{}, false
+	}
+	fallback, ok := apiFallbacks[method+" "+reqData.relPath]
+	return fallback, ok
+}
+
+// executeFallback - executes the older equivalent of a request and
+// converts a successful response to the current format.
+func (adm AdminClient) executeFallback(ctx context.Context, method string, reqData requestData, fallback apiFallback) (*http.Response, error) {
+	// The current API passed the read-only check, so does its
+	// equivalent.
+	adm.readOnly = false
+	reqData.relPath = fallback.relPath
+	resp, err := adm.executeMethod(ctx, method, reqData)
+	if err != nil || resp.StatusCode != http.StatusOK || fallback.convert == nil {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	if body, err = fallback.convert(body); err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+	return resp, nil
+}
+
+// storageInfoV2 - StorageInfo as returned by the v2 API, with per drive
+// usage in parallel slices.
+type storageInfoV2 struct {
+	Used       []uint64
+	Total      []uint64
+	Available  []uint64
+	MountPaths []string
+
+	Backend struct {
+		Type             BackendType
+		GatewayOnline    bool
+		OnlineDisks      BackendDisks
+		OfflineDisks     BackendDisks
+		StandardSCData   int
+		StandardSCParity int
+		RRSCData         int
+		RRSCParity       int
+	}
+}
+
+func convertStorageInfoV2(body []byte) ([]byte, error) {
+	var v2 storageInfoV2
+	if err := json.Unmarshal(body, &v2); err != nil {
+		return nil, err
+	}
+
+	// v2 only counts offline drives per endpoint, so drives are known to
+	// be ok only when none is offline.
+	var state string
+	if v2.Backend.OfflineDisks.Sum() == 0 {
+		state = "ok"
+	}
+
+	var info StorageInfo
+	for i, path := range v2.MountPaths {
+		disk := Disk{DrivePath: path, State: state}
+		if i < len(v2.Total) {
+			disk.TotalSpace = v2.Total[i]
+		}
+		if i < len(v2.Used) {
+			disk.UsedSpace = v2.Used[i]
+		}
+		if i < len(v2.Available) {
+			disk.AvailableSpace = v2.Available[i]
+		}
+		info.Disks = append(info.Disks, disk)
+	}
+	info.Backend = BackendInfo{
+		Type:             v2.Backend.Type,
+		GatewayOnline:    v2.Backend.GatewayOnline,
+		OnlineDisks:      v2.Backend.OnlineDisks,
+		OfflineDisks:     v2.Backend.OfflineDisks,
+		StandardSCParity: v2.Backend.StandardSCParity,
+		RRSCParity:       v2.Backend.RRSCParity,
+	}
+	if v2.Backend.StandardSCData > 0 {
+		info.Backend.StandardSCData = []int{v2.Backend.StandardSCData}
+	}
+	if v2.Backend.RRSCData > 0 {
+		info.Backend.RRSCData = []int{v2.Backend.RRSCData}
+	}
+	return json.Marshal(info)
+}
@@ -0,0 +1,94 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestStorageInfoFallbackV2(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path != libraryAdminURLPrefix+adminAPIPrefixV2+"/storageinfo" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"Used":[10,20],"Total":[100,200],"Available":[90,180],"MountPaths":["/d1","/d2"],
+"Backend":{"Type":2,"OnlineDisks":{"localhost:9000":2},"StandardSCData":1,"StandardSCParity":1}}`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := adm.StorageInfo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 2 {
+		t.Fatalf("expected the v3 and v2 requests, got %v", paths)
+	}
+	if len(info.Disks) != 2 || info.Disks[1].DrivePath != "/d2" || info.Disks[1].TotalSpace != 200 || info.Disks[1].AvailableSpace != 180 {
+		t.Errorf("unexpected disks %+v", info.Disks)
+	}
+	if info.Backend.Type != Erasure || len(info.Backend.StandardSCData) != 1 || info.Backend.OnlineDisks.Sum() != 2 {
+		t.Errorf("unexpected backend %+v", info.Backend)
+	}
+	if info.Disks[0].State != "ok" || info.Disks[1].State != "ok" {
+		t.Errorf("expected ok drives, got %+v", info.Disks)
+	}
+
+	paths = nil
+	adm.SetAPIFallback(false)
+	if _, err = adm.StorageInfo(context.Background()); err == nil {
+		t.Error("expected an error with the fallback disabled")
+	}
+	if len(paths) != 1 {
+		t.Errorf("expected only the v3 request, got %v", paths)
+	}
+}
+
+func TestConvertStorageInfoV2OfflineDisk(t *testing.T) {
+	body, err := convertStorageInfoV2([]byte(`{"Used":[10,0],"Total":[100,0],"Available":[90,0],"MountPaths":["/d1","/d2"],
+"Backend":{"Type":2,"OnlineDisks":{"localhost:9000":1},"OfflineDisks":{"localhost:9000":1}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var info StorageInfo
+	if err = json.Unmarshal(body, &info); err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Disks) != 2 {
+		t.Fatalf("unexpected disks %+v", info.Disks)
+	}
+	for _, disk := range info.Disks {
+		if disk.State != "" {
+			t.Errorf("expected an unknown state for %s, got %q", disk.DrivePath, disk.State)
+		}
+	}
+	if info.Backend.OfflineDisks.Sum() != 1 {
+		t.Errorf("unexpected backend %+v", info.Backend)
+	}
+}
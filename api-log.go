@@ -18,8 +18,6 @@ package madmin
 
 import (
 	"context"
-	"encoding/json"
-	"net/http"
 	"net/url"
 	"strconv"
 )
@@ -32,6 +30,39 @@ type LogInfo struct {
 	Err        error  `json:"-"`
 }
 
+// LogStream - console log messages pulled from the server,
+// reconnecting when the server ends the response.
+type LogStream struct {
+	responseStream
+	item LogInfo
+}
+
+// Next - advances to the next log message.
+func (s *LogStream) Next() bool {
+	s.item = LogInfo{}
+	return s.next(&s.item)
+}
+
+// Item - returns the current log message.
+func (s *LogStream) Item() LogInfo {
+	return s.item
+}
+
+// LogStream - returns a stream of console log messages, which are only
+// received as fast as they are consumed. Close the stream when done.
+func (adm AdminClient) LogStream(ctx context.Context, node string, lineCnt int, logKind string) *LogStream {
+	urlValues := make(url.Values)
+	urlValues.Set("node", node)
+	urlValues.Set("limit", strconv.Itoa(lineCnt))
+	urlValues.Set("logType", logKind)
+	return &LogStream{responseStream: newResponseStream(ctx, adm, requestData{
+		relPath:           adminAPIPrefix + "/log",
+		queryValues:       urlValues,
+		unboundedResponse: true,
+		messageStream:     true,
+	}, true)}
+}
+
 // GetLogs - listen on console log messages.
 func (adm AdminClient) GetLogs(ctx context.Context, node string, lineCnt int, logKind string) <-chan LogInfo {
 	logCh := make(chan LogInfo, 1)
@@ -39,39 +70,17 @@ func (adm AdminClient) GetLogs(ctx context.Context, node string, lineCnt int, lo
 	// Only success, start a routine to start reading line by line.
 	go func(logCh chan<- LogInfo) {
 		defer close(logCh)
-		urlValues := make(url.Values)
-		urlValues.Set("node", node)
-		urlValues.Set("limit", strconv.Itoa(lineCnt))
-		urlValues.Set("logType", logKind)
-		for {
-			reqData := requestData{
-				relPath:     adminAPIPrefix + "/log",
-				queryValues: urlValues,
-			}
-			// Execute GET to call log handler
-			resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
-			if err != nil {
-				closeResponse(resp)
-				return
-			}
-
-			if resp.StatusCode != http.StatusOK {
-				logCh <- LogInfo{Err: httpRespToErrorResponse(resp)}
+		s := adm.LogStream(ctx, node, lineCnt, logKind)
+		defer s.Close()
+		for s.Next() {
+			select {
+			case <-ctx.Done():
 				return
+			case logCh <- s.Item():
 			}
-			dec := json.NewDecoder(resp.Body)
-			for {
-				var info LogInfo
-				if err = dec.Decode(&info); err != nil {
-					break
-				}
-				select {
-				case <-ctx.Done():
-					return
-				case logCh <- info:
-				}
-			}
-
+		}
+		if ctx.Err() == nil {
+			logCh <- LogInfo{Err: s.Err()}
 		}
 	}(logCh)
 
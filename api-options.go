@@ -0,0 +1,203 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// Option - configures the client constructed by New or NewWithOptions,
+// options are applied in order.
+type Option func(*AdminClient) error
+
+// Signer - signs requests, with the signature of signer.SignV4.
+type Signer func(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string) *http.Request
+
+// WithTransport - sends the requests of the client with transport,
+// e.g. one with a proxy or client certificates, instead of
+// DefaultTransport.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(adm *AdminClient) error {
+		if transport == nil {
+			return ErrInvalidArgument("Transport cannot be nil.")
+		}
+		adm.httpClient.Transport = transport
+		return nil
+	}
+}
+
+// WithDialContext - opens the connections of the client with dial,
+// e.g. to connect through a unix socket. The transport of the client
+// must be an *http.Transport, which is copied rather than modified.
+func WithDialContext(dial func(ctx context.Context, network, addr string) (net.Conn, error)) Option {
+	return func(adm *AdminClient) error {
+		if dial == nil {
+			return ErrInvalidArgument("DialContext cannot be nil.")
+		}
+		return adm.updateTransport("DialContext", func(tr *http.Transport) error {
+			tr.DialContext = dial
+			return nil
+		})
+	}
+}
+
+// WithMaxIdleConnsPerHost - keeps at most n idle connections per host
+// open for reuse, 1024 by default. The transport of the client must be
+// an *http.Transport.
+func WithMaxIdleConnsPerHost(n int) Option {
+	return func(adm *AdminClient) error {
+		if n <= 0 {
+			return ErrInvalidArgument("MaxIdleConnsPerHost must be positive.")
+		}
+		return adm.updateTransport("MaxIdleConnsPerHost", func(tr *http.Transport) error {
+			tr.MaxIdleConnsPerHost = n
+			if tr.MaxIdleConns > 0 && tr.MaxIdleConns < n {
+				tr.MaxIdleConns = n
+			}
+			return nil
+		})
+	}
+}
+
+// WithIdleConnTimeout - closes connections idle for longer than timeout,
+// 60 seconds by default, zero keeps them open. The transport of the
+// client must be an *http.Transport.
+func WithIdleConnTimeout(timeout time.Duration) Option {
+	return func(adm *AdminClient) error {
+		if timeout < 0 {
+			return ErrInvalidArgument("IdleConnTimeout cannot be negative.")
+		}
+		return adm.updateTransport("IdleConnTimeout", func(tr *http.Transport) error {
+			tr.IdleConnTimeout = timeout
+			return nil
+		})
+	}
+}
+
+// HTTPVersion - HTTP protocol version of the requests of the client.
+type HTTPVersion int
+
+// HTTP protocol versions, HTTPVersionAuto uses HTTP/1.1 with the
+// default transport.
+const (
+	HTTPVersionAuto HTTPVersion = iota
+	HTTPVersion1
+	HTTPVersion2
+)
+
+// WithHTTPVersion - sends requests over HTTP/1.1 or HTTP/2. HTTP/2
+// multiplexes concurrent requests over a single connection and requires
+// TLS. The transport of the client must be an *http.Transport.
+func WithHTTPVersion(version HTTPVersion) Option {
+	return func(adm *AdminClient) error {
+		return adm.updateTransport("HTTPVersion", func(tr *http.Transport) error {
+			switch version {
+			case HTTPVersionAuto:
+			case HTTPVersion1:
+				// A non-nil empty map disables HTTP/2.
+				tr.ForceAttemptHTTP2 = false
+				tr.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+			case HTTPVersion2:
+				if !adm.secure {
+					return ErrInvalidArgument("HTTP/2 requires TLS.")
+				}
+				tr.ForceAttemptHTTP2 = true
+				tr.TLSNextProto = nil
+			default:
+				return ErrInvalidArgument("Unknown HTTP version.")
+			}
+			return nil
+		})
+	}
+}
+
+// updateTransport - replaces the transport of the client by a copy
+// modified by update, the transport may be shared and is not modified.
+func (adm *AdminClient) updateTransport(option string, update func(tr *http.Transport) error) error {
+	tr, ok := adm.httpClient.Transport.(*http.Transport)
+	if !ok {
+		return ErrInvalidArgument(option + " requires an *http.Transport.")
+	}
+	tr = tr.Clone()
+	if err := update(tr); err != nil {
+		return err
+	}
+	adm.httpClient.Transport = tr
+	return nil
+}
+
+// WithCredentials - signs requests with creds instead of the keys
+// passed to New, e.g. temporary credentials of an STS provider which
+// are refreshed as they expire.
+func WithCredentials(creds *credentials.Credentials) Option {
+	return func(adm *AdminClient) error {
+		if creds == nil {
+			return ErrInvalidArgument("Credentials cannot be nil.")
+		}
+		adm.credsProvider = creds
+		return nil
+	}
+}
+
+// WithCredentialsProviders - signs requests with the credentials of the
+// first of providers returning any, e.g. of environment variables,
+// an mc configuration file or STS AssumeRole. Credentials are retrieved
+// again once the provider reports them expired.
+func WithCredentialsProviders(providers ...credentials.Provider) Option {
+	return func(adm *AdminClient) error {
+		if len(providers) == 0 {
+			return ErrInvalidArgument("Credentials providers cannot be empty.")
+		}
+		adm.credsProvider = credentials.NewChainCredentials(providers)
+		return nil
+	}
+}
+
+// WithRegion - signs requests for region, empty by default.
+func WithRegion(region string) Option {
+	return func(adm *AdminClient) error {
+		adm.region = region
+		return nil
+	}
+}
+
+// WithCustomSigner - signs requests with sign instead of signer.SignV4,
+// e.g. to delegate signing to a service holding the secret key.
+func WithCustomSigner(sign Signer) Option {
+	return func(adm *AdminClient) error {
+		if sign == nil {
+			return ErrInvalidArgument("Signer cannot be nil.")
+		}
+		adm.sign = sign
+		return nil
+	}
+}
+
+// WithUserAgentSuffix - appends suffix to the User-Agent of requests,
+// after the application details of SetAppInfo.
+func WithUserAgentSuffix(suffix string) Option {
+	return func(adm *AdminClient) error {
+		adm.userAgentSuffix = suffix
+		return nil
+	}
+}
@@ -0,0 +1,218 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestNewOptions(t *testing.T) {
+	var userAgent, auth string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent, auth = r.Header.Get("User-Agent"), r.Header.Get("Authorization")
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+
+	var dialed []string
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialed = append(dialed, addr)
+		var d net.Dialer
+		return d.DialContext(ctx, network, u.Host)
+	}
+	var region string
+	sign := func(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string) *http.Request {
+		region = location
+		req.Header.Set("Authorization", "custom "+accessKeyID)
+		return &req
+	}
+	// The endpoint is only reachable through the dialer.
+	adm, err := New("admin.invalid:9000", "minioadmin", "minioadmin", false,
+		WithDialContext(dial), WithRegion("eu-west-1"), WithCustomSigner(sign), WithUserAgentSuffix("app/1.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm.SetAppInfo("mc", "2.0")
+	resp, err := adm.executeMethod(context.Background(), http.MethodGet, requestData{relPath: adminAPIPrefix + "/options"})
+	closeResponse(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dialed) != 1 || dialed[0] != "admin.invalid:9000" {
+		t.Errorf("expected the connection to be dialed with the option, got %v", dialed)
+	}
+	if region != "eu-west-1" || auth != "custom minioadmin" {
+		t.Errorf("expected a custom signature of eu-west-1, got %q of %q", auth, region)
+	}
+	if !strings.HasSuffix(userAgent, " mc/2.0 app/1.0") {
+		t.Errorf("unexpected User-Agent %q", userAgent)
+	}
+	if DefaultTransport(false).(*http.Transport).DialContext == nil {
+		t.Error("expected the default transport to be left unchanged")
+	}
+
+	var transported bool
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		transported = true
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	adm, err = NewWithOptions(u.Host, &Options{Creds: adm.credsProvider}, WithTransport(transport))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = adm.executeMethod(context.Background(), http.MethodGet, requestData{relPath: adminAPIPrefix + "/options"})
+	closeResponse(resp)
+	if err != nil || !transported {
+		t.Errorf("expected the request to use the transport, got %v", err)
+	}
+
+	if _, err = New(u.Host, "minioadmin", "minioadmin", false, WithTransport(transport), WithDialContext(dial)); err == nil {
+		t.Error("expected an error dialing with a transport other than *http.Transport")
+	}
+	if _, err = New(u.Host, "minioadmin", "minioadmin", false, WithCustomSigner(nil)); err == nil {
+		t.Error("expected an error for a nil signer")
+	}
+}
+
+// stsProvider hands out a new session token on every retrieval.
+type stsProvider struct {
+	retrieved int32
+}
+
+func (p *stsProvider) Retrieve() (credentials.Value, error) {
+	n := atomic.AddInt32(&p.retrieved, 1)
+	return credentials.Value{AccessKeyID: "sts", SecretAccessKey: "secret", SessionToken: "token" + string(rune('0'+n))}, nil
+}
+
+func (p *stsProvider) IsExpired() bool {
+	return false
+}
+
+func TestWithCredentialsProviders(t *testing.T) {
+	var tokens []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("X-Amz-Security-Token")
+		tokens = append(tokens, token)
+		if token != "token2" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"Code":"ExpiredToken","Message":"The provided token has expired."}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+
+	// Sends the session token the request is signed with.
+	sign := WithCustomSigner(func(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string) *http.Request {
+		req.Header.Set("X-Amz-Security-Token", sessionToken)
+		return &req
+	})
+	sts := &stsProvider{}
+	adm, err := NewWithOptions(u.Host, &Options{}, WithCredentialsProviders(&credentials.Static{}, sts), sign)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm.SetRetryPolicy(RetryPolicy{MaxRetry: 1})
+	resp, err := adm.executeMethod(context.Background(), http.MethodGet, requestData{relPath: adminAPIPrefix + "/creds"})
+	closeResponse(resp)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the request to succeed with refreshed credentials, got %v", err)
+	}
+	if len(tokens) != 2 || tokens[0] != "token1" || atomic.LoadInt32(&sts.retrieved) != 2 {
+		t.Errorf("expected a single refresh, got tokens %v", tokens)
+	}
+
+	// Credentials are refreshed only once per call.
+	tokens = nil
+	adm, _ = New(u.Host, "", "", false, WithCredentials(credentials.New(&stsProvider{retrieved: 5})), sign)
+	resp, _ = adm.executeMethod(context.Background(), http.MethodGet, requestData{relPath: adminAPIPrefix + "/creds"})
+	closeResponse(resp)
+	if resp == nil || resp.StatusCode != http.StatusForbidden || len(tokens) != 2 {
+		t.Errorf("expected the expired token to be returned after one refresh, got %v", tokens)
+	}
+
+	if _, err = New(u.Host, "", "", false, WithCredentialsProviders()); err == nil {
+		t.Error("expected an error without providers")
+	}
+}
+
+func TestTransportOptions(t *testing.T) {
+	var protos []int
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		protos = append(protos, r.ProtoMajor)
+		w.Write([]byte(`{}`))
+	}))
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ts.Certificate())
+	tr := DefaultTransport(true).(*http.Transport)
+	tr.TLSClientConfig.RootCAs = roots
+
+	for _, version := range []HTTPVersion{HTTPVersionAuto, HTTPVersion1, HTTPVersion2} {
+		adm, err := New(u.Host, "minioadmin", "minioadmin", true, WithTransport(tr), WithHTTPVersion(version),
+			WithMaxIdleConnsPerHost(2048), WithIdleConnTimeout(time.Minute))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := adm.executeMethod(context.Background(), http.MethodGet, requestData{relPath: adminAPIPrefix + "/proto"})
+		closeResponse(resp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pooled := adm.httpClient.Transport.(*http.Transport)
+		if pooled == tr || pooled.MaxIdleConnsPerHost != 2048 || pooled.MaxIdleConns != 2048 || pooled.IdleConnTimeout != time.Minute {
+			t.Errorf("expected a copy of the transport with the pool options, got %+v", pooled)
+		}
+	}
+	if len(protos) != 3 || protos[0] != 1 || protos[1] != 1 || protos[2] != 2 {
+		t.Errorf("expected HTTP/1.1 unless HTTP/2 is forced, got %v", protos)
+	}
+	if tr.ForceAttemptHTTP2 || tr.MaxIdleConnsPerHost != 1024 {
+		t.Error("expected the passed transport to be left unchanged")
+	}
+
+	if _, err := New(u.Host, "minioadmin", "minioadmin", false, WithHTTPVersion(HTTPVersion2)); err == nil {
+		t.Error("expected an error forcing HTTP/2 without TLS")
+	}
+	transport := roundTripperFunc(http.DefaultTransport.RoundTrip)
+	if _, err := New(u.Host, "minioadmin", "minioadmin", true, WithTransport(transport), WithIdleConnTimeout(time.Minute)); err == nil {
+		t.Error("expected an error tuning a transport other than *http.Transport")
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -33,8 +33,8 @@ import (
 	"os"
 	"regexp"
 	"runtime"
+	"strconv"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -70,6 +70,48 @@ type AdminClient struct {
 	// Advanced functionality.
 	isTraceEnabled bool
 	traceOutput    io.Writer
+
+	// Observes the requests sent, if set.
+	httpHooks HTTPHooks
+
+	// Region and signer of requests, signer.SignV4 if nil.
+	region string
+	sign   Signer
+
+	// Appended to the User-Agent, if set.
+	userAgentSuffix string
+
+	// Picks the endpoints of requests, nil for a single endpoint.
+	endpoints *endpointSelector
+
+	// Refuse all mutating admin APIs.
+	readOnly bool
+
+	// Metrics shared by all copies of the client.
+	stats *clientStats
+
+	// Limit of responses with unbounded payloads, zero if unlimited.
+	maxResponseSize ByteSize
+
+	// Persists the handles of long-running jobs, if set.
+	jobStore JobStore
+
+	// Disables retrying older equivalents of unsupported APIs.
+	noAPIFallback bool
+
+	// Called with attempts to reduce compliance retention, if set.
+	retentionChangeLog func(RetentionChange)
+
+	// Rejects unknown fields in responses.
+	strictDecoding bool
+
+	// Retries of failed requests, the defaults if zero.
+	retryPolicy RetryPolicy
+
+	// Clock of generated timestamps and source of randomness of keys,
+	// the system defaults if nil.
+	clock      Clock
+	randSource io.Reader
 }
 
 // Global constants.
@@ -93,14 +135,17 @@ const (
 type Options struct {
 	Creds  *credentials.Credentials
 	Secure bool
+	// ReadOnly restricts the client to non-mutating admin APIs,
+	// regardless of the permissions of the credentials.
+	ReadOnly bool
 	// Add future fields here
 }
 
-// New - instantiate minio admin client
-func New(endpoint string, accessKeyID, secretAccessKey string, secure bool) (*AdminClient, error) {
+// New - instantiate minio admin client, configured by options.
+func New(endpoint string, accessKeyID, secretAccessKey string, secure bool, options ...Option) (*AdminClient, error) {
 	creds := credentials.NewStaticV4(accessKeyID, secretAccessKey, "")
 
-	clnt, err := privateNew(endpoint, creds, secure)
+	clnt, err := privateNew(endpoint, creds, secure, options)
 	if err != nil {
 		return nil, err
 	}
@@ -108,15 +153,16 @@ func New(endpoint string, accessKeyID, secretAccessKey string, secure bool) (*Ad
 }
 
 // NewWithOptions - instantiate minio admin client with options.
-func NewWithOptions(endpoint string, opts *Options) (*AdminClient, error) {
-	clnt, err := privateNew(endpoint, opts.Creds, opts.Secure)
+func NewWithOptions(endpoint string, opts *Options, options ...Option) (*AdminClient, error) {
+	clnt, err := privateNew(endpoint, opts.Creds, opts.Secure, options)
 	if err != nil {
 		return nil, err
 	}
+	clnt.readOnly = opts.ReadOnly
 	return clnt, nil
 }
 
-func privateNew(endpoint string, creds *credentials.Credentials, secure bool) (*AdminClient, error) {
+func privateNew(endpoint string, creds *credentials.Credentials, secure bool, options []Option) (*AdminClient, error) {
 	// Initialize cookies to preserve server sent cookies if any and replay
 	// them upon each request.
 	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
@@ -148,7 +194,15 @@ func privateNew(endpoint string, creds *credentials.Credentials, secure bool) (*
 	}
 
 	// Add locked pseudo-random number generator.
-	clnt.random = rand.New(&lockedRandSource{src: rand.NewSource(time.Now().UTC().UnixNano())})
+	clnt.random = newRandom(nil)
+
+	clnt.stats = newClientStats()
+
+	for _, option := range options {
+		if err = option(clnt); err != nil {
+			return nil, err
+		}
+	}
 
 	// Return.
 	return clnt, nil
@@ -211,6 +265,21 @@ type requestData struct {
 	queryValues   url.Values
 	relPath       string // URL path relative to admin API base endpoint
 	content       []byte
+
+	// Response payload is unbounded and subject to maxResponseSize.
+	unboundedResponse bool
+	// Response is a long-lived stream of newline delimited messages,
+	// maxResponseSize applies to each message.
+	messageStream bool
+
+	// Overrides the retry policy of the client, if set.
+	retryPolicy *RetryPolicy
+
+	// relPath is relative to the server root instead of the admin API,
+	// e.g. an S3 bucket or object path or the metrics API.
+	rootPath bool
+	// Authorizes the request instead of the signature, if set.
+	bearerToken string
 }
 
 // Filter out signature value from Authorization header.
@@ -219,9 +288,18 @@ func (adm AdminClient) filterSignature(req *http.Request) {
 
 	// Save the original auth.
 	origAuth := req.Header.Get("Authorization")
+	if origAuth == "" {
+		return
+	}
+	// Other schemes, like the bearer token of NodeMetrics, are
+	// redacted as a whole.
+	if !strings.HasPrefix(origAuth, "AWS4-") {
+		req.Header.Set("Authorization", "**REDACTED**")
+		return
+	}
 	// Strip out accessKeyID from:
 	// Credential=<access-key-id>/<date>/<aws-region>/<aws-service>/aws4_request
-	regCred := regexp.MustCompile("Credential=([A-Z0-9]+)/")
+	regCred := regexp.MustCompile("Credential=([^/]+)/")
 	newAuth := regCred.ReplaceAllString(origAuth, "Credential=**REDACTED**/")
 
 	// Strip out 256-bit signature from: Signature=<256-bit signature>
@@ -298,7 +376,7 @@ func (adm AdminClient) dumpHTTP(req *http.Request, resp *http.Response) error {
 
 // do - execute http request.
 func (adm AdminClient) do(req *http.Request) (*http.Response, error) {
-	resp, err := adm.httpClient.Do(req)
+	resp, err := adm.doWithHooks(req)
 	if err != nil {
 		// Handle this specifically for now until future Golang versions fix this issue properly.
 		if urlErr, ok := err.(*url.Error); ok {
@@ -337,10 +415,27 @@ var successStatus = []int{
 }
 
 // executeMethod - instantiates a given method, and retries the
-// request upon any error up to maxRetries attempts in a binomially
-// delayed manner using a standard back off algorithm.
+// request upon retryable errors as configured by its RetryPolicy, in a
+// binomially delayed manner using a standard back off algorithm.
 func (adm AdminClient) executeMethod(ctx context.Context, method string, reqData requestData) (res *http.Response, err error) {
-	var reqRetry = MaxRetry // Indicates how many times we can retry the request
+	if err = adm.checkReadOnlyRequest(method, reqData); err != nil {
+		return nil, err
+	}
+
+	callOpts := callOptionsFrom(ctx)
+	policy := adm.retryPolicyFor(ctx, reqData)
+	if callOpts.timeout > 0 {
+		var cancelCall context.CancelFunc
+		ctx, cancelCall = context.WithTimeout(ctx, callOpts.timeout)
+		defer func() {
+			// The deadline covers reading the response body.
+			if err != nil || res == nil {
+				cancelCall()
+				return
+			}
+			res.Body = cancelReadCloser{ReadCloser: res.Body, cancel: cancelCall}
+		}()
+	}
 	defer func() {
 		if err != nil {
 			// close idle connections before returning, upon error.
@@ -348,37 +443,47 @@ func (adm AdminClient) executeMethod(ctx context.Context, method string, reqData
 		}
 	}()
 
-	// Create cancel context to control 'newRetryTimer' go routine.
-	retryCtx, cancel := context.WithCancel(ctx)
-
-	// Indicate to our routine to exit cleanly upon return.
-	defer cancel()
+	var retryAfter time.Duration
+	var refreshed bool
+	for attempt := 0; attempt < policy.MaxRetry; attempt++ {
+		if attempt > 0 {
+			if err = policy.wait(ctx, adm.random, attempt-1, retryAfter); err != nil {
+				return nil, err
+			}
+			retryAfter = 0
+		}
 
-	for range adm.newRetryTimer(retryCtx, reqRetry, DefaultRetryUnit, DefaultRetryCap, MaxJitter) {
 		// Instantiate a new request.
 		var req *http.Request
 		req, err = adm.newRequest(ctx, method, reqData)
 		if err != nil {
 			return nil, err
 		}
+		adm.stats.request(reqData.relPath, len(reqData.content), attempt > 0)
 
 		// Initiate the request.
 		res, err = adm.do(req)
 		if err != nil {
-			// Give up right away if it is a connection refused problem
-			if errors.Is(err, syscall.ECONNREFUSED) {
-				return nil, err
+			adm.stats.failure("NetworkError")
+			// Other endpoints may be reachable, even if the
+			// connection was refused.
+			failover := adm.endpoints != nil && NodeFromContext(ctx) == ""
+			if failover {
+				adm.endpoints.failed(req.URL.Host)
 			}
-			if err == context.Canceled || err == context.DeadlineExceeded {
+			if !failover && !policy.retryableError(err) {
 				return nil, err
 			}
-			// retry all network errors.
 			continue
 		}
 
 		// For any known successful http status, return quickly.
 		for _, httpStatus := range successStatus {
 			if httpStatus == res.StatusCode {
+				adm.stats.countResponse(res)
+				if err = adm.limitResponse(reqData, res); err != nil {
+					return nil, err
+				}
 				return res, nil
 			}
 		}
@@ -390,6 +495,7 @@ func (adm AdminClient) executeMethod(ctx context.Context, method string, reqData
 		if err != nil {
 			return nil, err
 		}
+		adm.stats.received(len(errBodyBytes))
 
 		// Save the body.
 		errBodySeeker := bytes.NewReader(errBodyBytes)
@@ -397,29 +503,42 @@ func (adm AdminClient) executeMethod(ctx context.Context, method string, reqData
 
 		// For errors verify if its retryable otherwise fail quickly.
 		errResponse := ToErrorResponse(httpRespToErrorResponse(res))
+		if errResponse.Code != "" {
+			adm.stats.failure(errResponse.Code)
+		} else {
+			adm.stats.failure(strconv.Itoa(res.StatusCode))
+		}
 
 		// Save the body back again.
 		errBodySeeker.Seek(0, 0) // Seek back to starting point.
 		res.Body = ioutil.NopCloser(errBodySeeker)
 
-		// Verify if error response code is retryable.
-		if isS3CodeRetryable(errResponse.Code) {
-			continue // Retry.
+		// Older servers lack some APIs, retry their older equivalent.
+		if fallback, ok := adm.apiFallbackFor(method, reqData, res.StatusCode, errResponse.Code); ok {
+			closeResponse(res)
+			return adm.executeFallback(ctx, method, reqData, fallback)
+		}
+
+		// Temporary credentials may expire before their provider
+		// considers them expired, e.g. due to clock skew. Retry
+		// immediately with refreshed credentials, once.
+		if errResponse.Code == string(ErrCodeExpiredToken) && !refreshed {
+			refreshed = true
+			adm.credsProvider.Expire()
+			closeResponse(res)
+			attempt--
+			continue
 		}
 
-		// Verify if http status code is retryable.
-		if isHTTPStatusRetryable(res.StatusCode) {
+		// Verify if error response code or http status code is retryable.
+		if policy.retryableResponse(res.StatusCode, errResponse.Code) {
+			retryAfter = parseRetryAfter(res.Header.Get("Retry-After"), adm.now())
 			continue // Retry.
 		}
 
 		break
 	}
 
-	// Return an error when retry is canceled or deadlined
-	if e := retryCtx.Err(); e != nil {
-		return nil, e
-	}
-
 	return res, err
 }
 
@@ -429,6 +548,9 @@ func (adm AdminClient) setUserAgent(req *http.Request) {
 	if adm.appInfo.appName != "" && adm.appInfo.appVersion != "" {
 		req.Header.Set("User-Agent", libraryUserAgent+" "+adm.appInfo.appName+"/"+adm.appInfo.appVersion)
 	}
+	if adm.userAgentSuffix != "" {
+		req.Header.Set("User-Agent", req.Header.Get("User-Agent")+" "+adm.userAgentSuffix)
+	}
 }
 
 func (adm AdminClient) getSecretKey() string {
@@ -448,14 +570,19 @@ func (adm AdminClient) newRequest(ctx context.Context, method string, reqData re
 		method = "POST"
 	}
 
-	// Default all requests to ""
-	location := ""
+	// Region of the requests, "" by default.
+	location := adm.region
 
 	// Construct a new target URL.
 	targetURL, err := adm.makeTargetURL(reqData)
 	if err != nil {
 		return nil, err
 	}
+	if node := NodeFromContext(ctx); node != "" {
+		targetURL.Host = node
+	} else if adm.endpoints != nil {
+		targetURL.Host = adm.endpoints.next()
+	}
 
 	// Initialize a new HTTP request for the method.
 	req, err = http.NewRequestWithContext(ctx, method, targetURL.String(), nil)
@@ -485,7 +612,15 @@ func (adm AdminClient) newRequest(ctx context.Context, method string, reqData re
 	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(sum[:]))
 	req.Body = ioutil.NopCloser(bytes.NewReader(reqData.content))
 
-	req = signer.SignV4(*req, accessKeyID, secretAccessKey, sessionToken, location)
+	if reqData.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+reqData.bearerToken)
+		return req, nil
+	}
+	if adm.sign != nil {
+		req = adm.sign(*req, accessKeyID, secretAccessKey, sessionToken, location)
+	} else {
+		req = signer.SignV4(*req, accessKeyID, secretAccessKey, sessionToken, location)
+	}
 	return req, nil
 }
 
@@ -496,6 +631,9 @@ func (adm AdminClient) makeTargetURL(r requestData) (*url.URL, error) {
 	scheme := adm.endpointURL.Scheme
 
 	urlStr := scheme + "://" + host + libraryAdminURLPrefix + r.relPath
+	if r.rootPath {
+		urlStr = scheme + "://" + host + r.relPath
+	}
 
 	// If there are any query values, add them to the end.
 	if len(r.queryValues) > 0 {
@@ -18,9 +18,12 @@
 package madmin_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/minio/madmin-go"
+	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
 func TestMinioAdminClient(t *testing.T) {
@@ -29,3 +32,22 @@ func TestMinioAdminClient(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestReadOnlyAdminClient(t *testing.T) {
+	clnt, err := madmin.NewWithOptions("localhost:9000", &madmin.Options{
+		Creds:    credentials.NewStaticV4("food", "food123", ""),
+		ReadOnly: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !clnt.IsReadOnly() {
+		t.Fatal("expected read-only client")
+	}
+	if err = clnt.ServiceRestart(context.Background()); !errors.Is(err, madmin.ErrReadOnlyClient) {
+		t.Fatalf("expected ErrReadOnlyClient, got %v", err)
+	}
+	if err = clnt.SetBucketQuota(context.Background(), "bucket", &madmin.BucketQuota{}); !errors.Is(err, madmin.ErrReadOnlyClient) {
+		t.Fatalf("expected ErrReadOnlyClient, got %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// CapabilityProbe - outcome of the functional probe of a capability.
+type CapabilityProbe struct {
+	Supported bool `json:"supported" yaml:"supported"`
+	// Error explains why the capability is missing or could not be
+	// probed.
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// BackendCapabilities - features the storage backend actually
+// provides, as found by functional probes. Probes which did not run
+// are nil.
+type BackendCapabilities struct {
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Versioning *CapabilityProbe `json:"versioning,omitempty" yaml:"versioning,omitempty"`
+	ObjectLock *CapabilityProbe `json:"object_lock,omitempty" yaml:"object_lock,omitempty"`
+	// Xattr and CaseSensitive are probed on the drives and supported
+	// only if all drives support them.
+	Xattr         *CapabilityProbe `json:"xattr,omitempty" yaml:"xattr,omitempty"`
+	CaseSensitive *CapabilityProbe `json:"case_sensitive,omitempty" yaml:"case_sensitive,omitempty"`
+}
+
+// ProbeDriveCapabilities - probes extended attribute support and case
+// sensitivity of the filesystems of drives, on the node itself.
+func ProbeDriveCapabilities(drivePaths []string) BackendCapabilities {
+	xattr := &CapabilityProbe{Supported: true}
+	caseSensitive := &CapabilityProbe{Supported: true}
+	for _, path := range drivePaths {
+		dir, err := ioutil.TempDir(path, ".minio-capability-")
+		if err != nil {
+			return BackendCapabilities{Error: fmt.Sprintf("%s is not writable: %v", path, err)}
+		}
+		if err = checkXattr(dir); err != nil && xattr.Supported {
+			xattr.Supported, xattr.Error = false, fmt.Sprintf("%s: %v", path, err)
+		}
+		if err = checkCaseSensitive(dir); err != nil && caseSensitive.Supported {
+			caseSensitive.Supported, caseSensitive.Error = false, fmt.Sprintf("%s: %v", path, err)
+		}
+		os.RemoveAll(dir)
+	}
+	return BackendCapabilities{Xattr: xattr, CaseSensitive: caseSensitive}
+}
+
+// checkCaseSensitive - creates a file and looks it up in upper case.
+func checkCaseSensitive(dir string) error {
+	if err := ioutil.WriteFile(filepath.Join(dir, "case-probe"), nil, 0600); err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(dir, "CASE-PROBE")); err == nil {
+		return fmt.Errorf("filesystem is case insensitive")
+	}
+	return nil
+}
+
+// ProbeBackendCapabilities - probes versioning and object locking of the
+// backend by creating a temporary bucket with object locking enabled
+// through the S3 API, which is removed afterwards. The credentials of
+// the client need permission to create and delete buckets. Drive
+// capabilities are probed if drivePaths is not empty, which requires
+// running on a node of the backend.
+func (adm *AdminClient) ProbeBackendCapabilities(ctx context.Context, drivePaths []string) BackendCapabilities {
+	caps := BackendCapabilities{}
+	if len(drivePaths) > 0 {
+		caps = ProbeDriveCapabilities(drivePaths)
+	}
+
+	bucket := "minio-capability-probe-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	caps.ObjectLock = &CapabilityProbe{}
+	caps.Versioning = &CapabilityProbe{}
+	if err := adm.s3Request(ctx, http.MethodPut, bucket, "", map[string]string{"x-amz-bucket-object-lock-enabled": "true"}, nil, nil); err != nil {
+		caps.ObjectLock.Error = err.Error()
+		// Without object locking, a plain bucket still probes versioning.
+		if err = adm.s3Request(ctx, http.MethodPut, bucket, "", nil, nil, nil); err != nil {
+			caps.Versioning.Error = err.Error()
+			return caps
+		}
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		adm.s3Request(ctx, http.MethodDelete, bucket, "", nil, nil, nil)
+	}()
+
+	if caps.ObjectLock.Error == "" {
+		var lock struct {
+			ObjectLockEnabled string
+		}
+		if err := adm.s3Request(ctx, http.MethodGet, bucket, "object-lock", nil, nil, &lock); err != nil {
+			caps.ObjectLock.Error = err.Error()
+		} else if lock.ObjectLockEnabled != "Enabled" {
+			caps.ObjectLock.Error = "object lock configuration is not enabled on a bucket created with object locking"
+		} else {
+			caps.ObjectLock.Supported = true
+		}
+	}
+
+	enable := []byte(`<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>Enabled</Status></VersioningConfiguration>`)
+	if err := adm.s3Request(ctx, http.MethodPut, bucket, "versioning", nil, enable, nil); err != nil {
+		caps.Versioning.Error = err.Error()
+		return caps
+	}
+	var versioning struct {
+		Status string
+	}
+	if err := adm.s3Request(ctx, http.MethodGet, bucket, "versioning", nil, nil, &versioning); err != nil {
+		caps.Versioning.Error = err.Error()
+	} else if versioning.Status != "Enabled" {
+		caps.Versioning.Error = "versioning status is " + strconv.Quote(versioning.Status) + " after enabling it"
+	} else {
+		caps.Versioning.Supported = true
+	}
+	return caps
+}
+
+// s3Request - sends an S3 bucket request through executeMethod,
+// subresource is a query parameter without value like "versioning".
+// The XML response is decoded into v if not nil, a *[]byte receives
+// the raw response.
+func (adm *AdminClient) s3Request(ctx context.Context, method, bucket, subresource string, headers map[string]string, body []byte, v interface{}) error {
+	reqData := requestData{
+		relPath:  "/" + bucket,
+		content:  body,
+		rootPath: true,
+	}
+	if subresource != "" {
+		reqData.queryValues = url.Values{subresource: []string{""}}
+	}
+	if len(headers) > 0 {
+		reqData.customHeaders = make(http.Header)
+		for k, v := range headers {
+			reqData.customHeaders.Set(k, v)
+		}
+	}
+
+	resp, err := adm.executeMethod(ctx, method, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		var errResp ErrorResponse
+		if err = xml.NewDecoder(resp.Body).Decode(&errResp); err != nil || errResp.Code == "" {
+			return fmt.Errorf("%s %s: %s", method, subresource, resp.Status)
+		}
+		return errResp
+	}
+	if raw, ok := v.(*[]byte); ok {
+		*raw, err = ioutil.ReadAll(resp.Body)
+		return err
+	}
+	if v != nil {
+		return xml.NewDecoder(resp.Body).Decode(v)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestProbeBackendCapabilities(t *testing.T) {
+	var deleted bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, versioning := r.URL.Query()["versioning"]
+		_, objectLock := r.URL.Query()["object-lock"]
+		switch {
+		case r.Method == http.MethodPut && r.Header.Get("x-amz-bucket-object-lock-enabled") != "":
+			// A backend without object locking.
+			w.WriteHeader(http.StatusNotImplemented)
+			w.Write([]byte(`<Error><Code>NotImplemented</Code><Message>object lock is not supported</Message></Error>`))
+		case r.Method == http.MethodPut && versioning:
+			body, _ := ioutil.ReadAll(r.Body)
+			if !strings.Contains(string(body), "<Status>Enabled</Status>") {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		case r.Method == http.MethodGet && versioning:
+			w.Write([]byte(`<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`))
+		case r.Method == http.MethodPut && !objectLock:
+		case r.Method == http.MethodDelete:
+			deleted = strings.HasPrefix(r.URL.Path, "/minio-capability-probe-")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "capabilities")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	caps := adm.ProbeBackendCapabilities(context.Background(), []string{dir})
+	if caps.ObjectLock.Supported || caps.ObjectLock.Error != "object lock is not supported" {
+		t.Errorf("unexpected object lock %+v", caps.ObjectLock)
+	}
+	if !caps.Versioning.Supported {
+		t.Errorf("unexpected versioning %+v", caps.Versioning)
+	}
+	if caps.CaseSensitive == nil || !caps.CaseSensitive.Supported || caps.Xattr == nil {
+		t.Errorf("unexpected drive capabilities %+v %+v", caps.CaseSensitive, caps.Xattr)
+	}
+	if !deleted {
+		t.Error("probe bucket was not removed")
+	}
+}
+
+func TestProbeBackendCapabilitiesReadOnly(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if auth := r.Header.Get("Authorization"); auth != "custom eu-west-1" {
+			t.Errorf("expected the custom signer with the client region, got %s", auth)
+		}
+		w.Write([]byte(`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := NewWithOptions(u.Host, &Options{Creds: credentials.NewStaticV4("minioadmin", "minioadmin", ""), ReadOnly: true},
+		WithRegion("eu-west-1"), WithCustomSigner(func(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string) *http.Request {
+			req.Header.Set("Authorization", "custom "+location)
+			return &req
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	caps := adm.ProbeBackendCapabilities(context.Background(), nil)
+	if caps.ObjectLock.Supported || !strings.Contains(caps.Versioning.Error, ErrReadOnlyClient.Error()) {
+		t.Errorf("expected the probe to be refused, got %+v %+v", caps.ObjectLock, caps.Versioning)
+	}
+	if len(requests) != 0 {
+		t.Errorf("expected no requests from a read-only client, got %v", requests)
+	}
+
+	// Reading S3 configuration is allowed.
+	cfg, err := adm.GetObjectLockConfig(context.Background(), "bucket")
+	if err != nil || !cfg.Enabled || len(requests) != 1 || requests[0] != "GET /bucket" {
+		t.Errorf("unexpected object lock config %+v, %v, requests %v", cfg, err, requests)
+	}
+}
@@ -0,0 +1,118 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// BackendHealth - health information of an S3 compatible storage
+// backend other than MinIO.
+type BackendHealth struct {
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Vendor  string `json:"vendor" yaml:"vendor"`
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+	// Capabilities like "versioning" or "object-lock".
+	Capabilities []string `json:"capabilities,omitempty" yaml:"capabilities,omitempty"`
+	// KV carries vendor specific information.
+	KV map[string]string `json:"kv,omitempty" yaml:"kv,omitempty"`
+}
+
+// HasCapability - returns true if the backend reports capability.
+func (b BackendHealth) HasCapability(capability string) bool {
+	for _, c := range b.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+// BackendHealthAdapter - collects the health information of a storage
+// backend other than MinIO.
+type BackendHealthAdapter interface {
+	BackendHealth(ctx context.Context) (BackendHealth, error)
+}
+
+// BackendHealthFunc - adapts a function to a BackendHealthAdapter.
+type BackendHealthFunc func(ctx context.Context) (BackendHealth, error)
+
+// BackendHealth - calls f.
+func (f BackendHealthFunc) BackendHealth(ctx context.Context) (BackendHealth, error) {
+	return f(ctx)
+}
+
+var backendHealthAdapters = struct {
+	sync.RWMutex
+	m map[string]BackendHealthAdapter
+}{m: make(map[string]BackendHealthAdapter)}
+
+// RegisterBackendHealthAdapter - registers the adapter of a vendor,
+// replacing any previously registered one.
+func RegisterBackendHealthAdapter(vendor string, adapter BackendHealthAdapter) error {
+	if vendor == "" {
+		return ErrInvalidArgument("Backend vendor cannot be empty.")
+	}
+	if adapter == nil {
+		return ErrInvalidArgument("Backend health adapter of " + vendor + " cannot be nil.")
+	}
+	backendHealthAdapters.Lock()
+	defer backendHealthAdapters.Unlock()
+	backendHealthAdapters.m[vendor] = adapter
+	return nil
+}
+
+// BackendHealthVendors - returns the vendors with a registered adapter.
+func BackendHealthVendors() []string {
+	backendHealthAdapters.RLock()
+	defer backendHealthAdapters.RUnlock()
+	vendors := make([]string, 0, len(backendHealthAdapters.m))
+	for vendor := range backendHealthAdapters.m {
+		vendors = append(vendors, vendor)
+	}
+	sort.Strings(vendors)
+	return vendors
+}
+
+// CollectBackendHealth - returns the Minio section of a health report
+// for a backend, collected by the adapter registered for vendor. Errors
+// are reported in the section like the other collectors do.
+func CollectBackendHealth(ctx context.Context, vendor string) MinioHealthInfo {
+	backendHealthAdapters.RLock()
+	adapter, ok := backendHealthAdapters.m[vendor]
+	backendHealthAdapters.RUnlock()
+	if !ok {
+		return MinioHealthInfo{Backend: &BackendHealth{Vendor: vendor, Error: "no health adapter registered for backend " + vendor}}
+	}
+	health, err := adapter.BackendHealth(ctx)
+	if health.Vendor == "" {
+		health.Vendor = vendor
+	}
+	if err != nil {
+		health.Error = err.Error()
+	}
+	return MinioHealthInfo{Backend: &health}
+}
+
+// IsMinIO - returns true if the section describes a MinIO deployment
+// rather than a third-party backend.
+func (info MinioHealthInfo) IsMinIO() bool {
+	return info.Backend == nil
+}
@@ -0,0 +1,92 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRegisterBackendHealthAdapter(t *testing.T) {
+	adapter := BackendHealthFunc(func(ctx context.Context) (BackendHealth, error) {
+		return BackendHealth{}, nil
+	})
+	if err := RegisterBackendHealthAdapter("", adapter); err == nil {
+		t.Error("expected an error for an empty vendor")
+	}
+	if err := RegisterBackendHealthAdapter("test-nil", nil); err == nil {
+		t.Error("expected an error for a nil adapter")
+	}
+	if err := RegisterBackendHealthAdapter("test-registered", adapter); err != nil {
+		t.Fatal(err)
+	}
+	var registered bool
+	for _, vendor := range BackendHealthVendors() {
+		if vendor == "test-nil" {
+			t.Error("expected the invalid adapter not to be registered")
+		}
+		registered = registered || vendor == "test-registered"
+	}
+	if !registered {
+		t.Errorf("expected test-registered in %v", BackendHealthVendors())
+	}
+}
+
+func TestCollectBackendHealth(t *testing.T) {
+	ctx := context.Background()
+
+	info := CollectBackendHealth(ctx, "test-unregistered")
+	if info.IsMinIO() || info.Backend.Vendor != "test-unregistered" || info.Backend.Error == "" {
+		t.Errorf("expected an error for an unregistered vendor, got %+v", info.Backend)
+	}
+
+	RegisterBackendHealthAdapter("test-failing", BackendHealthFunc(func(ctx context.Context) (BackendHealth, error) {
+		return BackendHealth{Version: "1.2"}, errors.New("connection refused")
+	}))
+	info = CollectBackendHealth(ctx, "test-failing")
+	if info.IsMinIO() || info.Backend.Error != "connection refused" || info.Backend.Version != "1.2" || info.Backend.Vendor != "test-failing" {
+		t.Errorf("expected the adapter error with the partial data, got %+v", info.Backend)
+	}
+
+	RegisterBackendHealthAdapter("test-vendor", BackendHealthFunc(func(ctx context.Context) (BackendHealth, error) {
+		return BackendHealth{Capabilities: []string{"versioning"}}, nil
+	}))
+	info = CollectBackendHealth(ctx, "test-vendor")
+	if info.IsMinIO() || info.Backend.Vendor != "test-vendor" || info.Backend.Error != "" || !info.Backend.HasCapability("versioning") {
+		t.Errorf("expected the registered vendor as fallback, got %+v", info.Backend)
+	}
+	if info.Backend.HasCapability("object-lock") {
+		t.Error("expected no object-lock capability")
+	}
+
+	RegisterBackendHealthAdapter("test-reported", BackendHealthFunc(func(ctx context.Context) (BackendHealth, error) {
+		return BackendHealth{Vendor: "Acme"}, nil
+	}))
+	if info = CollectBackendHealth(ctx, "test-reported"); info.Backend.Vendor != "Acme" {
+		t.Errorf("expected the reported vendor, got %+v", info.Backend)
+	}
+}
+
+func TestMinioHealthInfoIsMinIO(t *testing.T) {
+	if !(MinioHealthInfo{}).IsMinIO() {
+		t.Error("expected a section without backend to describe MinIO")
+	}
+	if (MinioHealthInfo{Backend: &BackendHealth{Vendor: "Acme"}}).IsMinIO() {
+		t.Error("expected a section with a backend not to describe MinIO")
+	}
+}
@@ -18,7 +18,6 @@ package madmin
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 	"net/url"
 	"strings"
@@ -65,7 +64,7 @@ func (adm *AdminClient) GetBucketBandwidth(ctx context.Context, buckets ...strin
 		return ch
 	}
 
-	dec := json.NewDecoder(resp.Body)
+	dec := adm.newResponseDecoder(resp)
 
 	go func(ctx context.Context, ch chan<- Report, resp *http.Response) {
 		defer func() {
@@ -0,0 +1,191 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// BatchJobResult - a started batch job.
+type BatchJobResult struct {
+	ID      string        `json:"id"`
+	Type    string        `json:"type"`
+	User    string        `json:"user,omitempty"`
+	Started time.Time     `json:"started"`
+	Elapsed time.Duration `json:"elapsed,omitempty"`
+}
+
+// BatchJobStatus - progress of a batch job.
+type BatchJobStatus struct {
+	ID         string    `json:"jobID"`
+	Type       string    `json:"jobType"`
+	StartTime  time.Time `json:"startTime"`
+	LastUpdate time.Time `json:"lastUpdate"`
+	// Complete is set once the job ended, Failed if it ended early.
+	Complete bool `json:"complete"`
+	Failed   bool `json:"failed"`
+
+	Objects       int64 `json:"objects"`
+	ObjectsFailed int64 `json:"objectsFailed"`
+	// LastError is the error of the most recent object failure.
+	LastError string `json:"lastError,omitempty"`
+}
+
+// Done - returns true once the job ended.
+func (s BatchJobStatus) Done() bool {
+	return s.Complete || s.Failed
+}
+
+// StartBatchJob - starts the batch job described in YAML.
+func (adm *AdminClient) StartBatchJob(ctx context.Context, job string) (BatchJobResult, error) {
+	resp, err := adm.executeMethod(ctx, http.MethodPost,
+		requestData{
+			relPath: adminAPIPrefix + "/start-job",
+			content: []byte(job),
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return BatchJobResult{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return BatchJobResult{}, httpRespToErrorResponse(resp)
+	}
+
+	var result BatchJobResult
+	if err = adm.decodeResponse(resp, &result); err != nil {
+		return BatchJobResult{}, err
+	}
+	return result, nil
+}
+
+// GetBatchJobStatus - returns the progress of a batch job.
+func (adm *AdminClient) GetBatchJobStatus(ctx context.Context, jobID string) (BatchJobStatus, error) {
+	values := make(url.Values)
+	values.Set("jobId", jobID)
+
+	resp, err := adm.executeMethod(ctx, http.MethodGet,
+		requestData{
+			relPath:     adminAPIPrefix + "/status-job",
+			queryValues: values,
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return BatchJobStatus{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return BatchJobStatus{}, httpRespToErrorResponse(resp)
+	}
+
+	var status struct {
+		LastMetric BatchJobStatus `json:"lastMetric"`
+	}
+	if err = adm.decodeResponse(resp, &status); err != nil {
+		return BatchJobStatus{}, err
+	}
+	return status.LastMetric, nil
+}
+
+// CancelBatchJob - cancels a running batch job.
+func (adm *AdminClient) CancelBatchJob(ctx context.Context, jobID string) error {
+	values := make(url.Values)
+	values.Set("id", jobID)
+
+	resp, err := adm.executeMethod(ctx, http.MethodDelete,
+		requestData{
+			relPath:     adminAPIPrefix + "/cancel-job",
+			queryValues: values,
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+	return nil
+}
+
+// runBatchJob - starts a batch job, records it in the job store and
+// polls its status every pollInterval, 5 seconds if zero, until it
+// ends. Finished jobs are removed from the store.
+func (adm *AdminClient) runBatchJob(ctx context.Context, job, bucket, prefix string, pollInterval time.Duration, onProgress func(BatchJobStatus)) (BatchJobStatus, error) {
+	result, err := adm.startRecordedBatchJob(ctx, job, bucket, prefix)
+	if err != nil {
+		return BatchJobStatus{}, err
+	}
+	return adm.waitBatchJob(ctx, result, pollInterval, onProgress)
+}
+
+// startRecordedBatchJob - starts a batch job and records it in the job
+// store.
+func (adm *AdminClient) startRecordedBatchJob(ctx context.Context, job, bucket, prefix string) (BatchJobResult, error) {
+	result, err := adm.StartBatchJob(ctx, job)
+	if err != nil {
+		return BatchJobResult{}, err
+	}
+	if adm.jobStore != nil {
+		handle := JobHandle{
+			ID:      result.ID,
+			Type:    JobTypeBatch,
+			Bucket:  bucket,
+			Prefix:  prefix,
+			Started: result.Started,
+		}
+		if err = adm.jobStore.SaveJob(handle); err != nil {
+			return BatchJobResult{}, err
+		}
+	}
+	return result, nil
+}
+
+// waitBatchJob - polls the status of a started batch job every
+// pollInterval, 5 seconds if zero, until it ends, then removes it from
+// the job store.
+func (adm *AdminClient) waitBatchJob(ctx context.Context, result BatchJobResult, pollInterval time.Duration, onProgress func(BatchJobStatus)) (BatchJobStatus, error) {
+	if pollInterval <= 0 {
+		pollInterval = 5 * time.Second
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		status, err := adm.GetBatchJobStatus(ctx, result.ID)
+		if err != nil {
+			return status, err
+		}
+		if onProgress != nil {
+			onProgress(status)
+		}
+		if status.Done() {
+			if adm.jobStore != nil {
+				err = adm.jobStore.DeleteJob(result.ID)
+			}
+			return status, err
+		}
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
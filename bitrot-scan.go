@@ -0,0 +1,207 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// bitrotScanPollInterval - interval between heal status requests.
+var bitrotScanPollInterval = time.Second
+
+// Heal sequence summaries reported in HealTaskStatus.
+const (
+	healSummaryRunning  = "running"
+	healSummaryStopped  = "stopped"
+	healSummaryFinished = "finished"
+)
+
+// BitrotStatus - outcome of the integrity check of an object.
+type BitrotStatus string
+
+// Bitrot statuses.
+const (
+	// BitrotRepaired objects had corrupted parts which were healed.
+	BitrotRepaired BitrotStatus = "repaired"
+	// BitrotCorrupted objects still have corrupted parts after healing.
+	BitrotCorrupted BitrotStatus = "corrupted"
+)
+
+// BitrotScanItem - an object found with corrupted parts.
+type BitrotScanItem struct {
+	Bucket    string       `json:"bucket"`
+	Object    string       `json:"object"`
+	VersionID string       `json:"versionId,omitempty"`
+	Status    BitrotStatus `json:"status"`
+	// CorruptedDrives before and after healing.
+	CorruptedBefore int    `json:"corruptedBefore"`
+	CorruptedAfter  int    `json:"corruptedAfter"`
+	Detail          string `json:"detail,omitempty"`
+}
+
+// BitrotScanProgress - running totals of a bitrot scan.
+type BitrotScanProgress struct {
+	StartTime      time.Time `json:"startTime"`
+	ObjectsScanned int64     `json:"objectsScanned"`
+	BytesScanned   ByteSize  `json:"bytesScanned"`
+	Repaired       int64     `json:"repaired"`
+	Corrupted      int64     `json:"corrupted"`
+}
+
+// BitrotScanSummary - final integrity summary of a bitrot scan.
+type BitrotScanSummary struct {
+	BitrotScanProgress
+	Duration Duration `json:"duration"`
+	// Stopped is set if the heal sequence was stopped before it
+	// scanned everything.
+	Stopped bool `json:"stopped,omitempty"`
+}
+
+// Healthy - returns true if no object is left corrupted.
+func (s BitrotScanSummary) Healthy() bool {
+	return !s.Stopped && s.Corrupted == 0
+}
+
+// BitrotScanEvent - a single event of TriggerBitrotScan, exactly one
+// of Item, Summary or Err is set on events other than progress updates.
+type BitrotScanEvent struct {
+	Item     *BitrotScanItem    `json:"item,omitempty"`
+	Progress BitrotScanProgress `json:"progress"`
+	Summary  *BitrotScanSummary `json:"summary,omitempty"`
+	Err      error              `json:"-"`
+}
+
+// TriggerBitrotScan - starts a recursive heal sequence on bucket and
+// prefix verifying the checksums of all parts, and streams the
+// corrupted and repaired objects found, followed by progress updates
+// and a final summary. HealDeepScan is used if scanMode is unknown.
+// Canceling ctx stops the heal sequence on the server.
+func (adm *AdminClient) TriggerBitrotScan(ctx context.Context, bucket, prefix string, scanMode HealScanMode) <-chan BitrotScanEvent {
+	eventCh := make(chan BitrotScanEvent, 1)
+	if scanMode == HealUnknownScan {
+		scanMode = HealDeepScan
+	}
+	opts := HealOpts{Recursive: true, ScanMode: scanMode}
+
+	go func(eventCh chan<- BitrotScanEvent) {
+		defer close(eventCh)
+
+		send := func(e BitrotScanEvent) bool {
+			select {
+			case eventCh <- e:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		start, _, err := adm.Heal(ctx, bucket, prefix, opts, "", false, false)
+		if err != nil {
+			send(BitrotScanEvent{Err: err})
+			return
+		}
+		progress := BitrotScanProgress{StartTime: start.StartTime}
+		if progress.StartTime.IsZero() {
+			progress.StartTime = adm.now().UTC()
+		}
+
+		ticker := time.NewTicker(bitrotScanPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				// Best effort, the server also stops abandoned sequences.
+				stopCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				adm.Heal(stopCtx, bucket, prefix, opts, "", false, true)
+				cancel()
+				return
+			case <-ticker.C:
+			}
+
+			_, status, err := adm.Heal(ctx, bucket, prefix, opts, start.ClientToken, false, false)
+			if err != nil {
+				if ctx.Err() == nil {
+					send(BitrotScanEvent{Err: err})
+				}
+				return
+			}
+
+			for i := range status.Items {
+				item := bitrotScanItem(&status.Items[i], &progress)
+				if item != nil && !send(BitrotScanEvent{Item: item, Progress: progress}) {
+					return
+				}
+			}
+
+			switch status.Summary {
+			case healSummaryFinished, healSummaryStopped:
+				if status.FailureDetail != "" {
+					send(BitrotScanEvent{Err: errors.New(status.FailureDetail), Progress: progress})
+					return
+				}
+				send(BitrotScanEvent{
+					Progress: progress,
+					Summary: &BitrotScanSummary{
+						BitrotScanProgress: progress,
+						Duration:           Duration(time.Since(progress.StartTime)),
+						Stopped:            status.Summary == healSummaryStopped,
+					},
+				})
+				return
+			}
+			if !send(BitrotScanEvent{Progress: progress}) {
+				return
+			}
+		}
+	}(eventCh)
+
+	// Returns the event channel, for caller to start reading from.
+	return eventCh
+}
+
+// bitrotScanItem - accounts a heal result in progress and returns
+// the scan item if the object had corrupted parts.
+func bitrotScanItem(hri *HealResultItem, progress *BitrotScanProgress) *BitrotScanItem {
+	if hri.Type != HealItemObject {
+		return nil
+	}
+	progress.ObjectsScanned++
+	progress.BytesScanned += ByteSize(hri.ObjectSize)
+
+	before, after := hri.GetCorruptedCounts()
+	if before == 0 && after == 0 {
+		return nil
+	}
+	item := &BitrotScanItem{
+		Bucket:          hri.Bucket,
+		Object:          hri.Object,
+		VersionID:       hri.VersionID,
+		CorruptedBefore: before,
+		CorruptedAfter:  after,
+		Detail:          hri.Detail,
+	}
+	if after == 0 {
+		item.Status = BitrotRepaired
+		progress.Repaired++
+	} else {
+		item.Status = BitrotCorrupted
+		progress.Corrupted++
+	}
+	return item
+}
@@ -0,0 +1,96 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestTriggerBitrotScan(t *testing.T) {
+	defer func(d time.Duration) { bitrotScanPollInterval = d }(bitrotScanPollInterval)
+	bitrotScanPollInterval = time.Millisecond
+
+	drives := func(states ...string) []HealDriveInfo {
+		var d []HealDriveInfo
+		for _, s := range states {
+			d = append(d, HealDriveInfo{State: s})
+		}
+		return d
+	}
+	repaired := HealResultItem{Type: HealItemObject, Bucket: "data", Object: "a", ObjectSize: 10}
+	repaired.Before.Drives = drives(DriveStateCorrupt, DriveStateOk)
+	repaired.After.Drives = drives(DriveStateOk, DriveStateOk)
+	corrupted := HealResultItem{Type: HealItemObject, Bucket: "data", Object: "b", ObjectSize: 20}
+	corrupted.Before.Drives = drives(DriveStateCorrupt, DriveStateCorrupt)
+	corrupted.After.Drives = drives(DriveStateCorrupt, DriveStateOk)
+	clean := HealResultItem{Type: HealItemObject, Bucket: "data", Object: "c", ObjectSize: 30}
+
+	statuses := []HealTaskStatus{
+		{Summary: healSummaryRunning, Items: []HealResultItem{repaired, clean}},
+		{Summary: healSummaryFinished, Items: []HealResultItem{corrupted}},
+	}
+	var opts HealOpts
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("clientToken") == "" {
+			json.NewDecoder(r.Body).Decode(&opts)
+			json.NewEncoder(w).Encode(HealStartSuccess{ClientToken: "token"})
+			return
+		}
+		json.NewEncoder(w).Encode(statuses[0])
+		statuses = statuses[1:]
+	}))
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var items []BitrotScanItem
+	var summary *BitrotScanSummary
+	for event := range adm.TriggerBitrotScan(context.Background(), "data", "", HealUnknownScan) {
+		if event.Err != nil {
+			t.Fatal(event.Err)
+		}
+		if event.Item != nil {
+			items = append(items, *event.Item)
+		}
+		if event.Summary != nil {
+			summary = event.Summary
+		}
+	}
+	if opts.ScanMode != HealDeepScan || !opts.Recursive {
+		t.Errorf("unexpected heal options %+v", opts)
+	}
+	if len(items) != 2 || items[0].Status != BitrotRepaired || items[1].Status != BitrotCorrupted {
+		t.Fatalf("unexpected items %+v", items)
+	}
+	if summary == nil || summary.ObjectsScanned != 3 || summary.BytesScanned != 60 ||
+		summary.Repaired != 1 || summary.Corrupted != 1 || summary.Healthy() {
+		t.Fatalf("unexpected summary %+v", summary)
+	}
+}
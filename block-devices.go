@@ -0,0 +1,79 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// blockDeviceInfo - fills the block device fields of a partition from
+// sysfs. Partitions are resolved to their disk, device mapper devices
+// report the properties of their mapping.
+func blockDeviceInfo(p *Partition) {
+	device := p.Device
+	if resolved, err := filepath.EvalSymlinks(device); err == nil {
+		device = resolved
+	}
+	if !strings.HasPrefix(device, "/dev/") {
+		return
+	}
+
+	dir, err := filepath.EvalSymlinks(filepath.Join(sysfsRoot, "class/block", filepath.Base(device)))
+	if err != nil {
+		return
+	}
+	if _, err = os.Stat(filepath.Join(dir, "partition")); err == nil {
+		dir = filepath.Dir(dir)
+	}
+
+	readAttr := func(name string) string {
+		data, _ := ioutil.ReadFile(filepath.Join(dir, name))
+		return strings.TrimSpace(string(data))
+	}
+	rotational := readAttr("queue/rotational")
+	if rotational == "" {
+		return
+	}
+	p.IsRotational = rotational == "1"
+	p.Scheduler = activeScheduler(readAttr("queue/scheduler"))
+	p.Model = readAttr("device/model")
+	p.Serial = readAttr("device/serial")
+	if p.Serial == "" {
+		p.Serial = readAttr("serial")
+	}
+	discard, _ := strconv.ParseUint(readAttr("queue/discard_max_bytes"), 10, 64)
+	p.Discard = discard > 0
+}
+
+// activeScheduler - returns the bracketed scheduler of a
+// queue/scheduler attribute like "mq-deadline kyber [bfq] none".
+func activeScheduler(schedulers string) string {
+	for _, s := range strings.Fields(schedulers) {
+		if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+			return strings.Trim(s, "[]")
+		}
+	}
+	// Devices with a single scheduler report it without brackets.
+	if fields := strings.Fields(schedulers); len(fields) == 1 {
+		return fields[0]
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBlockDeviceInfo(t *testing.T) {
+	root, err := ioutil.TempDir("", "sysfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	write := func(path, content string) {
+		path = filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	link := func(target, name string) {
+		if err := os.MkdirAll(filepath.Join(root, "class/block"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink(filepath.Join(root, target), filepath.Join(root, "class/block", name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("devices/pci0/block/sda/queue/rotational", "1\n")
+	write("devices/pci0/block/sda/queue/scheduler", "mq-deadline kyber [bfq] none\n")
+	write("devices/pci0/block/sda/queue/discard_max_bytes", "0\n")
+	write("devices/pci0/block/sda/device/model", "ST16000NM001G  \n")
+	write("devices/pci0/block/sda/sda1/partition", "1\n")
+	link("devices/pci0/block/sda/sda1", "sda1")
+	write("devices/pci1/nvme/nvme0/nvme0n1/queue/rotational", "0\n")
+	write("devices/pci1/nvme/nvme0/nvme0n1/queue/scheduler", "[none] mq-deadline\n")
+	write("devices/pci1/nvme/nvme0/nvme0n1/queue/discard_max_bytes", "2199023255040\n")
+	write("devices/pci1/nvme/nvme0/nvme0n1/device/model", "Samsung SSD 980 PRO 2TB\n")
+	write("devices/pci1/nvme/nvme0/nvme0n1/device/serial", "S6B0NL0W123456\n")
+	link("devices/pci1/nvme/nvme0/nvme0n1", "nvme0n1")
+
+	defer func(old string) { sysfsRoot = old }(sysfsRoot)
+	sysfsRoot = root
+
+	hdd := Partition{Device: "/dev/sda1"}
+	blockDeviceInfo(&hdd)
+	if !hdd.IsRotational || hdd.Scheduler != "bfq" || hdd.Model != "ST16000NM001G" || hdd.Discard {
+		t.Errorf("unexpected HDD partition %+v", hdd)
+	}
+	nvme := Partition{Device: "/dev/nvme0n1"}
+	blockDeviceInfo(&nvme)
+	if nvme.IsRotational || nvme.Scheduler != "none" || nvme.Serial != "S6B0NL0W123456" || !nvme.Discard {
+		t.Errorf("unexpected NVMe partition %+v", nvme)
+	}
+	unknown := Partition{Device: "/dev/sdz1"}
+	if blockDeviceInfo(&unknown); unknown.Scheduler != "" || unknown.Model != "" {
+		t.Errorf("unexpected unknown partition %+v", unknown)
+	}
+
+	var info HealthInfo
+	info.Sys.Partitions = []Partitions{{Addr: "node1", Partitions: []Partition{hdd, nvme}}}
+	recs := RecommendHardware(info, HardwareAdvisorThresholds{})
+	if len(recs) != 1 || recs[0].Check != HardwareCheckIOScheduler || recs[0].Node != "node1" {
+		t.Errorf("unexpected recommendations %+v", recs)
+	}
+}
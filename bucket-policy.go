@@ -0,0 +1,273 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// BucketAccess - anonymous access granted by a bucket policy.
+type BucketAccess string
+
+// Anonymous access patterns.
+const (
+	// BucketAccessNone - no anonymous access.
+	BucketAccessNone BucketAccess = "none"
+	// BucketAccessDownload - anonymous listing and reading.
+	BucketAccessDownload BucketAccess = "download"
+	// BucketAccessUpload - anonymous writing and multipart uploads.
+	BucketAccessUpload BucketAccess = "upload"
+	// BucketAccessPublic - download and upload.
+	BucketAccessPublic BucketAccess = "public"
+	// BucketAccessCustom - anonymous access matching none of the above.
+	BucketAccessCustom BucketAccess = "custom"
+)
+
+var (
+	bucketDownloadBucketActions = []string{"s3:GetBucketLocation", "s3:ListBucket"}
+	bucketDownloadObjectActions = []string{"s3:GetObject"}
+	bucketUploadBucketActions   = []string{"s3:GetBucketLocation", "s3:ListBucketMultipartUploads"}
+	bucketUploadObjectActions   = []string{"s3:AbortMultipartUpload", "s3:DeleteObject", "s3:ListMultipartUploadParts", "s3:PutObject"}
+)
+
+type bucketPolicyStatement struct {
+	Effect    string                     `json:"Effect"`
+	Principal json.RawMessage            `json:"Principal,omitempty"`
+	Action    policyActionSet            `json:"Action"`
+	Resource  policyActionSet            `json:"Resource"`
+	Condition map[string]json.RawMessage `json:"Condition,omitempty"`
+}
+
+type bucketPolicy struct {
+	Version   string                  `json:"Version"`
+	Statement []bucketPolicyStatement `json:"Statement"`
+}
+
+// anonymous - returns true if the principal is everyone.
+func (s bucketPolicyStatement) anonymous() bool {
+	var principal string
+	if json.Unmarshal(s.Principal, &principal) == nil {
+		return principal == "*"
+	}
+	var aws struct {
+		AWS policyActionSet
+	}
+	if json.Unmarshal(s.Principal, &aws) != nil {
+		return false
+	}
+	for _, p := range aws.AWS {
+		if p == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// NewBucketAccessPolicy - returns a bucket policy granting anonymous
+// access to the objects of bucket below prefix, matching the canned
+// policies of the mc client.
+func NewBucketAccessPolicy(bucket, prefix string, access BucketAccess) ([]byte, error) {
+	if bucket == "" {
+		return nil, ErrInvalidArgument("Bucket name cannot be empty.")
+	}
+
+	var bucketActions, objectActions []string
+	switch access {
+	case BucketAccessDownload:
+		bucketActions, objectActions = bucketDownloadBucketActions, bucketDownloadObjectActions
+	case BucketAccessUpload:
+		bucketActions, objectActions = bucketUploadBucketActions, bucketUploadObjectActions
+	case BucketAccessPublic:
+		bucketActions = mergeActions(bucketDownloadBucketActions, bucketUploadBucketActions)
+		objectActions = mergeActions(bucketDownloadObjectActions, bucketUploadObjectActions)
+	default:
+		return nil, ErrInvalidArgument("Invalid bucket access " + string(access) + ".")
+	}
+
+	anyone := json.RawMessage(`{"AWS":["*"]}`)
+	bucketStatement := bucketPolicyStatement{
+		Effect:    "Allow",
+		Principal: anyone,
+		Action:    bucketActions,
+		Resource:  policyActionSet{"arn:aws:s3:::" + bucket},
+	}
+	if prefix != "" {
+		// Only listings below the prefix are allowed.
+		for i, action := range bucketActions {
+			if action == "s3:ListBucket" {
+				bucketStatement.Action = append(append(policyActionSet{}, bucketActions[:i]...), bucketActions[i+1:]...)
+				condition, _ := json.Marshal(map[string][]string{"s3:prefix": {prefix}})
+				listStatement := bucketPolicyStatement{
+					Effect:    "Allow",
+					Principal: anyone,
+					Action:    policyActionSet{action},
+					Resource:  policyActionSet{"arn:aws:s3:::" + bucket},
+					Condition: map[string]json.RawMessage{"StringEquals": condition},
+				}
+				return marshalBucketPolicy(bucketStatement, listStatement, objectStatement(bucket, prefix, objectActions, anyone))
+			}
+		}
+	}
+	return marshalBucketPolicy(bucketStatement, objectStatement(bucket, prefix, objectActions, anyone))
+}
+
+func objectStatement(bucket, prefix string, actions []string, principal json.RawMessage) bucketPolicyStatement {
+	return bucketPolicyStatement{
+		Effect:    "Allow",
+		Principal: principal,
+		Action:    actions,
+		Resource:  policyActionSet{"arn:aws:s3:::" + bucket + "/" + prefix + "*"},
+	}
+}
+
+func marshalBucketPolicy(statements ...bucketPolicyStatement) ([]byte, error) {
+	return json.Marshal(bucketPolicy{Version: "2012-10-17", Statement: statements})
+}
+
+func mergeActions(a, b []string) []string {
+	seen := make(map[string]struct{}, len(a)+len(b))
+	var merged []string
+	for _, action := range append(append([]string{}, a...), b...) {
+		if _, ok := seen[action]; !ok {
+			seen[action] = struct{}{}
+			merged = append(merged, action)
+		}
+	}
+	sort.Strings(merged)
+	return merged
+}
+
+// ParseBucketAccess - classifies the anonymous access a bucket policy
+// grants by whether anyone may read (s3:GetObject) or write
+// (s3:PutObject) objects. Deny statements and conditions are not
+// evaluated, so the result is an upper bound.
+func ParseBucketAccess(policy []byte) (BucketAccess, error) {
+	if len(policy) == 0 {
+		return BucketAccessNone, nil
+	}
+	var p bucketPolicy
+	if err := json.Unmarshal(policy, &p); err != nil {
+		return "", err
+	}
+
+	var anonymous, download, upload bool
+	for _, st := range p.Statement {
+		if !strings.EqualFold(st.Effect, "allow") || !st.anonymous() {
+			continue
+		}
+		anonymous = true
+		for _, pattern := range st.Action {
+			pattern = strings.ToLower(pattern)
+			download = download || wildcardMatch(pattern, "s3:getobject")
+			upload = upload || wildcardMatch(pattern, "s3:putobject")
+		}
+	}
+	switch {
+	case download && upload:
+		return BucketAccessPublic, nil
+	case download:
+		return BucketAccessDownload, nil
+	case upload:
+		return BucketAccessUpload, nil
+	case anonymous:
+		return BucketAccessCustom, nil
+	}
+	return BucketAccessNone, nil
+}
+
+// GetBucketPolicy - returns the policy of a bucket, empty if it has
+// none.
+func (adm *AdminClient) GetBucketPolicy(ctx context.Context, bucket string) ([]byte, error) {
+	if bucket == "" {
+		return nil, ErrInvalidArgument("Bucket name cannot be empty.")
+	}
+	var policy []byte
+	if err := adm.s3Request(ctx, http.MethodGet, bucket, "policy", nil, nil, &policy); err != nil {
+		if ToErrorResponse(err).Code == "NoSuchBucketPolicy" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
+// SetBucketPolicy - replaces the policy of a bucket, an empty policy
+// removes it.
+func (adm *AdminClient) SetBucketPolicy(ctx context.Context, bucket string, policy []byte) error {
+	if bucket == "" {
+		return ErrInvalidArgument("Bucket name cannot be empty.")
+	}
+	if len(policy) == 0 {
+		return adm.s3Request(ctx, http.MethodDelete, bucket, "policy", nil, nil, nil)
+	}
+	return adm.s3Request(ctx, http.MethodPut, bucket, "policy", nil, policy, nil)
+}
+
+// SetBucketAccess - sets a policy granting the anonymous access to the
+// objects of bucket below prefix, BucketAccessNone removes the policy.
+func (adm *AdminClient) SetBucketAccess(ctx context.Context, bucket, prefix string, access BucketAccess) error {
+	if access == BucketAccessNone {
+		return adm.SetBucketPolicy(ctx, bucket, nil)
+	}
+	policy, err := NewBucketAccessPolicy(bucket, prefix, access)
+	if err != nil {
+		return err
+	}
+	return adm.SetBucketPolicy(ctx, bucket, policy)
+}
+
+// BucketAnonymousAccess - a bucket allowing anonymous access.
+type BucketAnonymousAccess struct {
+	Bucket string          `json:"bucket"`
+	Access BucketAccess    `json:"access"`
+	Policy json.RawMessage `json:"policy"`
+}
+
+// AuditAnonymousAccess - returns all buckets whose policy grants any
+// anonymous access.
+func (adm *AdminClient) AuditAnonymousAccess(ctx context.Context) ([]BucketAnonymousAccess, error) {
+	var list struct {
+		Buckets struct {
+			Bucket []struct {
+				Name string
+			}
+		}
+	}
+	if err := adm.s3Request(ctx, http.MethodGet, "", "", nil, nil, &list); err != nil {
+		return nil, err
+	}
+
+	var audit []BucketAnonymousAccess
+	for _, b := range list.Buckets.Bucket {
+		policy, err := adm.GetBucketPolicy(ctx, b.Name)
+		if err != nil {
+			return nil, err
+		}
+		access, err := ParseBucketAccess(policy)
+		if err != nil {
+			return nil, err
+		}
+		if access != BucketAccessNone {
+			audit = append(audit, BucketAnonymousAccess{Bucket: b.Name, Access: access, Policy: policy})
+		}
+	}
+	return audit, nil
+}
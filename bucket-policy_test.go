@@ -0,0 +1,108 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestBucketAccessPolicy(t *testing.T) {
+	for _, access := range []BucketAccess{BucketAccessDownload, BucketAccessUpload, BucketAccessPublic} {
+		for _, prefix := range []string{"", "shared/"} {
+			policy, err := NewBucketAccessPolicy("bucket", prefix, access)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(policy), "arn:aws:s3:::bucket/"+prefix+"*") {
+				t.Errorf("%s %q: missing object resource in %s", access, prefix, policy)
+			}
+			got, err := ParseBucketAccess(policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != access {
+				t.Errorf("%s %q: parsed as %s", access, prefix, got)
+			}
+		}
+	}
+	if _, err := NewBucketAccessPolicy("bucket", "", BucketAccessCustom); err == nil {
+		t.Error("expected an error for custom access")
+	}
+
+	for policy, want := range map[string]BucketAccess{
+		``: BucketAccessNone,
+		`{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::1:root"]},"Action":"s3:*","Resource":"*"}]}`: BucketAccessNone,
+		`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:*","Resource":"*"}]}`:                             BucketAccessPublic,
+		`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:ListBucket","Resource":"*"}]}`:                    BucketAccessCustom,
+	} {
+		got, err := ParseBucketAccess([]byte(policy))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("%s: expected %s, got %s", policy, want, got)
+		}
+	}
+}
+
+func TestAuditAnonymousAccess(t *testing.T) {
+	download, _ := NewBucketAccessPolicy("public", "", BucketAccessDownload)
+	var put []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/":
+			w.Write([]byte(`<ListAllMyBucketsResult><Buckets><Bucket><Name>private</Name></Bucket><Bucket><Name>public</Name></Bucket></Buckets></ListAllMyBucketsResult>`))
+		case r.URL.Path == "/public" && r.Method == http.MethodGet:
+			w.Write(download)
+		case r.URL.Path == "/private" && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`<Error><Code>NoSuchBucketPolicy</Code><Message>The bucket policy does not exist</Message></Error>`))
+		case r.Method == http.MethodPut:
+			put, _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	audit, err := adm.AuditAnonymousAccess(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(audit) != 1 || audit[0].Bucket != "public" || audit[0].Access != BucketAccessDownload {
+		t.Errorf("unexpected audit %+v", audit)
+	}
+
+	if err = adm.SetBucketAccess(context.Background(), "private", "", BucketAccessUpload); err != nil {
+		t.Fatal(err)
+	}
+	if access, _ := ParseBucketAccess(put); access != BucketAccessUpload {
+		t.Errorf("expected an upload policy, got %s", put)
+	}
+}
@@ -0,0 +1,87 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+type callOptionsKey struct{}
+
+// callOptions - per-call overrides carried by the context.
+type callOptions struct {
+	node     string
+	timeout  time.Duration
+	maxRetry int
+}
+
+func callOptionsFrom(ctx context.Context) callOptions {
+	opts, _ := ctx.Value(callOptionsKey{}).(callOptions)
+	return opts
+}
+
+func withCallOptions(ctx context.Context, update func(*callOptions)) context.Context {
+	opts := callOptionsFrom(ctx)
+	update(&opts)
+	return context.WithValue(ctx, callOptionsKey{}, opts)
+}
+
+// WithNode - returns a context sending the requests of calls made with
+// it directly to node, a host:port of a server of the cluster, instead
+// of the endpoint of the client, e.g. to bypass a load balancer.
+func WithNode(ctx context.Context, node string) context.Context {
+	return withCallOptions(ctx, func(o *callOptions) { o.node = node })
+}
+
+// WithTargetNode - returns a context sending the requests of calls made
+// with it to addr, e.g. to collect the health, trace or profile of a
+// specific node behind a load balancer. It bypasses the endpoint
+// selection of WithEndpoints and is equivalent to WithNode.
+func WithTargetNode(ctx context.Context, addr string) context.Context {
+	return WithNode(ctx, addr)
+}
+
+// WithCallTimeout - returns a context bounding calls made with it,
+// including retries and reading the response, to timeout.
+func WithCallTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return withCallOptions(ctx, func(o *callOptions) { o.timeout = timeout })
+}
+
+// WithMaxRetry - returns a context overriding the MaxRetry of the
+// RetryPolicy for calls made with it, 1 disables retries.
+func WithMaxRetry(ctx context.Context, maxRetry int) context.Context {
+	return withCallOptions(ctx, func(o *callOptions) { o.maxRetry = maxRetry })
+}
+
+// NodeFromContext - returns the node set by WithNode, if any.
+func NodeFromContext(ctx context.Context) string {
+	return callOptionsFrom(ctx).node
+}
+
+// cancelReadCloser - cancels the context of a call once its response
+// body is closed.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c cancelReadCloser) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
@@ -0,0 +1,68 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCallOptions(t *testing.T) {
+	var lbCalls, nodeCalls int32
+	lb := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&lbCalls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer lb.Close()
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&nodeCalls, 1)
+		if r.URL.Query().Get("node") == "slow" {
+			time.Sleep(time.Second)
+		}
+		w.Write([]byte(`{"addr":"node"}`))
+	}))
+	defer node.Close()
+
+	u, _ := url.Parse(lb.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := WithMaxRetry(context.Background(), 1)
+	if _, err = adm.GetRuntimeStats(ctx, ""); err == nil || lbCalls != 1 {
+		t.Fatalf("expected a single failed attempt, got %d: %v", lbCalls, err)
+	}
+
+	nu, _ := url.Parse(node.URL)
+	ctx = WithNode(ctx, nu.Host)
+	stats, err := adm.GetRuntimeStats(ctx, "")
+	if err != nil || stats.Addr != "node" || nodeCalls != 1 || lbCalls != 1 {
+		t.Fatalf("expected call to node, got %+v %v", stats, err)
+	}
+
+	ctx = WithCallTimeout(ctx, 50*time.Millisecond)
+	if _, err = adm.GetRuntimeStats(ctx, "slow"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
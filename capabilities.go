@@ -0,0 +1,268 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// AdminAction - admin policy action as used in IAM policies.
+type AdminAction string
+
+// Admin policy actions.
+const (
+	HealAdminAction                 AdminAction = "admin:Heal"
+	StorageInfoAdminAction          AdminAction = "admin:StorageInfo"
+	DataUsageInfoAdminAction        AdminAction = "admin:DataUsageInfo"
+	TopLocksAdminAction             AdminAction = "admin:TopLocksInfo"
+	ProfilingAdminAction            AdminAction = "admin:Profiling"
+	TraceAdminAction                AdminAction = "admin:ServerTrace"
+	ConsoleLogAdminAction           AdminAction = "admin:ConsoleLog"
+	KMSCreateKeyAdminAction         AdminAction = "admin:KMSCreateKey"
+	KMSKeyStatusAdminAction         AdminAction = "admin:KMSKeyStatus"
+	ServerInfoAdminAction           AdminAction = "admin:ServerInfo"
+	HealthInfoAdminAction           AdminAction = "admin:OBDInfo"
+	BandwidthMonitorAction          AdminAction = "admin:BandwidthMonitor"
+	ServerUpdateAdminAction         AdminAction = "admin:ServerUpdate"
+	ServiceRestartAdminAction       AdminAction = "admin:ServiceRestart"
+	ServiceStopAdminAction          AdminAction = "admin:ServiceStop"
+	ConfigUpdateAdminAction         AdminAction = "admin:ConfigUpdate"
+	CreateUserAdminAction           AdminAction = "admin:CreateUser"
+	DeleteUserAdminAction           AdminAction = "admin:DeleteUser"
+	ListUsersAdminAction            AdminAction = "admin:ListUsers"
+	EnableUserAdminAction           AdminAction = "admin:EnableUser"
+	DisableUserAdminAction          AdminAction = "admin:DisableUser"
+	GetUserAdminAction              AdminAction = "admin:GetUser"
+	AddUserToGroupAdminAction       AdminAction = "admin:AddUserToGroup"
+	RemoveUserFromGroupAdminAction  AdminAction = "admin:RemoveUserFromGroup"
+	GetGroupAdminAction             AdminAction = "admin:GetGroup"
+	ListGroupsAdminAction           AdminAction = "admin:ListGroups"
+	EnableGroupAdminAction          AdminAction = "admin:EnableGroup"
+	DisableGroupAdminAction         AdminAction = "admin:DisableGroup"
+	CreatePolicyAdminAction         AdminAction = "admin:CreatePolicy"
+	DeletePolicyAdminAction         AdminAction = "admin:DeletePolicy"
+	GetPolicyAdminAction            AdminAction = "admin:GetPolicy"
+	AttachPolicyAdminAction         AdminAction = "admin:AttachUserOrGroupPolicy"
+	ListUserPoliciesAdminAction     AdminAction = "admin:ListUserPolicies"
+	CreateServiceAccountAdminAction AdminAction = "admin:CreateServiceAccount"
+	UpdateServiceAccountAdminAction AdminAction = "admin:UpdateServiceAccount"
+	RemoveServiceAccountAdminAction AdminAction = "admin:RemoveServiceAccount"
+	ListServiceAccountsAdminAction  AdminAction = "admin:ListServiceAccounts"
+	SetBucketQuotaAdminAction       AdminAction = "admin:SetBucketQuota"
+	GetBucketQuotaAdminAction       AdminAction = "admin:GetBucketQuota"
+	SetBucketTargetAction           AdminAction = "admin:SetBucketTarget"
+	GetBucketTargetAction           AdminAction = "admin:GetBucketTarget"
+	SetTierAction                   AdminAction = "admin:SetTier"
+	ListTierAction                  AdminAction = "admin:ListTier"
+	ForceUnlockAdminAction          AdminAction = "admin:ForceUnlock"
+	AllAdminActions                 AdminAction = "admin:*"
+)
+
+// AdminActions - list of all known admin actions, in the order they
+// are reported by ProbePermissions.
+var AdminActions = []AdminAction{
+	HealAdminAction, StorageInfoAdminAction, DataUsageInfoAdminAction,
+	TopLocksAdminAction, ProfilingAdminAction, TraceAdminAction,
+	ConsoleLogAdminAction, KMSCreateKeyAdminAction, KMSKeyStatusAdminAction,
+	ServerInfoAdminAction, HealthInfoAdminAction, BandwidthMonitorAction,
+	ServerUpdateAdminAction, ServiceRestartAdminAction, ServiceStopAdminAction,
+	ConfigUpdateAdminAction, CreateUserAdminAction, DeleteUserAdminAction,
+	ListUsersAdminAction, EnableUserAdminAction, DisableUserAdminAction,
+	GetUserAdminAction, AddUserToGroupAdminAction, RemoveUserFromGroupAdminAction,
+	GetGroupAdminAction, ListGroupsAdminAction, EnableGroupAdminAction,
+	DisableGroupAdminAction, CreatePolicyAdminAction, DeletePolicyAdminAction,
+	GetPolicyAdminAction, AttachPolicyAdminAction, ListUserPoliciesAdminAction,
+	CreateServiceAccountAdminAction, UpdateServiceAccountAdminAction,
+	RemoveServiceAccountAdminAction, ListServiceAccountsAdminAction,
+	SetBucketQuotaAdminAction, GetBucketQuotaAdminAction, SetBucketTargetAction,
+	GetBucketTargetAction, SetTierAction, ListTierAction, ForceUnlockAdminAction,
+}
+
+// Capabilities - set of admin actions the credentials are allowed to perform.
+type Capabilities map[AdminAction]bool
+
+// Can - returns true if action is allowed.
+func (c Capabilities) Can(action AdminAction) bool {
+	return c[action]
+}
+
+// Allowed - returns all allowed actions.
+func (c Capabilities) Allowed() []AdminAction {
+	var actions []AdminAction
+	for _, action := range AdminActions {
+		if c[action] {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+// policyActionSet - a policy "Action" value which may either be a
+// single string or an array of strings.
+type policyActionSet []string
+
+func (s *policyActionSet) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*s = policyActionSet{single}
+		return nil
+	}
+	var multi []string
+	if err := json.Unmarshal(data, &multi); err != nil {
+		return err
+	}
+	*s = multi
+	return nil
+}
+
+func (s policyActionSet) match(action AdminAction) bool {
+	for _, pattern := range s {
+		if wildcardMatch(strings.ToLower(pattern), strings.ToLower(string(action))) {
+			return true
+		}
+	}
+	return false
+}
+
+type policyStatement struct {
+	Effect    string          `json:"Effect"`
+	Action    policyActionSet `json:"Action,omitempty"`
+	NotAction policyActionSet `json:"NotAction,omitempty"`
+}
+
+func (s policyStatement) match(action AdminAction) bool {
+	if len(s.NotAction) > 0 {
+		return !s.NotAction.match(action)
+	}
+	return s.Action.match(action)
+}
+
+// EvaluateAdminPolicy - evaluates the admin actions of an IAM policy
+// document, explicit denies take precedence over allows. Conditions
+// are not evaluated, so the result is an upper bound.
+func EvaluateAdminPolicy(policy []byte) (Capabilities, error) {
+	caps := make(Capabilities, len(AdminActions))
+	if len(policy) == 0 || string(policy) == "null" {
+		return caps, nil
+	}
+
+	var doc struct {
+		Statement []policyStatement `json:"Statement"`
+	}
+	if err := json.Unmarshal(policy, &doc); err != nil {
+		return nil, err
+	}
+
+	for _, action := range AdminActions {
+		var allowed, denied bool
+		for _, st := range doc.Statement {
+			if !st.match(action) {
+				continue
+			}
+			switch strings.ToLower(st.Effect) {
+			case "allow":
+				allowed = true
+			case "deny":
+				denied = true
+			}
+		}
+		caps[action] = allowed && !denied
+	}
+	return caps, nil
+}
+
+// ProbePermissions - returns the admin actions the current credentials
+// may perform, based on the policy reported by the server for the
+// authenticated account. Read-only clients never report mutating
+// capabilities since those calls are refused locally.
+func (adm *AdminClient) ProbePermissions(ctx context.Context) (Capabilities, error) {
+	info, err := adm.AccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	caps, err := EvaluateAdminPolicy(info.Policy)
+	if err != nil {
+		return nil, err
+	}
+	if adm.readOnly {
+		for action := range caps {
+			if _, ok := readOnlyAdminActions[action]; !ok {
+				caps[action] = false
+			}
+		}
+	}
+	return caps, nil
+}
+
+// readOnlyAdminActions - admin actions which never mutate server state.
+var readOnlyAdminActions = map[AdminAction]struct{}{
+	StorageInfoAdminAction:         {},
+	DataUsageInfoAdminAction:       {},
+	TopLocksAdminAction:            {},
+	TraceAdminAction:               {},
+	ConsoleLogAdminAction:          {},
+	KMSKeyStatusAdminAction:        {},
+	ServerInfoAdminAction:          {},
+	HealthInfoAdminAction:          {},
+	BandwidthMonitorAction:         {},
+	ListUsersAdminAction:           {},
+	GetUserAdminAction:             {},
+	GetGroupAdminAction:            {},
+	ListGroupsAdminAction:          {},
+	GetPolicyAdminAction:           {},
+	ListUserPoliciesAdminAction:    {},
+	ListServiceAccountsAdminAction: {},
+	GetBucketQuotaAdminAction:      {},
+	GetBucketTargetAction:          {},
+	ListTierAction:                 {},
+}
+
+// wildcardMatch - matches name against a pattern where '*' matches any
+// sequence of characters and '?' matches a single character.
+func wildcardMatch(pattern, name string) bool {
+	if pattern == "" {
+		return name == ""
+	}
+	if pattern == "*" {
+		return true
+	}
+	p, n := []rune(pattern), []rune(name)
+	var pi, ni int
+	star, match := -1, 0
+	for ni < len(n) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == n[ni]):
+			pi++
+			ni++
+		case pi < len(p) && p[pi] == '*':
+			star, match = pi, ni
+			pi++
+		case star != -1:
+			pi = star + 1
+			match++
+			ni = match
+		default:
+			return false
+		}
+	}
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+	return pi == len(p)
+}
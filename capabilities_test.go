@@ -0,0 +1,70 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"testing"
+)
+
+func TestEvaluateAdminPolicy(t *testing.T) {
+	testCases := []struct {
+		policy  string
+		allowed []AdminAction
+		denied  []AdminAction
+	}{
+		{
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["admin:*"]},{"Effect":"Deny","Action":"admin:Service*"}]}`,
+			allowed: []AdminAction{HealAdminAction, ServerInfoAdminAction},
+			denied:  []AdminAction{ServiceRestartAdminAction, ServiceStopAdminAction},
+		},
+		{
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["admin:ServerInfo","admin:List?ier","s3:*"]}]}`,
+			allowed: []AdminAction{ServerInfoAdminAction, ListTierAction},
+			denied:  []AdminAction{HealAdminAction, CreateUserAdminAction},
+		},
+		{
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":["admin:Heal"]}]}`,
+			allowed: []AdminAction{ProfilingAdminAction},
+			denied:  []AdminAction{HealAdminAction},
+		},
+		{
+			policy: ``,
+			denied: []AdminAction{ServerInfoAdminAction},
+		},
+	}
+
+	for i, testCase := range testCases {
+		caps, err := EvaluateAdminPolicy([]byte(testCase.policy))
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		for _, action := range testCase.allowed {
+			if !caps.Can(action) {
+				t.Errorf("Test %d: expected %s to be allowed", i+1, action)
+			}
+		}
+		for _, action := range testCase.denied {
+			if caps.Can(action) {
+				t.Errorf("Test %d: expected %s to be denied", i+1, action)
+			}
+		}
+	}
+
+	if _, err := EvaluateAdminPolicy([]byte(`{"Statement":`)); err == nil {
+		t.Fatal("expected error for malformed policy")
+	}
+}
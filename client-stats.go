@@ -0,0 +1,196 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ClientStats - metrics about the requests made by an AdminClient.
+type ClientStats struct {
+	// Requests counts HTTP requests, retries included, by admin API
+	// endpoint, e.g. "/info".
+	Requests map[string]uint64 `json:"requests"`
+	// Retries counts requests repeated after a retryable failure.
+	Retries uint64 `json:"retries"`
+	// Errors counts failed requests by S3 error code, HTTP status
+	// or "NetworkError".
+	Errors        map[string]uint64 `json:"errors"`
+	BytesSent     ByteSize          `json:"bytesSent"`
+	BytesReceived ByteSize          `json:"bytesReceived"`
+}
+
+// clientStats - the concurrent-safe metrics shared by all copies of
+// an AdminClient.
+type clientStats struct {
+	mu    sync.Mutex
+	stats ClientStats
+}
+
+func newClientStats() *clientStats {
+	return &clientStats{stats: ClientStats{
+		Requests: make(map[string]uint64),
+		Errors:   make(map[string]uint64),
+	}}
+}
+
+// statsEndpoint - returns the endpoint of relPath without the API
+// version and, for heal, the bucket and prefix.
+func statsEndpoint(relPath string) string {
+	if relPath == nodeMetricsPath {
+		return relPath
+	}
+	var endpoint string
+	switch {
+	case strings.HasPrefix(relPath, adminAPIPrefix+"/"):
+		endpoint = strings.TrimPrefix(relPath, adminAPIPrefix)
+	case strings.HasPrefix(relPath, adminAPIPrefixV2+"/"):
+		// Fallbacks to older servers count as the endpoint called.
+		endpoint = strings.TrimPrefix(relPath, adminAPIPrefixV2)
+	default:
+		// S3 requests are counted together.
+		return "/s3"
+	}
+	if strings.HasPrefix(endpoint, "/heal/") {
+		return "/heal"
+	}
+	return endpoint
+}
+
+func (s *clientStats) request(relPath string, sent int, retry bool) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats.Requests[statsEndpoint(relPath)]++
+	s.stats.BytesSent += ByteSize(sent)
+	if retry {
+		s.stats.Retries++
+	}
+}
+
+func (s *clientStats) failure(code string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats.Errors[code]++
+}
+
+func (s *clientStats) received(n int) {
+	if s == nil || n <= 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats.BytesReceived += ByteSize(n)
+}
+
+// countingReadCloser - counts the response bytes read by callers.
+type countingReadCloser struct {
+	io.ReadCloser
+	stats *clientStats
+}
+
+func (r countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.stats.received(n)
+	return n, err
+}
+
+// countResponse - counts the body of resp towards the received bytes.
+func (s *clientStats) countResponse(resp *http.Response) {
+	if s == nil || resp == nil || resp.Body == nil {
+		return
+	}
+	resp.Body = countingReadCloser{ReadCloser: resp.Body, stats: s}
+}
+
+// Stats - returns a snapshot of the metrics of this client, shared by
+// all its copies and safe to call concurrently.
+func (adm *AdminClient) Stats() ClientStats {
+	stats := ClientStats{
+		Requests: make(map[string]uint64),
+		Errors:   make(map[string]uint64),
+	}
+	s := adm.stats
+	if s == nil {
+		return stats
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range s.stats.Requests {
+		stats.Requests[k] = v
+	}
+	for k, v := range s.stats.Errors {
+		stats.Errors[k] = v
+	}
+	stats.Retries = s.stats.Retries
+	stats.BytesSent = s.stats.BytesSent
+	stats.BytesReceived = s.stats.BytesReceived
+	return stats
+}
+
+// WritePrometheus - writes the metrics in the Prometheus text format,
+// a prometheus.Collector can be built by parsing it with expfmt or by
+// turning each field into a const metric:
+//
+//	func (c collector) Collect(ch chan<- prometheus.Metric) {
+//		stats := c.adm.Stats()
+//		for endpoint, n := range stats.Requests {
+//			ch <- prometheus.MustNewConstMetric(requestsDesc, prometheus.CounterValue, float64(n), endpoint)
+//		}
+//		...
+//	}
+func (s ClientStats) WritePrometheus(w io.Writer) error {
+	var b strings.Builder
+	counter := func(name, help string) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+	}
+	labeled := func(name, label string, values map[string]uint64) {
+		keys := make([]string, 0, len(values))
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%s{%s=%s} %d\n", name, label, strconv.Quote(k), values[k])
+		}
+	}
+
+	counter("madmin_client_requests_total", "HTTP requests sent by endpoint, retries included.")
+	labeled("madmin_client_requests_total", "endpoint", s.Requests)
+	counter("madmin_client_retries_total", "HTTP requests retried after a retryable failure.")
+	fmt.Fprintf(&b, "madmin_client_retries_total %d\n", s.Retries)
+	counter("madmin_client_errors_total", "Failed HTTP requests by error code.")
+	labeled("madmin_client_errors_total", "code", s.Errors)
+	counter("madmin_client_sent_bytes_total", "Request body bytes sent.")
+	fmt.Fprintf(&b, "madmin_client_sent_bytes_total %d\n", uint64(s.BytesSent))
+	counter("madmin_client_received_bytes_total", "Response body bytes received.")
+	fmt.Fprintf(&b, "madmin_client_received_bytes_total %d\n", uint64(s.BytesReceived))
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,101 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestClientStats(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/heal/bucket/prefix") {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"Code":"AccessDenied"}`))
+			return
+		}
+		w.Write([]byte(`{"mode":"online"}`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := adm.ServerInfo(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if _, _, err = adm.Heal(context.Background(), "bucket", "prefix", HealOpts{}, "", false, false); err == nil {
+		t.Fatal("expected heal to be denied")
+	}
+
+	stats := adm.Stats()
+	if stats.Requests["/info"] != 4 || stats.Requests["/heal"] != 1 {
+		t.Errorf("unexpected requests %v", stats.Requests)
+	}
+	if stats.Errors["AccessDenied"] != 1 || stats.Retries != 0 {
+		t.Errorf("unexpected errors %v, retries %d", stats.Errors, stats.Retries)
+	}
+	if stats.BytesReceived != ByteSize(4*len(`{"mode":"online"}`)+len(`{"Code":"AccessDenied"}`)) {
+		t.Errorf("unexpected received bytes %d", stats.BytesReceived)
+	}
+
+	var buf bytes.Buffer
+	if err = stats.WritePrometheus(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		`madmin_client_requests_total{endpoint="/info"} 4`,
+		`madmin_client_errors_total{code="AccessDenied"} 1`,
+		`madmin_client_retries_total 0`,
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Errorf("missing %q in\n%s", line, buf.String())
+		}
+	}
+}
+
+func TestStatsEndpoint(t *testing.T) {
+	for relPath, want := range map[string]string{
+		adminAPIPrefix + "/storageinfo":        "/storageinfo",
+		adminAPIPrefixV2 + "/storageinfo":      "/storageinfo",
+		adminAPIPrefixV2 + "/datausageinfo":    "/datausageinfo",
+		adminAPIPrefix + "/heal/bucket/prefix": "/heal",
+		nodeMetricsPath:                        nodeMetricsPath,
+		"/bucket/object":                       "/s3",
+	} {
+		if got := statsEndpoint(relPath); got != want {
+			t.Errorf("%s: expected %s, got %s", relPath, want, got)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	crand "crypto/rand"
+	"io"
+	"math/rand"
+	"time"
+)
+
+// Clock - a source of the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc - adapts a function to the Clock interface.
+type ClockFunc func() time.Time
+
+// Now - returns f().
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// SystemClock - the real time, the default Clock.
+var SystemClock Clock = ClockFunc(time.Now)
+
+// SetClock - sets the clock of the timestamps generated by the client,
+// e.g. of health reports and retention change logs, so that consumers
+// can write deterministic tests. nil restores SystemClock. Not safe for
+// concurrent use, call it before using the client.
+func (adm *AdminClient) SetClock(clock Clock) {
+	adm.clock = clock
+}
+
+// SetRandSource - sets the source of randomness of the keys encrypting
+// request payloads, so that consumers can write deterministic tests.
+// nil restores crypto/rand. Never set a predictable source outside of
+// tests. Not safe for concurrent use, call it before using the client.
+func (adm *AdminClient) SetRandSource(src io.Reader) {
+	adm.randSource = src
+}
+
+// SetJitterSource - sets the source of the retry jitter, so that
+// consumers can write deterministic tests. It is separate from the rand
+// source of keys, so jitter never consumes key material. nil restores a
+// time seeded source. Not safe for concurrent use, call it before using
+// the client.
+func (adm *AdminClient) SetJitterSource(src rand.Source) {
+	adm.random = newRandom(src)
+}
+
+// now - returns the current time of the client clock.
+func (adm *AdminClient) now() time.Time {
+	if adm.clock == nil {
+		return SystemClock.Now()
+	}
+	return adm.clock.Now()
+}
+
+// randReader - returns the source of randomness for key generation.
+func (adm *AdminClient) randReader() io.Reader {
+	if adm.randSource == nil {
+		return crand.Reader
+	}
+	return adm.randSource
+}
+
+// newRandom - returns a pseudo-random generator for jitter reading src,
+// a time seeded source if nil.
+func newRandom(src rand.Source) *rand.Rand {
+	if src == nil {
+		src = rand.NewSource(time.Now().UTC().UnixNano())
+	}
+	return rand.New(&lockedRandSource{src: src})
+}
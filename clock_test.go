@@ -0,0 +1,101 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestClock(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"version":"` + HealthInfoVersion + `"}`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixed := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	adm.SetClock(ClockFunc(func() time.Time { return fixed }))
+	info, err := adm.collectHealthInfo(context.Background(), []HealthDataType{HealthDataTypeSysTime}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.TimeStamp.Equal(fixed) {
+		t.Errorf("expected timestamp %v, got %v", fixed, info.TimeStamp)
+	}
+
+	adm.SetClock(nil)
+	if adm.now().Before(fixed) {
+		t.Error("expected the system clock after resetting the clock")
+	}
+
+	a, err := NewAggregator(func(ctx context.Context, node string) (HealthInfo, error) {
+		return HealthInfo{}, nil
+	}, AggregatorOptions{Clock: ClockFunc(func() time.Time { return fixed })})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info, _ = a.Aggregate(context.Background(), []string{"node1"}); !info.TimeStamp.Equal(fixed) {
+		t.Errorf("expected aggregated timestamp %v, got %v", fixed, info.TimeStamp)
+	}
+}
+
+func TestRandSource(t *testing.T) {
+	adm, err := New("localhost:9000", "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jitter := func() []int64 {
+		adm.SetJitterSource(rand.NewSource(42))
+		var values []int64
+		for i := 0; i < 4; i++ {
+			values = append(values, adm.random.Int63())
+		}
+		return values
+	}
+	first, second := jitter(), jitter()
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("expected equal values for equal jitter sources, got %v and %v", first, second)
+		}
+	}
+
+	// Jitter does not consume the rand source of keys.
+	keys := bytes.NewReader(make([]byte, 64))
+	adm.SetRandSource(keys)
+	adm.SetJitterSource(nil)
+	adm.random.Int63()
+	if keys.Len() != 64 || adm.randReader() != keys {
+		t.Errorf("expected the rand source to be used for keys only, %d bytes left", keys.Len())
+	}
+
+	adm.SetRandSource(nil)
+	if adm.randReader() == nil {
+		t.Error("expected crypto/rand after resetting the rand source")
+	}
+}
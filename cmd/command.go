@@ -0,0 +1,191 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package cmd provides admin CLI command factories built on madmin, so
+// custom admin CLIs can be assembled without re-implementing flag
+// parsing and output formatting.
+//
+// Commands only depend on the standard library flag package. They can
+// be run standalone with Execute, or wrapped as cobra commands:
+//
+//	func toCobra(c *cmd.Command) *cobra.Command {
+//		cc := &cobra.Command{Use: c.Use, Short: c.Short}
+//		if c.Run != nil {
+//			cc.RunE = func(cc *cobra.Command, args []string) error {
+//				return c.Run(cc.Context(), args)
+//			}
+//		}
+//		cc.Flags().AddGoFlagSet(c.Flags)
+//		for _, sub := range c.Subcommands {
+//			cc.AddCommand(toCobra(sub))
+//		}
+//		return cc
+//	}
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/minio/madmin-go"
+)
+
+// ErrUsage is returned when a command is called with invalid arguments.
+var ErrUsage = errors.New("invalid usage")
+
+// Env - shared environment of all commands.
+type Env struct {
+	// Client returns the admin client commands run against, e.g.
+	// built from global flags or with madmin.NewFromMCAlias.
+	Client func() (*madmin.AdminClient, error)
+	// Out receives command output, defaults to os.Stdout.
+	Out io.Writer
+	// JSON selects JSON output, also set by the --json flag.
+	JSON bool
+}
+
+func (env *Env) out() io.Writer {
+	if env.Out == nil {
+		return os.Stdout
+	}
+	return env.Out
+}
+
+// print - writes v as JSON or in text form.
+func (env *Env) print(v interface{}, text func(w io.Writer) error) error {
+	if env.JSON || text == nil {
+		enc := json.NewEncoder(env.out())
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	return text(env.out())
+}
+
+// table - writes rows as aligned columns.
+func table(w io.Writer, header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// Command - a CLI command, either a leaf with Run or a group of
+// Subcommands.
+type Command struct {
+	// Use is the one-line usage, the first word is the command name.
+	Use   string
+	Short string
+	Flags *flag.FlagSet
+	// Run is called with the arguments left after flag parsing.
+	Run         func(ctx context.Context, args []string) error
+	Subcommands []*Command
+}
+
+// Name - returns the command name.
+func (c *Command) Name() string {
+	if i := strings.IndexByte(c.Use, ' '); i >= 0 {
+		return c.Use[:i]
+	}
+	return c.Use
+}
+
+// Usage - writes the usage of the command and its subcommands.
+func (c *Command) Usage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: %s\n", c.Use)
+	if c.Short != "" {
+		fmt.Fprintf(w, "\n%s\n", c.Short)
+	}
+	if len(c.Subcommands) > 0 {
+		fmt.Fprintln(w, "\nCommands:")
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		for _, sub := range c.Subcommands {
+			fmt.Fprintf(tw, "  %s\t%s\n", sub.Name(), sub.Short)
+		}
+		tw.Flush()
+	}
+	if c.Flags != nil {
+		fmt.Fprintln(w, "\nFlags:")
+		c.Flags.SetOutput(w)
+		c.Flags.PrintDefaults()
+	}
+}
+
+// Execute - dispatches args to the matching subcommand, parses flags
+// and runs the command.
+func (c *Command) Execute(ctx context.Context, args []string) error {
+	if len(args) > 0 {
+		for _, sub := range c.Subcommands {
+			if sub.Name() == args[0] {
+				return sub.Execute(ctx, args[1:])
+			}
+		}
+	}
+	if c.Run == nil {
+		if len(args) > 0 {
+			return fmt.Errorf("%w: unknown command %q for %s", ErrUsage, args[0], c.Name())
+		}
+		return fmt.Errorf("%w: %s requires a subcommand", ErrUsage, c.Name())
+	}
+	if c.Flags != nil {
+		if err := c.Flags.Parse(args); err != nil {
+			return fmt.Errorf("%w: %v", ErrUsage, err)
+		}
+		args = c.Flags.Args()
+	}
+	return c.Run(ctx, args)
+}
+
+// newFlagSet - returns the flag set of a leaf command, every leaf
+// supports --json.
+func (env *Env) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	fs.BoolVar(&env.JSON, "json", env.JSON, "print output as JSON")
+	return fs
+}
+
+// exactArgs - checks the number of positional arguments.
+func exactArgs(c string, args []string, n int) error {
+	if len(args) != n {
+		return fmt.Errorf("%w: %s expects %d arguments, got %d", ErrUsage, c, n, len(args))
+	}
+	return nil
+}
+
+// NewRootCommand - returns a command named name grouping the health,
+// heal, iam and config commands.
+func NewRootCommand(name string, env *Env) *Command {
+	return &Command{
+		Use:   name + " COMMAND",
+		Short: "MinIO administration",
+		Subcommands: []*Command{
+			NewHealthCommand(env),
+			NewHealCommand(env),
+			NewIAMCommand(env),
+			NewConfigCommand(env),
+		},
+	}
+}
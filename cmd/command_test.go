@@ -0,0 +1,96 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestCommands(t *testing.T) {
+	var calls []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+path.Base(r.URL.Path)+" "+r.URL.RawQuery)
+		if path.Base(r.URL.Path) == "list-canned-policies" {
+			json.NewEncoder(w).Encode(map[string]json.RawMessage{"readwrite": json.RawMessage(`{}`), "acme": json.RawMessage(`{}`)})
+		}
+	}))
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	env := &Env{
+		Client: func() (*madmin.AdminClient, error) {
+			return madmin.New(u.Host, "minioadmin", "minioadmin", false)
+		},
+		Out: &out,
+	}
+	// Commands are built per execution, like in a CLI process.
+	execute := func(args ...string) error {
+		env.JSON = false
+		return NewRootCommand("myadmin", env).Execute(context.Background(), args)
+	}
+
+	if err = execute("iam", "policy", "list"); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "acme\nreadwrite\n" {
+		t.Errorf("unexpected output %q", out.String())
+	}
+
+	out.Reset()
+	if err = execute("iam", "policy", "list", "--json"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), `"readwrite": {}`) {
+		t.Errorf("unexpected JSON output %q", out.String())
+	}
+
+	if err = execute("iam", "policy", "attach", "--group", "devs", "readwrite"); err != nil {
+		t.Fatal(err)
+	}
+	if last := calls[len(calls)-1]; !strings.HasPrefix(last, "PUT set-user-or-group-policy ") || !strings.Contains(last, "isGroup=true") {
+		t.Errorf("unexpected call %q", last)
+	}
+
+	usageErrs := [][]string{
+		{"iam"},
+		{"iam", "unknown"},
+		{"iam", "user", "remove"},
+		{"iam", "user", "status", "bob", "paused"},
+		{"iam", "policy", "attach", "readwrite"},
+		{"heal", "start", "--scan", "quick", "bucket"},
+	}
+	for _, args := range usageErrs {
+		if err = execute(args...); !errors.Is(err, ErrUsage) {
+			t.Errorf("%v: expected ErrUsage, got %v", args, err)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/minio/madmin-go"
+)
+
+// NewConfigCommand - returns the config command group managing the
+// server configuration.
+func NewConfigCommand(env *Env) *Command {
+	return &Command{
+		Use:   "config COMMAND",
+		Short: "manage server configuration",
+		Subcommands: []*Command{
+			newConfigGetCommand(env),
+			newConfigSetCommand(env),
+			newConfigResetCommand(env),
+			newConfigHelpCommand(env),
+		},
+	}
+}
+
+func newConfigGetCommand(env *Env) *Command {
+	return newLeafCommand(env, "get KEY", "print a configuration sub-system", 1, func(ctx context.Context, adm *madmin.AdminClient, args []string) error {
+		kv, err := adm.GetConfigKV(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(env.out(), strings.TrimSpace(string(kv)))
+		return err
+	})
+}
+
+func newConfigSetCommand(env *Env) *Command {
+	c := &Command{
+		Use:   "set KEY K=V [K=V...]",
+		Short: "set configuration keys of a sub-system",
+		Flags: env.newFlagSet("set"),
+	}
+	c.Run = func(ctx context.Context, args []string) error {
+		if len(args) < 2 {
+			return fmt.Errorf("%w: set expects KEY K=V [K=V...]", ErrUsage)
+		}
+		adm, err := env.Client()
+		if err != nil {
+			return err
+		}
+		restart, err := adm.SetConfigKV(ctx, strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+		return env.print(map[string]bool{"restart": restart}, func(w io.Writer) error {
+			if restart {
+				_, err := fmt.Fprintln(w, "Restart the servers to apply the configuration.")
+				return err
+			}
+			return nil
+		})
+	}
+	return c
+}
+
+func newConfigResetCommand(env *Env) *Command {
+	return newLeafCommand(env, "reset KEY", "reset a configuration sub-system to defaults", 1, func(ctx context.Context, adm *madmin.AdminClient, args []string) error {
+		return adm.DelConfigKV(ctx, args[0])
+	})
+}
+
+func newConfigHelpCommand(env *Env) *Command {
+	c := &Command{
+		Use:   "help SUBSYS [KEY]",
+		Short: "describe the keys of a configuration sub-system",
+		Flags: env.newFlagSet("help"),
+	}
+	c.Run = func(ctx context.Context, args []string) error {
+		if len(args) < 1 || len(args) > 2 {
+			return fmt.Errorf("%w: help expects SUBSYS [KEY]", ErrUsage)
+		}
+		key := ""
+		if len(args) == 2 {
+			key = args[1]
+		}
+		adm, err := env.Client()
+		if err != nil {
+			return err
+		}
+		help, err := adm.HelpConfigKV(ctx, args[0], key, false)
+		if err != nil {
+			return err
+		}
+		return env.print(help, func(w io.Writer) error {
+			fmt.Fprintln(w, help.Description)
+			rows := make([][]string, 0, len(help.KeysHelp))
+			for _, kh := range help.KeysHelp {
+				optional := ""
+				if kh.Optional {
+					optional = "(optional)"
+				}
+				rows = append(rows, []string{kh.Key, kh.Type, optional, kh.Description})
+			}
+			return table(w, []string{"KEY", "TYPE", "", "DESCRIPTION"}, rows)
+		})
+	}
+	return c
+}
@@ -0,0 +1,139 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/minio/madmin-go"
+)
+
+// healPollInterval - interval between heal status requests.
+var healPollInterval = time.Second
+
+// NewHealCommand - returns the heal command group.
+func NewHealCommand(env *Env) *Command {
+	return &Command{
+		Use:   "heal COMMAND",
+		Short: "heal buckets and objects",
+		Subcommands: []*Command{
+			newHealStartCommand(env),
+			newHealStatusCommand(env),
+		},
+	}
+}
+
+func newHealStartCommand(env *Env) *Command {
+	c := &Command{
+		Use:   "start [--scan normal|deep] [--dry-run] [--remove] BUCKET [PREFIX]",
+		Short: "heal a bucket recursively and print the healed objects",
+		Flags: env.newFlagSet("start"),
+	}
+	scan := c.Flags.String("scan", "normal", "scan mode, normal or deep")
+	dryRun := c.Flags.Bool("dry-run", false, "only report what would be healed")
+	remove := c.Flags.Bool("remove", false, "remove dangling objects")
+
+	c.Run = func(ctx context.Context, args []string) error {
+		if len(args) < 1 || len(args) > 2 {
+			return fmt.Errorf("%w: heal start expects BUCKET [PREFIX]", ErrUsage)
+		}
+		bucket, prefix := args[0], ""
+		if len(args) == 2 {
+			prefix = args[1]
+		}
+		opts := madmin.HealOpts{Recursive: true, DryRun: *dryRun, Remove: *remove}
+		switch *scan {
+		case "normal":
+			opts.ScanMode = madmin.HealNormalScan
+		case "deep":
+			opts.ScanMode = madmin.HealDeepScan
+		default:
+			return fmt.Errorf("%w: unknown scan mode %q", ErrUsage, *scan)
+		}
+
+		adm, err := env.Client()
+		if err != nil {
+			return err
+		}
+		start, _, err := adm.Heal(ctx, bucket, prefix, opts, "", false, false)
+		if err != nil {
+			return err
+		}
+
+		ticker := time.NewTicker(healPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+			_, status, err := adm.Heal(ctx, bucket, prefix, opts, start.ClientToken, false, false)
+			if err != nil {
+				return err
+			}
+			for _, item := range status.Items {
+				item := item
+				err = env.print(item, func(w io.Writer) error {
+					before, after := item.GetOnlineCounts()
+					_, err := fmt.Fprintf(w, "%s %s/%s: %d -> %d drives online\n",
+						item.Type, item.Bucket, item.Object, before, after)
+					return err
+				})
+				if err != nil {
+					return err
+				}
+			}
+			if status.Summary != "running" {
+				if status.FailureDetail != "" {
+					return fmt.Errorf("heal %s: %s", status.Summary, status.FailureDetail)
+				}
+				return nil
+			}
+		}
+	}
+	return c
+}
+
+func newHealStatusCommand(env *Env) *Command {
+	c := &Command{
+		Use:   "status",
+		Short: "print the background heal status",
+		Flags: env.newFlagSet("status"),
+	}
+	c.Run = func(ctx context.Context, args []string) error {
+		if err := exactArgs("heal status", args, 0); err != nil {
+			return err
+		}
+		adm, err := env.Client()
+		if err != nil {
+			return err
+		}
+		state, err := adm.BackgroundHealStatus(ctx)
+		if err != nil {
+			return err
+		}
+		return env.print(state, func(w io.Writer) error {
+			fmt.Fprintf(w, "Scanned items: %d\n", state.ScannedItemsCount)
+			return madmin.Render(w, madmin.FormatTable, state)
+		})
+	}
+	return c
+}
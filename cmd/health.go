@@ -0,0 +1,96 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go"
+)
+
+// NewHealthCommand - returns the health command collecting a health
+// report of the cluster.
+func NewHealthCommand(env *Env) *Command {
+	c := &Command{
+		Use:   "health [--deadline DURATION] [--types TYPE,...]",
+		Short: "collect a health report of the cluster",
+		Flags: env.newFlagSet("health"),
+	}
+	deadline := c.Flags.Duration("deadline", time.Hour, "maximum duration of the collection")
+	types := c.Flags.String("types", "", "comma separated health data types, all if empty")
+
+	c.Run = func(ctx context.Context, args []string) error {
+		if err := exactArgs("health", args, 0); err != nil {
+			return err
+		}
+		adm, err := env.Client()
+		if err != nil {
+			return err
+		}
+		healthTypes := madmin.HealthDataTypesList
+		if *types != "" {
+			healthTypes = nil
+			for _, t := range strings.Split(*types, ",") {
+				if _, ok := madmin.HealthDataTypesMap[t]; !ok {
+					return fmt.Errorf("%w: unknown health data type %q", ErrUsage, t)
+				}
+				healthTypes = append(healthTypes, madmin.HealthDataType(t))
+			}
+		}
+
+		resp, _, err := adm.ServerHealthInfo(ctx, healthTypes, *deadline)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		// The server streams partial reports, the last one is complete.
+		var info madmin.HealthInfo
+		decoder := json.NewDecoder(resp.Body)
+		for {
+			var partial madmin.HealthInfo
+			if err = decoder.Decode(&partial); err != nil {
+				if err == io.EOF {
+					break
+				}
+				return err
+			}
+			info = partial
+		}
+		if err = info.Upgrade(); err != nil {
+			return err
+		}
+		info.Canonicalize()
+
+		return env.print(info, func(w io.Writer) error {
+			var rows [][]string
+			for _, s := range info.Minio.Info.Servers {
+				rows = append(rows, []string{s.Endpoint, s.State, s.Version, fmt.Sprint(len(s.Disks))})
+			}
+			if info.Error != "" {
+				fmt.Fprintf(w, "Error: %s\n", info.Error)
+			}
+			return table(w, []string{"SERVER", "STATE", "VERSION", "DRIVES"}, rows)
+		})
+	}
+	return c
+}
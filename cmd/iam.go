@@ -0,0 +1,190 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+
+	"github.com/minio/madmin-go"
+)
+
+// NewIAMCommand - returns the iam command group managing users and
+// policies.
+func NewIAMCommand(env *Env) *Command {
+	return &Command{
+		Use:   "iam COMMAND",
+		Short: "manage users and policies",
+		Subcommands: []*Command{
+			{
+				Use:   "user COMMAND",
+				Short: "manage users",
+				Subcommands: []*Command{
+					newUserListCommand(env),
+					newUserAddCommand(env),
+					newUserRemoveCommand(env),
+					newUserStatusCommand(env),
+				},
+			},
+			{
+				Use:   "policy COMMAND",
+				Short: "manage policies",
+				Subcommands: []*Command{
+					newPolicyListCommand(env),
+					newPolicyInfoCommand(env),
+					newPolicyAddCommand(env),
+					newPolicyRemoveCommand(env),
+					newPolicyAttachCommand(env),
+				},
+			},
+		},
+	}
+}
+
+// newLeafCommand - returns a leaf command taking exactly n arguments.
+func newLeafCommand(env *Env, use, short string, n int, run func(ctx context.Context, adm *madmin.AdminClient, args []string) error) *Command {
+	c := &Command{Use: use, Short: short}
+	c.Flags = env.newFlagSet(c.Name())
+	c.Run = func(ctx context.Context, args []string) error {
+		if err := exactArgs(c.Name(), args, n); err != nil {
+			return err
+		}
+		adm, err := env.Client()
+		if err != nil {
+			return err
+		}
+		return run(ctx, adm, args)
+	}
+	return c
+}
+
+func newUserListCommand(env *Env) *Command {
+	return newLeafCommand(env, "list", "list users", 0, func(ctx context.Context, adm *madmin.AdminClient, args []string) error {
+		users, err := adm.ListUsers(ctx)
+		if err != nil {
+			return err
+		}
+		for name, u := range users {
+			u.SecretKey = ""
+			users[name] = u
+		}
+		return env.print(users, func(w io.Writer) error {
+			return madmin.Render(w, madmin.FormatTable, users)
+		})
+	})
+}
+
+func newUserAddCommand(env *Env) *Command {
+	return newLeafCommand(env, "add ACCESS_KEY SECRET_KEY", "add a user", 2, func(ctx context.Context, adm *madmin.AdminClient, args []string) error {
+		return adm.AddUser(ctx, args[0], args[1])
+	})
+}
+
+func newUserRemoveCommand(env *Env) *Command {
+	return newLeafCommand(env, "remove ACCESS_KEY", "remove a user", 1, func(ctx context.Context, adm *madmin.AdminClient, args []string) error {
+		return adm.RemoveUser(ctx, args[0])
+	})
+}
+
+func newUserStatusCommand(env *Env) *Command {
+	return newLeafCommand(env, "status ACCESS_KEY enabled|disabled", "enable or disable a user", 2, func(ctx context.Context, adm *madmin.AdminClient, args []string) error {
+		status := madmin.AccountStatus(args[1])
+		if status != madmin.AccountEnabled && status != madmin.AccountDisabled {
+			return fmt.Errorf("%w: unknown user status %q", ErrUsage, args[1])
+		}
+		return adm.SetUserStatus(ctx, args[0], status)
+	})
+}
+
+func newPolicyListCommand(env *Env) *Command {
+	return newLeafCommand(env, "list", "list policies", 0, func(ctx context.Context, adm *madmin.AdminClient, args []string) error {
+		policies, err := adm.ListCannedPolicies(ctx)
+		if err != nil {
+			return err
+		}
+		return env.print(policies, func(w io.Writer) error {
+			names := make([]string, 0, len(policies))
+			for name := range policies {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				if _, err := fmt.Fprintln(w, name); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func newPolicyInfoCommand(env *Env) *Command {
+	return newLeafCommand(env, "info NAME", "print a policy document", 1, func(ctx context.Context, adm *madmin.AdminClient, args []string) error {
+		policy, err := adm.InfoCannedPolicy(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(env.out(), string(policy))
+		return err
+	})
+}
+
+func newPolicyAddCommand(env *Env) *Command {
+	return newLeafCommand(env, "add NAME FILE", "add a policy from a JSON file", 2, func(ctx context.Context, adm *madmin.AdminClient, args []string) error {
+		policy, err := ioutil.ReadFile(args[1])
+		if err != nil {
+			return err
+		}
+		return adm.AddCannedPolicy(ctx, args[0], policy)
+	})
+}
+
+func newPolicyRemoveCommand(env *Env) *Command {
+	return newLeafCommand(env, "remove NAME", "remove a policy", 1, func(ctx context.Context, adm *madmin.AdminClient, args []string) error {
+		return adm.RemoveCannedPolicy(ctx, args[0])
+	})
+}
+
+func newPolicyAttachCommand(env *Env) *Command {
+	c := &Command{
+		Use:   "attach POLICY[,POLICY...] (--user USER | --group GROUP)",
+		Short: "attach policies to a user or group",
+		Flags: env.newFlagSet("attach"),
+	}
+	user := c.Flags.String("user", "", "user to attach the policies to")
+	group := c.Flags.String("group", "", "group to attach the policies to")
+	c.Run = func(ctx context.Context, args []string) error {
+		if err := exactArgs("attach", args, 1); err != nil {
+			return err
+		}
+		if (*user == "") == (*group == "") {
+			return fmt.Errorf("%w: attach expects either --user or --group", ErrUsage)
+		}
+		adm, err := env.Client()
+		if err != nil {
+			return err
+		}
+		if *group != "" {
+			return adm.SetPolicy(ctx, args[0], *group, true)
+		}
+		return adm.SetPolicy(ctx, args[0], *user, false)
+	}
+	return c
+}
@@ -0,0 +1,215 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package compat checks that madmin response types still decode the
+// responses of known MinIO server versions.
+//
+// Golden responses are kept as <server version>/<type>.json files. The
+// fixtures shipped with this package can be verified by downstream CI
+// with a test like:
+//
+//	func TestMadminCompat(t *testing.T) {
+//		for _, f := range compat.Verify(compat.Fixtures()) {
+//			t.Error(f)
+//		}
+//	}
+//
+// Responses captured from other server versions can be checked by
+// passing their directory to Verify, e.g. "testdata/servers". Such
+// responses are captured with a Recorder.
+package compat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/minio/madmin-go"
+)
+
+// Fixtures - returns the directory of the golden responses shipped
+// with this package.
+func Fixtures() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "testdata")
+}
+
+// Types - exported response types by fixture name.
+var Types = map[string]func() interface{}{
+	"AccountInfo":            func() interface{} { return &madmin.AccountInfo{} },
+	"BucketQuota":            func() interface{} { return &madmin.BucketQuota{} },
+	"DataUsageInfo":          func() interface{} { return &madmin.DataUsageInfo{} },
+	"GroupDesc":              func() interface{} { return &madmin.GroupDesc{} },
+	"HealTaskStatus":         func() interface{} { return &madmin.HealTaskStatus{} },
+	"HealthInfo":             func() interface{} { return &madmin.HealthInfo{} },
+	"InfoMessage":            func() interface{} { return &madmin.InfoMessage{} },
+	"InfoServiceAccountResp": func() interface{} { return &madmin.InfoServiceAccountResp{} },
+	"ServerUpdateStatus":     func() interface{} { return &madmin.ServerUpdateStatus{} },
+	"SpeedTestResult":        func() interface{} { return &madmin.SpeedTestResult{} },
+	"StorageInfo":            func() interface{} { return &madmin.StorageInfo{} },
+	"TierConfig":             func() interface{} { return &madmin.TierConfig{} },
+	"UserInfo":               func() interface{} { return &madmin.UserInfo{} },
+}
+
+// Failure - a fixture which no longer decodes losslessly.
+type Failure struct {
+	Version string
+	Type    string
+	Err     error
+}
+
+func (f Failure) Error() string {
+	return fmt.Sprintf("%s/%s: %v", f.Version, f.Type, f.Err)
+}
+
+// Versions - returns the server versions with fixtures in dir.
+func Versions(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var versions []string
+	for _, e := range entries {
+		if e.IsDir() {
+			versions = append(versions, e.Name())
+		}
+	}
+	sort.Strings(versions)
+	return versions, nil
+}
+
+// Verify - decodes every fixture in dir into its type in Types. A
+// fixture fails if it does not decode, has fields the type does not
+// know, or has values lost when the type is encoded again.
+func Verify(dir string) []Failure {
+	versions, err := Versions(dir)
+	if err != nil {
+		return []Failure{{Err: err}}
+	}
+	var failures []Failure
+	for _, version := range versions {
+		files, err := filepath.Glob(filepath.Join(dir, version, "*.json"))
+		if err != nil {
+			failures = append(failures, Failure{Version: version, Err: err})
+			continue
+		}
+		for _, file := range files {
+			name := strings.TrimSuffix(filepath.Base(file), ".json")
+			if err := verifyFixture(file, name); err != nil {
+				failures = append(failures, Failure{Version: version, Type: name, Err: err})
+			}
+		}
+	}
+	return failures
+}
+
+func verifyFixture(file, name string) error {
+	newType, ok := Types[name]
+	if !ok {
+		return fmt.Errorf("unknown type %s", name)
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	v := newType()
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(v); err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var want, got interface{}
+	if err = json.Unmarshal(data, &want); err != nil {
+		return err
+	}
+	if err = json.Unmarshal(encoded, &got); err != nil {
+		return err
+	}
+	return compareJSON("$", want, got)
+}
+
+// compareJSON - returns an error for the first value of want missing
+// or different in got. Zero values may be omitted from got.
+func compareJSON(path string, want, got interface{}) error {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, _ := got.(map[string]interface{})
+		for k, wv := range w {
+			gv, ok := g[k]
+			if !ok {
+				if isZeroJSON(wv) {
+					continue
+				}
+				return fmt.Errorf("%s.%s: value lost", path, k)
+			}
+			if err := compareJSON(path+"."+k, wv, gv); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		g, _ := got.([]interface{})
+		if len(w) != len(g) {
+			if len(w) == 0 && got == nil {
+				return nil
+			}
+			return fmt.Errorf("%s: expected %d elements, got %d", path, len(w), len(g))
+		}
+		for i := range w {
+			if err := compareJSON(fmt.Sprintf("%s[%d]", path, i), w[i], g[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if got == nil && isZeroJSON(want) {
+		return nil
+	}
+	if !reflect.DeepEqual(want, got) {
+		return fmt.Errorf("%s: expected %v, got %v", path, want, got)
+	}
+	return nil
+}
+
+func isZeroJSON(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
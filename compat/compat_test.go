@@ -0,0 +1,165 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package compat
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go"
+)
+
+func TestFixtures(t *testing.T) {
+	for _, f := range Verify(Fixtures()) {
+		t.Error(f)
+	}
+}
+
+func TestFixturesCoverTypes(t *testing.T) {
+	dir := Fixtures()
+	versions, err := Versions(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) == 0 {
+		t.Fatal("no fixtures")
+	}
+	for _, version := range versions {
+		for name := range Types {
+			if _, err := os.Stat(filepath.Join(dir, version, name+".json")); err != nil {
+				t.Errorf("%s: missing fixture for %s", version, name)
+			}
+		}
+	}
+}
+
+func TestVerifyFailures(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err = os.Mkdir(filepath.Join(dir, "v1"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range map[string]string{
+		"UserInfo":           `{"status":"enabled","groups":["devops"]}`,
+		"GroupDesc":          `{"name":"devops","members":"alice"}`,
+		"ServerUpdateStatus": `{"currentVersion":"a","updatedVersion":"b"}`,
+		"Unknown":            `{}`,
+	} {
+		if err = ioutil.WriteFile(filepath.Join(dir, "v1", name+".json"), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	failures := Verify(dir)
+	want := map[string]string{
+		"UserInfo":  `unknown field "groups"`,
+		"GroupDesc": "cannot unmarshal string",
+		"Unknown":   "unknown type",
+	}
+	if len(failures) != len(want) {
+		t.Fatalf("expected %d failures, got %v", len(want), failures)
+	}
+	for _, f := range failures {
+		if f.Version != "v1" || !strings.Contains(f.Err.Error(), want[f.Type]) {
+			t.Errorf("unexpected failure %v", f)
+		}
+	}
+}
+
+func TestCompareJSON(t *testing.T) {
+	want := map[string]interface{}{"a": 1.0, "b": []interface{}{"x"}, "c": false}
+	if err := compareJSON("$", want, map[string]interface{}{"a": 1.0, "b": []interface{}{"x"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := compareJSON("$", want, map[string]interface{}{"a": 2.0, "b": []interface{}{"x"}}); err == nil || !strings.HasPrefix(err.Error(), "$.a:") {
+		t.Fatalf("expected $.a mismatch, got %v", err)
+	}
+	if err := compareJSON("$", want, map[string]interface{}{"a": 1.0}); err == nil {
+		t.Fatal("expected lost value")
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	const version = "RELEASE.2022-01-08T03-11-54Z"
+	fixture := func(name string) string {
+		data, err := ioutil.ReadFile(filepath.Join(Fixtures(), version, name+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return strings.TrimSpace(string(data))
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch path.Base(r.URL.Path) {
+		case "accountinfo":
+			w.Write([]byte(fixture("AccountInfo")))
+		case "tier":
+			w.Write([]byte("[" + fixture("TierConfig") + "]"))
+		case "healthinfo":
+			w.Write([]byte(`{"version":"` + madmin.HealthInfoVersion + `"}` + "\n" + fixture("HealthInfo") + "\n"))
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	rec := NewRecorder(http.DefaultTransport, "minioadmin")
+	adm, err := madmin.New(u.Host, "minioadmin", "minioadmin", false, madmin.WithTransport(rec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err = adm.AccountInfo(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = adm.ListTiers(ctx); err != nil {
+		t.Fatal(err)
+	}
+	resp, _, err := adm.ServerHealthInfo(ctx, nil, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	dir, err := ioutil.TempDir("", "compat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err = rec.WriteFixtures(dir, version); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"AccountInfo", "TierConfig", "HealthInfo"} {
+		data, err := ioutil.ReadFile(filepath.Join(dir, version, name+".json"))
+		if err != nil || strings.TrimSpace(string(data)) != fixture(name) {
+			t.Errorf("%s: expected the recorded response, got %s: %v", name, data, err)
+		}
+	}
+	for _, f := range Verify(dir) {
+		t.Error(f)
+	}
+}
@@ -0,0 +1,160 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package compat
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/minio/madmin-go"
+)
+
+// capturedEndpoints - fixture names by admin API endpoint.
+var capturedEndpoints = map[string]string{
+	"/accountinfo":          "AccountInfo",
+	"/get-bucket-quota":     "BucketQuota",
+	"/datausageinfo":        "DataUsageInfo",
+	"/group":                "GroupDesc",
+	"/healthinfo":           "HealthInfo",
+	"/info":                 "InfoMessage",
+	"/info-service-account": "InfoServiceAccountResp",
+	"/speedtest":            "SpeedTestResult",
+	"/storageinfo":          "StorageInfo",
+	"/tier":                 "TierConfig",
+	"/update":               "ServerUpdateStatus",
+	"/user-info":            "UserInfo",
+}
+
+// Recorder - an http.RoundTripper capturing the admin API responses of
+// a live server as fixtures. Calls through a client using it record
+// the response of every endpoint with a type in Types:
+//
+//	rec := compat.NewRecorder(http.DefaultTransport, secretKey)
+//	adm, err := madmin.New(endpoint, accessKey, secretKey, secure, madmin.WithTransport(rec))
+//	...
+//	adm.AccountInfo(ctx)
+//	err = rec.WriteFixtures("testdata", "RELEASE.2022-01-08T03-11-54Z")
+//
+// Encrypted responses are decrypted with secretKey. Of streamed
+// responses the last object is kept, of lists the first element. Heal
+// status responses are recorded as HealTaskStatus.
+type Recorder struct {
+	rt        http.RoundTripper
+	secretKey string
+
+	mu        sync.Mutex
+	responses map[string][]byte
+}
+
+// NewRecorder - returns a Recorder sending requests with rt.
+func NewRecorder(rt http.RoundTripper, secretKey string) *Recorder {
+	return &Recorder{rt: rt, secretKey: secretKey, responses: make(map[string][]byte)}
+}
+
+// RoundTrip - sends req and records a successful response.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.rt.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	name, ok := fixtureName(req)
+	if !ok {
+		return resp, nil
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if data, err := r.fixture(name, body); err == nil && len(data) > 0 {
+		r.mu.Lock()
+		r.responses[name] = data
+		r.mu.Unlock()
+	}
+	return resp, nil
+}
+
+// fixtureName - returns the fixture name of the endpoint of req.
+func fixtureName(req *http.Request) (string, bool) {
+	endpoint := strings.TrimPrefix(req.URL.Path, "/minio/admin/"+madmin.AdminAPIVersion)
+	if endpoint == req.URL.Path {
+		return "", false
+	}
+	if strings.HasPrefix(endpoint, "/heal/") && req.URL.Query().Get("clientToken") != "" {
+		return "HealTaskStatus", true
+	}
+	if endpoint == "/tier" && req.Method != http.MethodGet {
+		return "", false
+	}
+	name, ok := capturedEndpoints[endpoint]
+	return name, ok
+}
+
+// fixture - returns the fixture of fixture type name in body.
+func (r *Recorder) fixture(name string, body []byte) ([]byte, error) {
+	switch name {
+	case "InfoServiceAccountResp":
+		return madmin.DecryptData(r.secretKey, bytes.NewReader(body))
+	case "HealthInfo", "SpeedTestResult":
+		var last json.RawMessage
+		dec := json.NewDecoder(bytes.NewReader(body))
+		for {
+			var next json.RawMessage
+			if err := dec.Decode(&next); err == io.EOF {
+				return last, nil
+			} else if err != nil {
+				return nil, err
+			}
+			last = next
+		}
+	case "TierConfig":
+		var tiers []json.RawMessage
+		if err := json.Unmarshal(body, &tiers); err != nil || len(tiers) == 0 {
+			return nil, err
+		}
+		return tiers[0], nil
+	}
+	return body, nil
+}
+
+// WriteFixtures - writes the recorded responses to
+// dir/<version>/<type>.json.
+func (r *Recorder) WriteFixtures(dir, version string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dir = filepath.Join(dir, version)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for name, data := range r.responses {
+		data = append(bytes.TrimSpace(data), '\n')
+		if err := ioutil.WriteFile(filepath.Join(dir, name+".json"), data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
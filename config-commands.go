@@ -55,7 +55,7 @@ func (adm *AdminClient) SetConfig(ctx context.Context, config io.Reader) (err er
 		return err
 	}
 	configBytes := configBuf[:n]
-	econfigBytes, err := EncryptData(adm.getSecretKey(), configBytes)
+	econfigBytes, err := adm.encryptData(configBytes)
 	if err != nil {
 		return err
 	}
@@ -18,7 +18,6 @@ package madmin
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 	"net/url"
 )
@@ -79,7 +78,8 @@ func (adm *AdminClient) HelpConfigKV(ctx context.Context, subSys, key string, en
 	}
 
 	var help = Help{}
-	d := json.NewDecoder(resp.Body)
+	// Help is always decoded strictly.
+	d := adm.newResponseDecoder(resp)
 	d.DisallowUnknownFields()
 	if err = d.Decode(&help); err != nil {
 		return help, err
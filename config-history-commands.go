@@ -18,7 +18,6 @@ package madmin
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -118,7 +117,7 @@ func (adm *AdminClient) ListConfigHistoryKV(ctx context.Context, count int) ([]C
 	}
 
 	var chEntries []ConfigHistoryEntry
-	if err = json.Unmarshal(data, &chEntries); err != nil {
+	if err = adm.unmarshalResponse(resp, data, &chEntries); err != nil {
 		return chEntries, err
 	}
 
@@ -24,7 +24,7 @@ import (
 
 // DelConfigKV - delete key from server config.
 func (adm *AdminClient) DelConfigKV(ctx context.Context, k string) (err error) {
-	econfigBytes, err := EncryptData(adm.getSecretKey(), []byte(k))
+	econfigBytes, err := adm.encryptData([]byte(k))
 	if err != nil {
 		return err
 	}
@@ -59,7 +59,7 @@ const (
 
 // SetConfigKV - set key value config to server.
 func (adm *AdminClient) SetConfigKV(ctx context.Context, kv string) (restart bool, err error) {
-	econfigBytes, err := EncryptData(adm.getSecretKey(), []byte(kv))
+	econfigBytes, err := adm.encryptData([]byte(kv))
 	if err != nil {
 		return false, err
 	}
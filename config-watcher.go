@@ -0,0 +1,326 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ServerConfig - the targets of a server configuration, as returned by
+// GetConfig.
+type ServerConfig []Target
+
+// SubSys - returns the targets of a sub-system, i.e. "audit_webhook"
+// matches "audit_webhook" and "audit_webhook:target1".
+func (c ServerConfig) SubSys(subSys string) []Target {
+	var targets []Target
+	for _, t := range c {
+		if t.SubSystem == subSys || strings.HasPrefix(t.SubSystem, subSys+SubSystemSeparator) {
+			targets = append(targets, t)
+		}
+	}
+	return targets
+}
+
+// ConfigViolation - a configuration breaking the schema or a policy rule.
+type ConfigViolation struct {
+	Rule      string `json:"rule"`
+	SubSystem string `json:"subSys,omitempty"`
+	Key       string `json:"key,omitempty"`
+	Detail    string `json:"detail"`
+}
+
+// ConfigSchemaRule is the rule of violations of the server schema.
+const ConfigSchemaRule = "schema"
+
+// ConfigCondition - returns the violations of a policy in cfg.
+type ConfigCondition func(cfg ServerConfig) []ConfigViolation
+
+// ConfigPolicyRule - an organizational policy for the configuration.
+type ConfigPolicyRule struct {
+	Name  string
+	Check ConfigCondition
+}
+
+// ConfigRequireEnabled - requires an enabled target in any of the
+// sub-systems, i.e. audit_webhook and audit_kafka for audit logging.
+func ConfigRequireEnabled(subSystems ...string) ConfigCondition {
+	return func(cfg ServerConfig) []ConfigViolation {
+		for _, subSys := range subSystems {
+			for _, t := range cfg.SubSys(subSys) {
+				if t.KVS.Get(EnableKey) == EnableOn {
+					return nil
+				}
+			}
+		}
+		return []ConfigViolation{{
+			SubSystem: strings.Join(subSystems, ","),
+			Key:       EnableKey,
+			Detail:    "none of " + strings.Join(subSystems, ", ") + " is enabled",
+		}}
+	}
+}
+
+// ConfigRequireValue - requires key of every target of a sub-system to
+// have one of the values.
+func ConfigRequireValue(subSys, key string, values ...string) ConfigCondition {
+	return func(cfg ServerConfig) []ConfigViolation {
+		var violations []ConfigViolation
+		for _, t := range cfg.SubSys(subSys) {
+			value := t.KVS.Get(key)
+			if !containsString(values, value) {
+				violations = append(violations, ConfigViolation{
+					SubSystem: t.SubSystem,
+					Key:       key,
+					Detail:    fmt.Sprintf("%s is %q, expected one of %s", key, value, strings.Join(values, ", ")),
+				})
+			}
+		}
+		return violations
+	}
+}
+
+// ConfigRequireHTTPS - requires the URL valued key of every enabled
+// target of a sub-system, i.e. the endpoint of logger_webhook, to use
+// TLS.
+func ConfigRequireHTTPS(subSys, key string) ConfigCondition {
+	return func(cfg ServerConfig) []ConfigViolation {
+		var violations []ConfigViolation
+		for _, t := range cfg.SubSys(subSys) {
+			value := t.KVS.Get(key)
+			if t.KVS.Get(EnableKey) == EnableOn && !strings.HasPrefix(strings.ToLower(value), "https://") {
+				violations = append(violations, ConfigViolation{
+					SubSystem: t.SubSystem,
+					Key:       key,
+					Detail:    fmt.Sprintf("%s %q does not use TLS", key, value),
+				})
+			}
+		}
+		return violations
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// configKeyRegex matches configuration key names.
+var configKeyRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// validateConfigValue - checks a value against the type of its help.
+func validateConfigValue(typ, value string) error {
+	if value == "" {
+		return nil
+	}
+	var err error
+	switch typ {
+	case "on|off":
+		if value != EnableOn && value != EnableOff {
+			err = fmt.Errorf("expected %s or %s", EnableOn, EnableOff)
+		}
+	case "duration":
+		_, err = time.ParseDuration(value)
+	case "number":
+		_, err = strconv.ParseFloat(value, 64)
+	case "url", "uri":
+		var u *url.URL
+		if u, err = url.Parse(value); err == nil && u.Scheme == "" {
+			err = fmt.Errorf("URL %q has no scheme", value)
+		}
+	}
+	return err
+}
+
+// ConfigWatcherOptions - options of NewConfigWatcher.
+type ConfigWatcherOptions struct {
+	// Interval between validations.
+	Interval time.Duration
+	// Rules are the organizational policies, the server schema is
+	// always validated.
+	Rules []ConfigPolicyRule
+	// Notifiers receive an alert for every new violation.
+	Notifiers []AlertNotifier
+	// OnError is called with fetch and notifier errors, if set.
+	OnError func(err error)
+}
+
+// ConfigWatcher periodically validates the server configuration
+// against the schema reported by the server and policy rules. Alerts
+// are sent when a violation appears, not again while it persists.
+type ConfigWatcher struct {
+	adm    *AdminClient
+	opts   ConfigWatcherOptions
+	help   map[string]Help
+	firing map[ConfigViolation]time.Time
+}
+
+// NewConfigWatcher - returns a new config watcher.
+func NewConfigWatcher(adm *AdminClient, opts ConfigWatcherOptions) (*ConfigWatcher, error) {
+	if opts.Interval <= 0 {
+		return nil, ErrInvalidArgument("Config validation interval must be positive.")
+	}
+	for _, rule := range opts.Rules {
+		if rule.Name == "" || rule.Check == nil {
+			return nil, ErrInvalidArgument("Config policy rules need a name and a check.")
+		}
+	}
+	return &ConfigWatcher{
+		adm:    adm,
+		opts:   opts,
+		help:   make(map[string]Help),
+		firing: make(map[ConfigViolation]time.Time),
+	}, nil
+}
+
+// Run - validates the configuration every interval until ctx is
+// canceled.
+func (w *ConfigWatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.opts.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.RunOnce(ctx); err != nil && w.opts.OnError != nil {
+			w.opts.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce - validates the configuration, alerts about new violations
+// and returns all current ones.
+func (w *ConfigWatcher) RunOnce(ctx context.Context) ([]ConfigViolation, error) {
+	data, err := w.adm.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cfg, violations, err := w.parse(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range w.opts.Rules {
+		for _, v := range rule.Check(cfg) {
+			v.Rule = rule.Name
+			violations = append(violations, v)
+		}
+	}
+
+	now := w.adm.now().UTC()
+	current := make(map[ConfigViolation]time.Time, len(violations))
+	for _, v := range violations {
+		since, ok := w.firing[v]
+		if !ok {
+			since = now
+			w.notify(ctx, v, now)
+		}
+		current[v] = since
+	}
+	w.firing = current
+	return violations, nil
+}
+
+func (w *ConfigWatcher) notify(ctx context.Context, v ConfigViolation, now time.Time) {
+	alert := RemediationAlert{
+		Rule:        "config:" + v.Rule,
+		Detail:      v.Detail,
+		FiringSince: now,
+		Time:        now,
+	}
+	if v.SubSystem != "" {
+		alert.Detail = v.SubSystem + ": " + v.Detail
+	}
+	for _, n := range w.opts.Notifiers {
+		if err := n.Notify(ctx, alert); err != nil && w.opts.OnError != nil {
+			w.opts.OnError(err)
+		}
+	}
+}
+
+// parse - parses the configuration with the help of the server,
+// reporting unknown keys and values not matching their type.
+func (w *ConfigWatcher) parse(ctx context.Context, data []byte) (ServerConfig, []ConfigViolation, error) {
+	var cfg ServerConfig
+	var violations []ConfigViolation
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, KvComment) {
+			continue
+		}
+		subSys := strings.SplitN(strings.SplitN(line, KvSpaceSeparator, 2)[0], SubSystemSeparator, 2)[0]
+		help, ok := w.help[subSys]
+		if !ok {
+			var err error
+			if help, err = w.adm.HelpConfigKV(ctx, subSys, "", false); err != nil {
+				return nil, nil, err
+			}
+			// Values are split at known keys, the help may omit the
+			// standard ones.
+			for _, key := range []string{EnableKey, CommentKey} {
+				if !containsString(help.Keys(), key) {
+					help.KeysHelp = append(help.KeysHelp, HelpKV{Key: key, Type: "string"})
+				}
+			}
+			w.help[subSys] = help
+		}
+		types := make(map[string]string, len(help.KeysHelp))
+		for _, kh := range help.KeysHelp {
+			types[kh.Key] = kh.Type
+		}
+		// Values are only split at known keys, unknown ones are found
+		// and removed from the raw line.
+		fields := strings.Fields(line)
+		known := []string{fields[0]}
+		for _, field := range fields[1:] {
+			kv := strings.SplitN(field, KvSeparator, 2)
+			if _, ok := types[kv[0]]; len(kv) == 2 && configKeyRegex.MatchString(kv[0]) && !ok {
+				violations = append(violations, ConfigViolation{Rule: ConfigSchemaRule, SubSystem: fields[0], Key: kv[0], Detail: "unknown key " + kv[0]})
+				continue
+			}
+			known = append(known, field)
+		}
+		target, err := ParseTarget(strings.Join(known, KvSpaceSeparator), help)
+		if err != nil {
+			violations = append(violations, ConfigViolation{Rule: ConfigSchemaRule, SubSystem: subSys, Detail: err.Error()})
+			continue
+		}
+		for _, kv := range target.KVS {
+			if err = validateConfigValue(types[kv.Key], kv.Value); err != nil {
+				violations = append(violations, ConfigViolation{Rule: ConfigSchemaRule, SubSystem: target.SubSystem, Key: kv.Key, Detail: fmt.Sprintf("invalid %s value %q: %v", types[kv.Key], kv.Value, err)})
+			}
+		}
+		cfg = append(cfg, *target)
+	}
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].SubSystem < violations[j].SubSystem })
+	return cfg, violations, scanner.Err()
+}
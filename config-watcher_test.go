@@ -0,0 +1,118 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type recordingNotifier []RemediationAlert
+
+func (r *recordingNotifier) Notify(ctx context.Context, alert RemediationAlert) error {
+	*r = append(*r, alert)
+	return nil
+}
+
+func TestConfigWatcher(t *testing.T) {
+	config := "# comment\n" +
+		"api requests_max=abc requests_deadline=10s cors=*\n" +
+		"logger_webhook:splunk enable=on endpoint=http://splunk:8088 auth_token=\n" +
+		"audit_webhook enable=off endpoint=\n"
+	help := map[string]Help{
+		"api": {SubSys: "api", KeysHelp: HelpKVS{
+			{Key: "requests_max", Type: "number"},
+			{Key: "requests_deadline", Type: "duration"},
+		}},
+		"logger_webhook": {SubSys: "logger_webhook", MultipleTargets: true, KeysHelp: HelpKVS{
+			{Key: "endpoint", Type: "url"},
+			{Key: "auth_token", Type: "string"},
+		}},
+		"audit_webhook": {SubSys: "audit_webhook", KeysHelp: HelpKVS{
+			{Key: "endpoint", Type: "url"},
+		}},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case libraryAdminURLPrefix + adminAPIPrefix + "/config":
+			data, err := EncryptData("minioadmin", []byte(config))
+			if err != nil {
+				t.Error(err)
+			}
+			w.Write(data)
+		case libraryAdminURLPrefix + adminAPIPrefix + "/help-config-kv":
+			json.NewEncoder(w).Encode(help[r.URL.Query().Get("subSys")])
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var alerts recordingNotifier
+	watcher, err := NewConfigWatcher(adm, ConfigWatcherOptions{
+		Interval: 1,
+		Rules: []ConfigPolicyRule{
+			{Name: "audit-logging", Check: ConfigRequireEnabled("audit_webhook", "audit_kafka")},
+			{Name: "tls-logging", Check: ConfigRequireHTTPS("logger_webhook", "endpoint")},
+		},
+		Notifiers: []AlertNotifier{&alerts},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	violations, err := watcher.RunOnce(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules := make(map[string]int)
+	for _, v := range violations {
+		rules[v.Rule]++
+	}
+	if len(violations) != 4 || rules[ConfigSchemaRule] != 2 || rules["audit-logging"] != 1 || rules["tls-logging"] != 1 {
+		t.Fatalf("unexpected violations %+v", violations)
+	}
+	if violations[0].Key != "cors" || violations[1].Key != "requests_max" {
+		t.Errorf("expected the unknown key and invalid number first, got %+v", violations[:2])
+	}
+	if len(alerts) != 4 {
+		t.Errorf("expected 4 alerts, got %+v", alerts)
+	}
+
+	// Persisting violations alert once, fixed ones are forgotten.
+	config = "audit_webhook enable=on endpoint=https://audit:8080\n"
+	if violations, err = watcher.RunOnce(context.Background()); err != nil || len(violations) != 0 {
+		t.Fatalf("unexpected violations %+v, %v", violations, err)
+	}
+	config = "audit_webhook enable=off endpoint=\n"
+	if _, err = watcher.RunOnce(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = watcher.RunOnce(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 5 {
+		t.Errorf("expected one more alert, got %+v", alerts)
+	}
+}
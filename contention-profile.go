@@ -0,0 +1,284 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ProfilingRates - sampling rates of the mutex and block profiles of
+// the server, as set by runtime.SetMutexProfileFraction and
+// runtime.SetBlockProfileRate.
+type ProfilingRates struct {
+	// MutexFraction samples on average 1/n mutex contention events,
+	// zero disables the mutex profile.
+	MutexFraction int `json:"mutexFraction"`
+	// BlockRate samples one blocking event per rate nanoseconds
+	// spent blocked, zero disables the block profile.
+	BlockRate int `json:"blockRate"`
+}
+
+// GetProfilingRates - returns the current sampling rates of the server.
+func (adm *AdminClient) GetProfilingRates(ctx context.Context) (ProfilingRates, error) {
+	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{relPath: adminAPIPrefix + "/profiling/rates"})
+	defer closeResponse(resp)
+	if err != nil {
+		return ProfilingRates{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return ProfilingRates{}, httpRespToErrorResponse(resp)
+	}
+	var rates ProfilingRates
+	err = adm.decodeResponse(resp, &rates)
+	return rates, err
+}
+
+// SetProfilingRates - sets the sampling rates on all nodes and returns
+// the previous ones.
+func (adm *AdminClient) SetProfilingRates(ctx context.Context, rates ProfilingRates) (ProfilingRates, error) {
+	data, err := json.Marshal(rates)
+	if err != nil {
+		return ProfilingRates{}, err
+	}
+	resp, err := adm.executeMethod(ctx, http.MethodPost, requestData{
+		relPath: adminAPIPrefix + "/profiling/rates",
+		content: data,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return ProfilingRates{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return ProfilingRates{}, httpRespToErrorResponse(resp)
+	}
+	var previous ProfilingRates
+	err = adm.decodeResponse(resp, &previous)
+	return previous, err
+}
+
+// ContentionProfileOpts - options for ProfileContention.
+type ContentionProfileOpts struct {
+	// Duration of the capture, defaults to 30 seconds.
+	Duration time.Duration
+	// Rates used during the capture, default to a mutex fraction of
+	// 100 and a block rate of 10000 nanoseconds.
+	Rates ProfilingRates
+	// Top limits the number of contenders, defaults to 20.
+	Top int
+}
+
+// Contender - a function contending for locks or blocking.
+type Contender struct {
+	// Profile is ProfilerMutex or ProfilerBlock.
+	Profile  ProfilerType `json:"profile"`
+	Function string       `json:"function"`
+	// Contentions is the number of sampled events.
+	Contentions int64    `json:"contentions"`
+	Delay       Duration `json:"delay"`
+	// Nodes the function contended on.
+	Nodes []string `json:"nodes"`
+}
+
+// ContentionProfile - result of ProfileContention.
+type ContentionProfile struct {
+	// Raw pprof profiles by archive entry name, profile-<node>-mutex.pprof
+	// and profile-<node>-block.pprof.
+	Raw map[string][]byte `json:"-"`
+	// Top contenders by descending delay.
+	Top []Contender `json:"top"`
+}
+
+// ProfileContention - captures the mutex and block profiles of all
+// nodes with the given sampling rates and restores the original rates
+// afterwards, even if the capture fails.
+func (adm *AdminClient) ProfileContention(ctx context.Context, opts ContentionProfileOpts) (profile ContentionProfile, err error) {
+	if opts.Duration == 0 {
+		opts.Duration = 30 * time.Second
+	}
+	if opts.Rates == (ProfilingRates{}) {
+		opts.Rates = ProfilingRates{MutexFraction: 100, BlockRate: 10000}
+	}
+	if opts.Top == 0 {
+		opts.Top = 20
+	}
+
+	previous, err := adm.SetProfilingRates(ctx, opts.Rates)
+	if err != nil {
+		return profile, err
+	}
+	defer func() {
+		// Restore with a fresh context, ctx may be canceled by now.
+		restoreCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if _, rerr := adm.SetProfilingRates(restoreCtx, previous); rerr != nil && err == nil {
+			err = fmt.Errorf("unable to restore profiling rates: %w", rerr)
+		}
+	}()
+
+	results, err := adm.StartProfiling(ctx, ProfilerMutex+","+ProfilerBlock)
+	if err != nil {
+		return profile, err
+	}
+	for _, r := range results {
+		if !r.Success {
+			return profile, fmt.Errorf("unable to start profiling on %s: %s", r.NodeName, r.Error)
+		}
+	}
+
+	timer := time.NewTimer(opts.Duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return profile, ctx.Err()
+	case <-timer.C:
+	}
+
+	body, err := adm.DownloadProfilingData(ctx)
+	if err != nil {
+		return profile, err
+	}
+	defer body.Close()
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return profile, err
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return profile, err
+	}
+
+	profile.Raw = make(map[string][]byte)
+	for _, f := range archive.File {
+		rc, err := f.Open()
+		if err != nil {
+			return profile, err
+		}
+		raw, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return profile, err
+		}
+		profile.Raw[path.Base(f.Name)] = raw
+	}
+	profile.Top, err = topContenders(profile.Raw, opts.Top)
+	return profile, err
+}
+
+// contentionEntry - returns the node and profile of an archive entry
+// named profile-<node>-<mutex|block>.pprof.
+func contentionEntry(name string) (string, ProfilerType) {
+	for _, p := range []ProfilerType{ProfilerMutex, ProfilerBlock} {
+		suffix := "-" + string(p) + ".pprof"
+		if strings.HasPrefix(name, "profile-") && strings.HasSuffix(name, suffix) && len(name) > len("profile-")+len(suffix) {
+			return name[len("profile-") : len(name)-len(suffix)], p
+		}
+	}
+	return "", ""
+}
+
+// contentionFrame - returns the first frame of a stack outside of the
+// runtime and sync packages, which only implement the waiting.
+func contentionFrame(p *pprofProfile, locations []uint64) string {
+	var leaf string
+	for _, loc := range locations {
+		for _, fnID := range p.locations[loc] {
+			name := p.str(p.functions[fnID])
+			if leaf == "" {
+				leaf = name
+			}
+			if !strings.HasPrefix(name, "runtime.") && !strings.HasPrefix(name, "sync.") && !strings.HasPrefix(name, "internal/") {
+				return name
+			}
+		}
+	}
+	return leaf
+}
+
+// topContenders - aggregates the mutex and block profiles of all nodes
+// by contending function.
+func topContenders(raw map[string][]byte, top int) ([]Contender, error) {
+	byKey := make(map[string]*Contender)
+	names := make([]string, 0, len(raw))
+	for name := range raw {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		node, kind := contentionEntry(name)
+		if node == "" {
+			continue
+		}
+		p, err := readProfile(bytes.NewReader(raw[name]))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		countIdx, delayIdx := -1, -1
+		for i, st := range p.sampleTypes {
+			switch p.str(st[0]) {
+			case "contentions":
+				countIdx = i
+			case "delay":
+				delayIdx = i
+			}
+		}
+		if countIdx < 0 || delayIdx < 0 {
+			return nil, fmt.Errorf("%s: not a contention profile", name)
+		}
+		for _, s := range p.samples {
+			if countIdx >= len(s.values) || delayIdx >= len(s.values) {
+				continue
+			}
+			fn := contentionFrame(p, s.locations)
+			c, ok := byKey[string(kind)+" "+fn]
+			if !ok {
+				c = &Contender{Profile: kind, Function: fn}
+				byKey[string(kind)+" "+fn] = c
+			}
+			c.Contentions += s.values[countIdx]
+			c.Delay += Duration(s.values[delayIdx])
+			if len(c.Nodes) == 0 || c.Nodes[len(c.Nodes)-1] != node {
+				c.Nodes = append(c.Nodes, node)
+			}
+		}
+	}
+
+	contenders := make([]Contender, 0, len(byKey))
+	for _, c := range byKey {
+		contenders = append(contenders, *c)
+	}
+	sort.Slice(contenders, func(i, j int) bool {
+		if contenders[i].Delay != contenders[j].Delay {
+			return contenders[i].Delay > contenders[j].Delay
+		}
+		return contenders[i].Function < contenders[j].Function
+	})
+	if len(contenders) > top {
+		contenders = contenders[:top]
+	}
+	return contenders, nil
+}
@@ -0,0 +1,109 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testContentionProfile - returns a mutex or block profile with a
+// sample of contentions and delay per stack of function IDs, leaf first.
+func testContentionProfile(samples map[int64][]uint64) []byte {
+	var p protoMessage
+	for _, s := range []string{"", "contentions", "count", "delay", "nanoseconds", "sync.(*Mutex).Unlock", "main.handler", "main.main"} {
+		p = p.bytes(6, []byte(s))
+	}
+	p = p.bytes(1, protoMessage{}.varint(1, 1).varint(2, 2))
+	p = p.bytes(1, protoMessage{}.varint(1, 3).varint(2, 4))
+	for id := uint64(1); id <= 3; id++ {
+		p = p.bytes(5, protoMessage{}.varint(1, id).varint(2, id+4))
+		p = p.bytes(4, protoMessage{}.varint(1, id).bytes(4, protoMessage{}.varint(1, id)))
+	}
+	for delay, stack := range samples {
+		p = p.bytes(2, protoMessage{}.packed(1, stack...).packed(2, 2, uint64(delay)))
+	}
+	return p
+}
+
+func TestProfileContention(t *testing.T) {
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for name, profile := range map[string][]byte{
+		"profile-node1:9000-mutex.pprof": testContentionProfile(map[int64][]uint64{500: {1, 2, 3}, 100: {1, 3}}),
+		"profile-node2:9000-mutex.pprof": testContentionProfile(map[int64][]uint64{300: {1, 2, 3}}),
+		"profile-node1:9000-block.pprof": testContentionProfile(map[int64][]uint64{50: {3}}),
+	} {
+		w, _ := zw.Create(name)
+		w.Write(profile)
+	}
+	zw.Close()
+
+	current := ProfilingRates{MutexFraction: 0, BlockRate: 0}
+	var set []ProfilingRates
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/profiling/rates"):
+			var rates ProfilingRates
+			json.NewDecoder(r.Body).Decode(&rates)
+			set = append(set, rates)
+			json.NewEncoder(w).Encode(current)
+			current = rates
+		case strings.HasSuffix(r.URL.Path, "/profiling/start") && r.URL.Query().Get("profilerType") == "mutex,block":
+			w.Write([]byte(`[{"nodeName":"node1:9000","success":true},{"nodeName":"node2:9000","success":true}]`))
+		case strings.HasSuffix(r.URL.Path, "/profiling/download"):
+			w.Write(archive.Bytes())
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile, err := adm.ProfileContention(context.Background(), ContentionProfileOpts{Duration: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(set) != 2 || set[0] != (ProfilingRates{MutexFraction: 100, BlockRate: 10000}) || set[1] != (ProfilingRates{}) {
+		t.Fatalf("rates not restored: %+v", set)
+	}
+	if len(profile.Raw) != 3 {
+		t.Fatalf("expected 3 raw profiles, got %d", len(profile.Raw))
+	}
+	expected := []Contender{
+		{Profile: ProfilerMutex, Function: "main.handler", Contentions: 4, Delay: 800, Nodes: []string{"node1:9000", "node2:9000"}},
+		{Profile: ProfilerMutex, Function: "main.main", Contentions: 2, Delay: 100, Nodes: []string{"node1:9000"}},
+		{Profile: ProfilerBlock, Function: "main.main", Contentions: 2, Delay: 50, Nodes: []string{"node1:9000"}},
+	}
+	got, _ := json.Marshal(profile.Top)
+	want, _ := json.Marshal(expected)
+	if !bytes.Equal(got, want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,294 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/shirou/gopsutil/cpu"
+)
+
+// procCPUInfo is the kernel CPU information file, replaced in tests.
+var procCPUInfo = "/proc/cpuinfo"
+
+// Core classes of heterogeneous (big.LITTLE) systems.
+const (
+	CoreClassPerformance = "performance"
+	CoreClassEfficiency  = "efficiency"
+)
+
+// armImplementers - names of the implementer codes of the ARM main ID
+// register.
+var armImplementers = map[uint64]string{
+	0x41: "ARM",
+	0x42: "Broadcom",
+	0x43: "Cavium",
+	0x46: "Fujitsu",
+	0x48: "HiSilicon",
+	0x4e: "NVIDIA",
+	0x50: "APM",
+	0x51: "Qualcomm",
+	0x53: "Samsung",
+	0x61: "Apple",
+	0xc0: "Ampere",
+}
+
+// armParts - names of the part numbers of the ARM main ID register by
+// implementer, covering common server and big.LITTLE cores.
+var armParts = map[uint64]map[uint64]string{
+	0x41: {
+		0xd03: "Cortex-A53",
+		0xd05: "Cortex-A55",
+		0xd07: "Cortex-A57",
+		0xd08: "Cortex-A72",
+		0xd09: "Cortex-A73",
+		0xd0a: "Cortex-A75",
+		0xd0b: "Cortex-A76",
+		0xd0c: "Neoverse-N1",
+		0xd0d: "Cortex-A77",
+		0xd40: "Neoverse-V1",
+		0xd41: "Cortex-A78",
+		0xd44: "Cortex-X1",
+		0xd46: "Cortex-A510",
+		0xd47: "Cortex-A710",
+		0xd48: "Cortex-X2",
+		0xd49: "Neoverse-N2",
+		0xd4f: "Neoverse-V2",
+	},
+	0x43: {
+		0x0a1: "ThunderX",
+		0x0af: "ThunderX2",
+	},
+	0x48: {
+		0xd01: "Kunpeng-920",
+	},
+	0x46: {
+		0x001: "A64FX",
+	},
+	0xc0: {
+		0xac3: "Ampere-1",
+		0xac4: "Ampere-1a",
+	},
+}
+
+// riscvVendors - names of the RISC-V mvendorid JEDEC codes.
+var riscvVendors = map[uint64]string{
+	0x489: "SiFive",
+	0x5b7: "T-Head",
+	0x31e: "Andes",
+	0x710: "StarFive",
+}
+
+// armMIDR - the fields of an ARM main ID register (midr_el1).
+type armMIDR struct {
+	implementer, variant, part, revision uint64
+}
+
+// parseARMMIDR - parses a main ID register like "0x00000000413fd0c1".
+func parseARMMIDR(s string) (armMIDR, bool) {
+	v, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(s), "0x"), 16, 64)
+	if err != nil || v == 0 {
+		return armMIDR{}, false
+	}
+	return armMIDR{
+		implementer: (v >> 24) & 0xff,
+		variant:     (v >> 20) & 0xf,
+		part:        (v >> 4) & 0xfff,
+		revision:    v & 0xf,
+	}, true
+}
+
+// apply - fills the fields of info gopsutil leaves empty on ARM.
+func (m armMIDR) apply(info *cpu.InfoStat) {
+	if info.VendorID == "" {
+		info.VendorID = armImplementers[m.implementer]
+		if info.VendorID == "" {
+			info.VendorID = "0x" + strconv.FormatUint(m.implementer, 16)
+		}
+	}
+	if info.Model == "" {
+		info.Model = "0x" + strconv.FormatUint(m.part, 16)
+	}
+	if info.Stepping == 0 {
+		info.Stepping = int32(m.revision)
+	}
+	if info.ModelName == "" {
+		if name := armParts[m.implementer][m.part]; name != "" {
+			info.ModelName = name
+		} else {
+			info.ModelName = info.VendorID + " " + info.Model
+		}
+		info.ModelName += " r" + strconv.FormatUint(m.variant, 10) + "p" + strconv.FormatUint(m.revision, 10)
+	}
+}
+
+// enrichCPUInfos - fills the vendor and model of ARM and RISC-V CPUs
+// for which gopsutil, reading x86 style /proc/cpuinfo fields, leaves
+// them empty. ARM CPUs are identified by their main ID register from
+// sysfs, or /proc/cpuinfo on older kernels.
+func enrichCPUInfos(infos []cpu.InfoStat) {
+	var procInfos map[int32]map[string]string
+	for i := range infos {
+		info := &infos[i]
+		if info.VendorID != "" && info.ModelName != "" {
+			continue
+		}
+		midr, err := ioutil.ReadFile(filepath.Join(sysfsRoot, "devices/system/cpu", "cpu"+strconv.Itoa(int(info.CPU)), "regs/identification/midr_el1"))
+		if m, ok := parseARMMIDR(string(midr)); err == nil && ok {
+			m.apply(info)
+			continue
+		}
+		if procInfos == nil {
+			procInfos = readProcCPUInfo()
+		}
+		fields := procInfos[info.CPU]
+		switch {
+		case fields["CPU implementer"] != "":
+			implementer, _ := strconv.ParseUint(strings.TrimPrefix(fields["CPU implementer"], "0x"), 16, 64)
+			part, _ := strconv.ParseUint(strings.TrimPrefix(fields["CPU part"], "0x"), 16, 64)
+			variant, _ := strconv.ParseUint(strings.TrimPrefix(fields["CPU variant"], "0x"), 16, 64)
+			revision, _ := strconv.ParseUint(fields["CPU revision"], 10, 64)
+			armMIDR{implementer: implementer, variant: variant, part: part, revision: revision}.apply(info)
+		case fields["isa"] != "":
+			enrichRISCV(info, fields)
+		}
+	}
+}
+
+// enrichRISCV - fills the fields of a RISC-V hart from its /proc/cpuinfo
+// fields, e.g. "isa: rv64imafdc" and "uarch: sifive,u74-mc".
+func enrichRISCV(info *cpu.InfoStat, fields map[string]string) {
+	if info.VendorID == "" {
+		vendor, _ := strconv.ParseUint(strings.TrimPrefix(fields["mvendorid"], "0x"), 16, 64)
+		info.VendorID = riscvVendors[vendor]
+		if info.VendorID == "" {
+			if info.VendorID = fields["mvendorid"]; info.VendorID == "" {
+				info.VendorID = "RISC-V"
+			}
+		}
+	}
+	if info.Model == "" {
+		info.Model = fields["marchid"]
+	}
+	if info.ModelName == "" {
+		info.ModelName = fields["uarch"]
+		if info.ModelName == "" {
+			info.ModelName = fields["isa"]
+		}
+	}
+	if info.Microcode == "" {
+		info.Microcode = fields["mimpid"]
+	}
+	if len(info.Flags) == 0 {
+		info.Flags = riscvExtensions(fields["isa"])
+	}
+}
+
+// riscvExtensions - returns the extensions of an ISA string, e.g. "i",
+// "m", "a", "f", "d", "c" and "zicsr" for "rv64imafdc_zicsr".
+func riscvExtensions(isa string) []string {
+	isa = strings.ToLower(isa)
+	if !strings.HasPrefix(isa, "rv32") && !strings.HasPrefix(isa, "rv64") && !strings.HasPrefix(isa, "rv128") {
+		return nil
+	}
+	isa = strings.TrimLeft(strings.TrimPrefix(isa, "rv"), "0123456789")
+	parts := strings.Split(isa, "_")
+	var exts []string
+	for _, r := range parts[0] {
+		exts = append(exts, string(r))
+	}
+	for _, p := range parts[1:] {
+		if p != "" {
+			exts = append(exts, p)
+		}
+	}
+	return exts
+}
+
+// readProcCPUInfo - returns the fields of /proc/cpuinfo by processor.
+func readProcCPUInfo() map[int32]map[string]string {
+	infos := make(map[int32]map[string]string)
+	f, err := os.Open(procCPUInfo)
+	if err != nil {
+		return infos
+	}
+	defer f.Close()
+	var fields map[string]string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		i := strings.IndexByte(scanner.Text(), ':')
+		if i < 0 {
+			continue
+		}
+		key := strings.TrimSpace(scanner.Text()[:i])
+		value := strings.TrimSpace(scanner.Text()[i+1:])
+		if key == "processor" {
+			id, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				fields = nil
+				continue
+			}
+			fields = make(map[string]string)
+			infos[int32(id)] = fields
+			continue
+		}
+		if fields != nil {
+			fields[key] = value
+		}
+	}
+	return infos
+}
+
+// cpuCapacity - returns the relative capacity of a logical CPU on
+// heterogeneous systems, zero if the kernel does not report it.
+func cpuCapacity(id int) int {
+	capacity, err := ioutil.ReadFile(filepath.Join(sysfsRoot, "devices/system/cpu", "cpu"+strconv.Itoa(id), "cpu_capacity"))
+	if err != nil {
+		return 0
+	}
+	n, _ := strconv.Atoi(strings.TrimSpace(string(capacity)))
+	return n
+}
+
+// classifyCores - sets the core class of the logical CPUs of systems
+// with different core capacities, the cores with the highest capacity
+// are performance cores and all others efficiency cores.
+func classifyCores(cpus []LogicalCPU) {
+	max, min := 0, 0
+	for i, c := range cpus {
+		if i == 0 || c.Capacity > max {
+			max = c.Capacity
+		}
+		if i == 0 || c.Capacity < min {
+			min = c.Capacity
+		}
+	}
+	if min == max || min == 0 {
+		return
+	}
+	for i := range cpus {
+		cpus[i].CoreClass = CoreClassEfficiency
+		if cpus[i].Capacity == max {
+			cpus[i].CoreClass = CoreClassPerformance
+		}
+	}
+}
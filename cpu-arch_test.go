@@ -0,0 +1,103 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/shirou/gopsutil/cpu"
+)
+
+func TestEnrichCPUInfos(t *testing.T) {
+	root, err := ioutil.TempDir("", "sysfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	write := func(path, content string) {
+		path = filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("sys/devices/system/cpu/cpu0/regs/identification/midr_el1", "0x00000000413fd0c1\n")
+	write("proc/cpuinfo", `processor	: 1
+BogoMIPS	: 50.00
+CPU implementer	: 0xc0
+CPU architecture: 8
+CPU variant	: 0x0
+CPU part	: 0xac3
+CPU revision	: 0
+
+processor	: 2
+hart		: 2
+isa		: rv64imafdc_zicsr_zifencei
+mmu		: sv39
+uarch		: sifive,u74-mc
+mvendorid	: 0x489
+marchid		: 0x8000000000000007
+mimpid		: 0x4210427
+`)
+
+	defer func(old string) { sysfsRoot = old }(sysfsRoot)
+	defer func(old string) { procCPUInfo = old }(procCPUInfo)
+	sysfsRoot = filepath.Join(root, "sys")
+	procCPUInfo = filepath.Join(root, "proc/cpuinfo")
+
+	infos := []cpu.InfoStat{
+		{CPU: 0},
+		{CPU: 1},
+		{CPU: 2},
+		{CPU: 3, VendorID: "GenuineIntel", ModelName: "Xeon"},
+	}
+	enrichCPUInfos(infos)
+	expected := []cpu.InfoStat{
+		{CPU: 0, VendorID: "ARM", Model: "0xd0c", Stepping: 1, ModelName: "Neoverse-N1 r3p1"},
+		{CPU: 1, VendorID: "Ampere", Model: "0xac3", ModelName: "Ampere-1 r0p0"},
+		{
+			CPU: 2, VendorID: "SiFive", Model: "0x8000000000000007", ModelName: "sifive,u74-mc", Microcode: "0x4210427",
+			Flags: []string{"i", "m", "a", "f", "d", "c", "zicsr", "zifencei"},
+		},
+		{CPU: 3, VendorID: "GenuineIntel", ModelName: "Xeon"},
+	}
+	if !reflect.DeepEqual(infos, expected) {
+		t.Errorf("expected %+v, got %+v", expected, infos)
+	}
+}
+
+func TestClassifyCores(t *testing.T) {
+	cpus := []LogicalCPU{{ID: 0, Capacity: 446}, {ID: 1, Capacity: 446}, {ID: 2, Capacity: 1024}}
+	classifyCores(cpus)
+	for i, class := range []string{CoreClassEfficiency, CoreClassEfficiency, CoreClassPerformance} {
+		if cpus[i].CoreClass != class {
+			t.Errorf("expected core %d to be a %s core, got %q", i, class, cpus[i].CoreClass)
+		}
+	}
+
+	homogeneous := []LogicalCPU{{ID: 0, Capacity: 1024}, {ID: 1, Capacity: 1024}}
+	classifyCores(homogeneous)
+	if homogeneous[0].CoreClass != "" || homogeneous[1].CoreClass != "" {
+		t.Errorf("expected no core classes on homogeneous systems, got %+v", homogeneous)
+	}
+}
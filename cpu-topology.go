@@ -0,0 +1,152 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shirou/gopsutil/cpu"
+)
+
+// sysfsRoot is where sysfs is mounted, replaced in tests.
+var sysfsRoot = "/sys"
+
+// NUMANode - a NUMA node of a system.
+type NUMANode struct {
+	ID int `json:"id" yaml:"id"`
+	// CPUs are the logical CPUs of the node.
+	CPUs     []int    `json:"cpus" yaml:"cpus"`
+	MemTotal ByteSize `json:"mem_total,omitempty" yaml:"mem_total,omitempty"`
+}
+
+// LogicalCPU - placement and frequency governor of a logical CPU.
+type LogicalCPU struct {
+	ID         int    `json:"id" yaml:"id"`
+	PhysicalID string `json:"physical_id" yaml:"physical_id"`
+	CoreID     string `json:"core_id" yaml:"core_id"`
+	// NUMANode is -1 if the system does not report NUMA nodes.
+	NUMANode int    `json:"numa_node" yaml:"numa_node"`
+	Governor string `json:"governor,omitempty" yaml:"governor,omitempty"`
+	// ModelName differs between the cores of heterogeneous systems.
+	ModelName string `json:"model_name,omitempty" yaml:"model_name,omitempty"`
+	// Capacity is the relative capacity reported by the kernel on
+	// heterogeneous systems like ARM big.LITTLE, where CoreClass is
+	// CoreClassPerformance or CoreClassEfficiency.
+	Capacity  int    `json:"capacity,omitempty" yaml:"capacity,omitempty"`
+	CoreClass string `json:"core_class,omitempty" yaml:"core_class,omitempty"`
+}
+
+// cpuTopology - returns the logical CPUs and NUMA nodes of the system,
+// both nil if sysfs is not available.
+func cpuTopology(infos []cpu.InfoStat) ([]LogicalCPU, []NUMANode) {
+	nodes := numaNodes()
+	nodeOf := make(map[int]int)
+	for _, n := range nodes {
+		for _, c := range n.CPUs {
+			nodeOf[c] = n.ID
+		}
+	}
+
+	var cpus []LogicalCPU
+	for _, info := range infos {
+		id := int(info.CPU)
+		node, ok := nodeOf[id]
+		if !ok {
+			node = -1
+		}
+		governor, _ := ioutil.ReadFile(filepath.Join(sysfsRoot, "devices/system/cpu", "cpu"+strconv.Itoa(id), "cpufreq/scaling_governor"))
+		cpus = append(cpus, LogicalCPU{
+			ID:         id,
+			PhysicalID: info.PhysicalID,
+			CoreID:     info.CoreID,
+			NUMANode:   node,
+			Governor:   strings.TrimSpace(string(governor)),
+			ModelName:  info.ModelName,
+			Capacity:   cpuCapacity(id),
+		})
+	}
+	classifyCores(cpus)
+	return cpus, nodes
+}
+
+// numaNodes - reads the NUMA nodes from sysfs.
+func numaNodes() []NUMANode {
+	dirs, _ := filepath.Glob(filepath.Join(sysfsRoot, "devices/system/node/node[0-9]*"))
+	var nodes []NUMANode
+	for _, dir := range dirs {
+		id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "node"))
+		if err != nil {
+			continue
+		}
+		cpulist, err := ioutil.ReadFile(filepath.Join(dir, "cpulist"))
+		if err != nil {
+			continue
+		}
+		nodes = append(nodes, NUMANode{
+			ID:       id,
+			CPUs:     parseCPUList(string(cpulist)),
+			MemTotal: numaMemTotal(filepath.Join(dir, "meminfo")),
+		})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	return nodes
+}
+
+// parseCPUList - parses a kernel CPU list like "0-3,8,10-11".
+func parseCPUList(list string) []int {
+	var cpus []int
+	for _, r := range strings.Split(strings.TrimSpace(list), ",") {
+		lo, hi := r, r
+		if i := strings.IndexByte(r, '-'); i > 0 {
+			lo, hi = r[:i], r[i+1:]
+		}
+		from, err1 := strconv.Atoi(lo)
+		to, err2 := strconv.Atoi(hi)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		for c := from; c <= to; c++ {
+			cpus = append(cpus, c)
+		}
+	}
+	return cpus
+}
+
+// numaMemTotal - reads MemTotal from a node meminfo file, with lines
+// like "Node 0 MemTotal:       131895856 kB".
+func numaMemTotal(path string) ByteSize {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 4 && fields[2] == "MemTotal:" {
+			kb, _ := strconv.ParseUint(fields[3], 10, 64)
+			return ByteSize(kb) * KiB
+		}
+	}
+	return 0
+}
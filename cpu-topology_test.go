@@ -0,0 +1,77 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/shirou/gopsutil/cpu"
+)
+
+func TestCPUTopology(t *testing.T) {
+	root, err := ioutil.TempDir("", "sysfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	write := func(path, content string) {
+		path = filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("devices/system/node/node0/cpulist", "0,2\n")
+	write("devices/system/node/node0/meminfo", "Node 0 MemTotal:       1024 kB\nNode 0 MemFree:         512 kB\n")
+	write("devices/system/node/node1/cpulist", "1,3\n")
+	write("devices/system/cpu/cpu0/cpufreq/scaling_governor", "performance\n")
+	write("devices/system/cpu/cpu1/cpufreq/scaling_governor", "powersave\n")
+
+	defer func(old string) { sysfsRoot = old }(sysfsRoot)
+	sysfsRoot = root
+
+	cpus, nodes := cpuTopology([]cpu.InfoStat{
+		{CPU: 0, PhysicalID: "0", CoreID: "0"},
+		{CPU: 1, PhysicalID: "1", CoreID: "0"},
+		{CPU: 4, PhysicalID: "1", CoreID: "1"},
+	})
+	expectedNodes := []NUMANode{
+		{ID: 0, CPUs: []int{0, 2}, MemTotal: 1024 * KiB},
+		{ID: 1, CPUs: []int{1, 3}},
+	}
+	if !reflect.DeepEqual(nodes, expectedNodes) {
+		t.Errorf("expected NUMA nodes %+v, got %+v", expectedNodes, nodes)
+	}
+	expectedCPUs := []LogicalCPU{
+		{ID: 0, PhysicalID: "0", CoreID: "0", NUMANode: 0, Governor: "performance"},
+		{ID: 1, PhysicalID: "1", CoreID: "0", NUMANode: 1, Governor: "powersave"},
+		{ID: 4, PhysicalID: "1", CoreID: "1", NUMANode: -1},
+	}
+	if !reflect.DeepEqual(cpus, expectedCPUs) {
+		t.Errorf("expected logical CPUs %+v, got %+v", expectedCPUs, cpus)
+	}
+
+	if list := parseCPUList("0-3,8,10-11"); !reflect.DeepEqual(list, []int{0, 1, 2, 3, 8, 10, 11}) {
+		t.Errorf("unexpected CPU list %v", list)
+	}
+}
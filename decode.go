@@ -0,0 +1,88 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The Decode functions take responses received from the network or
+// read from files, which may be truncated or crafted. They return an
+// error instead of panicking, and reject data which is not a single
+// JSON value.
+
+// decodeJSON - unmarshals data into v, converting panics of custom
+// unmarshalers into errors.
+func decodeJSON(data []byte, v interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid data: %v", r)
+		}
+	}()
+	return json.Unmarshal(data, v)
+}
+
+// DecodeHealthInfo - decodes a version 0 or 1 health report, as read
+// from the healthinfo response or a saved report, into HealthInfo.
+// Deprecated fields are upgraded to their replacements.
+func DecodeHealthInfo(data []byte) (info HealthInfo, err error) {
+	var version healthInfoVersion
+	if err = decodeJSON(data, &version); err != nil {
+		return HealthInfo{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			info, err = HealthInfo{}, fmt.Errorf("invalid health info: %v", r)
+		}
+	}()
+	switch version.Version {
+	case HealthInfoVersion1:
+		if err = decodeJSON(data, &info); err != nil {
+			return HealthInfo{}, err
+		}
+		if err = info.Upgrade(); err != nil {
+			return HealthInfo{}, err
+		}
+		return info, nil
+	case HealthInfoVersion0:
+		var v0 healthInfoV0
+		if err = decodeJSON(data, &v0); err != nil {
+			return HealthInfo{}, err
+		}
+		return v0.toV1(), nil
+	}
+	return HealthInfo{}, fmt.Errorf("unsupported health info version %q", version.Version)
+}
+
+// DecodeServerInfo - decodes the response of ServerInfo.
+func DecodeServerInfo(data []byte) (InfoMessage, error) {
+	var info InfoMessage
+	if err := decodeJSON(data, &info); err != nil {
+		return InfoMessage{}, err
+	}
+	return info, nil
+}
+
+// DecodeTraceEvent - decodes a single event of the trace stream.
+func DecodeTraceEvent(data []byte) (TraceInfo, error) {
+	var info TraceInfo
+	if err := decodeJSON(data, &info); err != nil {
+		return TraceInfo{}, err
+	}
+	return info, nil
+}
@@ -0,0 +1,86 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//go:build go1.18
+// +build go1.18
+
+package madmin
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Run with go test -fuzz FuzzDecodeHealthInfo, the seeds are run by
+// go test.
+
+func FuzzDecodeHealthInfo(f *testing.F) {
+	f.Add([]byte(`{"version":"1","timestamp":"2021-10-14T08:00:00Z","sys":{"cpus":[{"addr":"node1:9000","cpus":[{"cores":4}]}]}}`))
+	f.Add([]byte(`{"version":"1","minio":{"info":{"servers":[{"endpoint":"node1:9000","drives":[{"totalspace":100,"availspace":0}]}]}}}`))
+	f.Add([]byte(`{"timestamp":"2021-10-14T08:00:00Z","perf":{"drives":[{"addr":"node1:9000","serial":[{"path":"/data1"}]}]}}`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		info, err := DecodeHealthInfo(data)
+		if err != nil {
+			return
+		}
+		// Consumers of decoded reports.
+		info.Summarize()
+		info.ToV2()
+		DiffHealthInfo(info, HealthInfo{})
+		if _, err = json.Marshal(info); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func FuzzDecodeHealthInfoV2(f *testing.F) {
+	f.Add([]byte(`{"version":"2","cpu":{"nodes":[{"addr":"node1:9000"}]}}`))
+	f.Add([]byte(`{"version":"1","minio":{"info":{"mode":"online"}}}`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		info, err := DecodeHealthInfoV2(data)
+		if err != nil {
+			return
+		}
+		info.Errors()
+	})
+}
+
+func FuzzDecodeServerInfo(f *testing.F) {
+	f.Add([]byte(`{"mode":"online","buckets":{"count":1},"backend":{"backendType":"Erasure"},"servers":[{"state":"online","endpoint":"node1:9000","drives":[{"path":"/data1","pool_index":0,"set_index":0,"disk_index":0}]}]}`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		info, err := DecodeServerInfo(data)
+		if err != nil {
+			return
+		}
+		if _, err = json.Marshal(info); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func FuzzDecodeTraceEvent(f *testing.F) {
+	f.Add([]byte(`{"type":0,"nodename":"node1:9000","funcname":"s3.GetObject","time":"2021-10-14T08:00:00Z","request":{"method":"GET","path":"/photos/a.jpg"},"response":{"statuscode":200},"stats":{"duration":1000}}`))
+	f.Add([]byte(`{"type":2,"storageStats":{"path":"/data1","duration":100}}`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		info, err := DecodeTraceEvent(data)
+		if err != nil {
+			return
+		}
+		if _, err = json.Marshal(info); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
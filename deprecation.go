@@ -0,0 +1,192 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Deprecated fields are kept on the wire so older servers and clients
+// keep working, and are marked with a struct tag naming the version
+// they were deprecated in and the field replacing them:
+//
+//	MountOptions     string   `json:"mount_options,omitempty" deprecated:"since=1,use=mount_options_list"`
+//	MountOptionsList []string `json:"mount_options_list,omitempty"`
+//
+// UpgradeDeprecatedFields fills replacements from deprecated fields
+// after decoding documents written by older versions.
+
+// FieldDeprecation - a parsed deprecated struct tag.
+type FieldDeprecation struct {
+	// Since is the version the field was deprecated in.
+	Since string
+	// Use is the JSON name of the replacing field.
+	Use string
+}
+
+// parseDeprecation - parses a deprecated struct tag.
+func parseDeprecation(tag string) (FieldDeprecation, error) {
+	var d FieldDeprecation
+	for _, kv := range strings.Split(tag, ",") {
+		i := strings.Index(kv, "=")
+		if i < 0 {
+			return d, fmt.Errorf("malformed deprecated tag %q", tag)
+		}
+		switch k, v := strings.TrimSpace(kv[:i]), strings.TrimSpace(kv[i+1:]); k {
+		case "since":
+			d.Since = v
+		case "use":
+			d.Use = v
+		default:
+			return d, fmt.Errorf("unknown key %q in deprecated tag %q", k, tag)
+		}
+	}
+	if d.Since == "" || d.Use == "" {
+		return d, fmt.Errorf("deprecated tag %q needs both since and use", tag)
+	}
+	return d, nil
+}
+
+// jsonFieldName - returns the JSON name of a struct field.
+func jsonFieldName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
+
+// convertDeprecated - converts the value of a deprecated field to the
+// type of its replacement, comma separated strings become lists.
+func convertDeprecated(old reflect.Value, to reflect.Type) (reflect.Value, error) {
+	switch {
+	case old.Type().AssignableTo(to):
+		return old, nil
+	case old.Kind() == reflect.String && to.Kind() == reflect.Slice && to.Elem().Kind() == reflect.String:
+		list := reflect.MakeSlice(to, 0, 0)
+		for _, s := range strings.Split(old.String(), ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				list = reflect.Append(list, reflect.ValueOf(s).Convert(to.Elem()))
+			}
+		}
+		return list, nil
+	case old.Type().ConvertibleTo(to):
+		return old.Convert(to), nil
+	}
+	return reflect.Value{}, fmt.Errorf("cannot convert %s to %s", old.Type(), to)
+}
+
+// deprecationVisitor - called for every deprecated field with its
+// value and the value of its replacement.
+type deprecationVisitor func(path string, d FieldDeprecation, old, replacement reflect.Value) error
+
+// walkDeprecated - calls visit for every deprecated field reachable
+// from v.
+func walkDeprecated(v reflect.Value, path string, visit deprecationVisitor) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return walkDeprecated(v.Elem(), path, visit)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := walkDeprecated(v.Index(i), fmt.Sprintf("%s[%d]", path, i), visit); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+	default:
+		return nil
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		fieldPath := jsonFieldName(f)
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		if tag, ok := f.Tag.Lookup("deprecated"); ok {
+			d, err := parseDeprecation(tag)
+			if err != nil {
+				return fmt.Errorf("%s: %w", fieldPath, err)
+			}
+			replacement := -1
+			for j := 0; j < t.NumField(); j++ {
+				if t.Field(j).PkgPath == "" && jsonFieldName(t.Field(j)) == d.Use {
+					replacement = j
+				}
+			}
+			if replacement < 0 || replacement == i {
+				return fmt.Errorf("%s: replacement field %q does not exist", fieldPath, d.Use)
+			}
+			if err = visit(fieldPath, d, v.Field(i), v.Field(replacement)); err != nil {
+				return err
+			}
+		}
+		if err := walkDeprecated(v.Field(i), fieldPath, visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpgradeDeprecatedFields - fills every unset replacement field of the
+// value pointed to by v from its deprecated field. Deprecated fields are
+// left in place for older readers.
+func UpgradeDeprecatedFields(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return ErrInvalidArgument("UpgradeDeprecatedFields needs a non-nil pointer.")
+	}
+	return walkDeprecated(rv, "", func(path string, d FieldDeprecation, old, replacement reflect.Value) error {
+		if old.IsZero() || !replacement.IsZero() {
+			return nil
+		}
+		converted, err := convertDeprecated(old, replacement.Type())
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		replacement.Set(converted)
+		return nil
+	})
+}
+
+// ValidateDeprecatedFields - checks the deprecated tags of v and that
+// deprecated fields set together with their replacement agree with it.
+func ValidateDeprecatedFields(v interface{}) error {
+	return walkDeprecated(reflect.ValueOf(v), "", func(path string, d FieldDeprecation, old, replacement reflect.Value) error {
+		if old.IsZero() || replacement.IsZero() {
+			return nil
+		}
+		converted, err := convertDeprecated(old, replacement.Type())
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if !reflect.DeepEqual(converted.Interface(), replacement.Interface()) {
+			return fmt.Errorf("%s: deprecated since version %s conflicts with %s", path, d.Since, d.Use)
+		}
+		return nil
+	})
+}
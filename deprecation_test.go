@@ -0,0 +1,81 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestHealthInfoUpgrade(t *testing.T) {
+	// Report of an older server without mount_options_list.
+	data := `{"version":"1","sys":{"partitions":[{"addr":"node1","partitions":[` +
+		`{"device":"/dev/sda","mount_options":"rw, noatime"},` +
+		`{"device":"/dev/sdb","mount_options":"ro","mount_options_list":["ro"]}]}]}}`
+
+	var info HealthInfo
+	if err := json.Unmarshal([]byte(data), &info); err != nil {
+		t.Fatal(err)
+	}
+	if err := info.Upgrade(); err != nil {
+		t.Fatal(err)
+	}
+	parts := info.Sys.Partitions[0].Partitions
+	if !reflect.DeepEqual(parts[0].MountOptionsList, []string{"rw", "noatime"}) {
+		t.Errorf("unexpected mount options %v", parts[0].MountOptionsList)
+	}
+	if parts[0].MountOptions != "rw, noatime" {
+		t.Errorf("deprecated field must be kept, got %q", parts[0].MountOptions)
+	}
+	if err := info.Validate(); err != nil {
+		t.Errorf("unexpected validation error %v", err)
+	}
+
+	parts[1].MountOptionsList = []string{"rw"}
+	if err := info.Validate(); err == nil {
+		t.Error("expected conflicting mount options to be reported")
+	}
+}
+
+func TestDeprecatedTags(t *testing.T) {
+	var malformed struct {
+		Old string `json:"old" deprecated:"use=new"`
+		New string `json:"new"`
+	}
+	if err := UpgradeDeprecatedFields(&malformed); err == nil {
+		t.Error("expected tag without since to be rejected")
+	}
+
+	var missing struct {
+		Old string `json:"old" deprecated:"since=2,use=new"`
+	}
+	if err := ValidateDeprecatedFields(missing); err == nil {
+		t.Error("expected missing replacement to be rejected")
+	}
+
+	renamed := struct {
+		Old int64    `json:"old" deprecated:"since=2,use=new"`
+		New ByteSize `json:"new"`
+	}{Old: 10}
+	if err := UpgradeDeprecatedFields(&renamed); err != nil || renamed.New != 10 {
+		t.Errorf("unexpected upgrade %+v: %v", renamed, err)
+	}
+	if err := UpgradeDeprecatedFields(renamed); err == nil {
+		t.Error("expected non-pointer to be rejected")
+	}
+}
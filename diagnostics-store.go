@@ -0,0 +1,332 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Kinds of diagnostics snapshots recorded by the helpers below.
+const (
+	DiagnosticsKindHealth  = "health"
+	DiagnosticsKindMetrics = "metrics"
+)
+
+// ErrNoSnapshot is returned by Latest when no snapshot was recorded.
+var ErrNoSnapshot = errors.New("no diagnostics snapshot found")
+
+const diagnosticsSegmentExt = ".jsonl"
+
+// DiagnosticsSnapshot is a single recorded snapshot.
+type DiagnosticsSnapshot struct {
+	Time time.Time       `json:"time"`
+	Kind string          `json:"kind"`
+	Data json.RawMessage `json:"data"`
+}
+
+// DiagnosticsStoreOptions - options for OpenDiagnosticsStore.
+type DiagnosticsStoreOptions struct {
+	// Dir holds the store, it is created if missing.
+	Dir string
+	// MaxAge removes snapshots older than this, zero keeps everything.
+	// The newest segment and the one last written to are never removed.
+	MaxAge time.Duration
+	// MaxBytes removes the oldest snapshots once the store grows
+	// beyond this size, zero means unlimited. The latest snapshot is
+	// always kept.
+	MaxBytes int64
+	// Redaction is applied to every snapshot before it is written.
+	Redaction *RedactionPolicy
+}
+
+// DiagnosticsStore is an embedded store recording periodic health and
+// metrics snapshots on local disk, meant for air-gapped sites that
+// cannot ship diagnostics anywhere. Snapshots are appended to daily
+// JSON lines segments which are dropped as a whole by the retention
+// policy, only the segment written to is trimmed to fit MaxBytes.
+type DiagnosticsStore struct {
+	mu   sync.Mutex
+	opts DiagnosticsStoreOptions
+}
+
+// OpenDiagnosticsStore - opens or creates a diagnostics store.
+func OpenDiagnosticsStore(opts DiagnosticsStoreOptions) (*DiagnosticsStore, error) {
+	if opts.Dir == "" {
+		return nil, ErrInvalidArgument("Diagnostics store directory cannot be empty.")
+	}
+	if err := os.MkdirAll(opts.Dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &DiagnosticsStore{opts: opts}, nil
+}
+
+// Record - appends a snapshot of v taken at t.
+func (s *DiagnosticsStore) Record(kind string, t time.Time, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if data, err = s.opts.Redaction.RedactJSON(data); err != nil {
+		return err
+	}
+	line, err := json.Marshal(DiagnosticsSnapshot{Time: t.UTC(), Kind: kind, Data: data})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segment := s.segmentPath(t)
+	f, err := os.OpenFile(segment, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return s.prune(t, filepath.Base(segment))
+}
+
+// RecordHealth - records a HealthInfo snapshot at its own timestamp.
+func (s *DiagnosticsStore) RecordHealth(info HealthInfo) error {
+	ts := info.TimeStamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	return s.Record(DiagnosticsKindHealth, ts, info)
+}
+
+// Query - returns snapshots of kind recorded within [from, to], oldest
+// first. An empty kind matches all snapshots.
+func (s *DiagnosticsStore) Query(kind string, from, to time.Time) ([]DiagnosticsSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments, err := s.segments()
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []DiagnosticsSnapshot
+	for _, segment := range segments {
+		day, err := time.Parse("2006-01-02", strings.TrimSuffix(segment, diagnosticsSegmentExt))
+		if err != nil {
+			continue
+		}
+		if day.Add(24*time.Hour).Before(from) || day.After(to) {
+			continue
+		}
+		if err = s.readSegment(segment, func(snapshot DiagnosticsSnapshot) {
+			if kind != "" && snapshot.Kind != kind {
+				return
+			}
+			if snapshot.Time.Before(from) || snapshot.Time.After(to) {
+				return
+			}
+			snapshots = append(snapshots, snapshot)
+		}); err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Time.Before(snapshots[j].Time)
+	})
+	return snapshots, nil
+}
+
+// Latest - returns the most recent snapshot of kind.
+func (s *DiagnosticsStore) Latest(kind string) (DiagnosticsSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments, err := s.segments()
+	if err != nil {
+		return DiagnosticsSnapshot{}, err
+	}
+	for i := len(segments) - 1; i >= 0; i-- {
+		var latest *DiagnosticsSnapshot
+		if err = s.readSegment(segments[i], func(snapshot DiagnosticsSnapshot) {
+			if snapshot.Kind != kind {
+				return
+			}
+			if latest == nil || !snapshot.Time.Before(latest.Time) {
+				latest = &snapshot
+			}
+		}); err != nil {
+			return DiagnosticsSnapshot{}, err
+		}
+		if latest != nil {
+			return *latest, nil
+		}
+	}
+	return DiagnosticsSnapshot{}, ErrNoSnapshot
+}
+
+// Prune - applies the retention policy relative to now.
+func (s *DiagnosticsStore) Prune(now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.prune(now, filepath.Base(s.segmentPath(now)))
+}
+
+// prune - applies the retention policy, current is the segment last
+// written to. Neither current nor the newest segment are removed, they
+// are trimmed instead.
+func (s *DiagnosticsStore) prune(now time.Time, current string) error {
+	segments, err := s.segments()
+	if err != nil {
+		return err
+	}
+	if len(segments) == 0 {
+		return nil
+	}
+	newest := segments[len(segments)-1]
+	keep := func(segment string) bool {
+		return segment == current || segment == newest
+	}
+
+	if s.opts.MaxAge > 0 {
+		cutoff := now.Add(-s.opts.MaxAge)
+		remaining := segments[:0]
+		for _, segment := range segments {
+			day, err := time.Parse("2006-01-02", strings.TrimSuffix(segment, diagnosticsSegmentExt))
+			if err == nil && day.Add(24*time.Hour).Before(cutoff) && !keep(segment) {
+				if err = os.Remove(filepath.Join(s.opts.Dir, segment)); err != nil {
+					return err
+				}
+				continue
+			}
+			remaining = append(remaining, segment)
+		}
+		segments = remaining
+	}
+
+	if s.opts.MaxBytes > 0 {
+		var total int64
+		sizes := make(map[string]int64, len(segments))
+		for _, segment := range segments {
+			fi, err := os.Stat(filepath.Join(s.opts.Dir, segment))
+			if err != nil {
+				return err
+			}
+			sizes[segment] = fi.Size()
+			total += fi.Size()
+		}
+		for i := 0; i < len(segments) && total > s.opts.MaxBytes; i++ {
+			if keep(segments[i]) {
+				continue
+			}
+			if err = os.Remove(filepath.Join(s.opts.Dir, segments[i])); err != nil {
+				return err
+			}
+			total -= sizes[segments[i]]
+		}
+		// Trim the older of the kept segments first.
+		trim := []string{newest}
+		if _, ok := sizes[current]; ok && current != newest {
+			trim = []string{current, newest}
+		}
+		for _, segment := range trim {
+			if total <= s.opts.MaxBytes {
+				break
+			}
+			size, err := s.trimSegment(segment, sizes[segment]-(total-s.opts.MaxBytes))
+			if err != nil {
+				return err
+			}
+			total -= sizes[segment] - size
+		}
+	}
+	return nil
+}
+
+// trimSegment - removes the oldest snapshots of a segment until it fits
+// into limit bytes, but keeps its latest snapshot. Returns the size of
+// the trimmed segment.
+func (s *DiagnosticsStore) trimSegment(segment string, limit int64) (int64, error) {
+	name := filepath.Join(s.opts.Dir, segment)
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return 0, err
+	}
+	// Skip the trailing newline when looking for the latest line.
+	last := bytes.LastIndexByte(bytes.TrimSuffix(data, []byte{'\n'}), '\n') + 1
+	start := 0
+	for int64(len(data)-start) > limit && start < last {
+		start += bytes.IndexByte(data[start:], '\n') + 1
+	}
+	tmp := name + ".tmp"
+	if err = ioutil.WriteFile(tmp, data[start:], 0o600); err != nil {
+		return 0, err
+	}
+	return int64(len(data) - start), os.Rename(tmp, name)
+}
+
+func (s *DiagnosticsStore) segmentPath(t time.Time) string {
+	return filepath.Join(s.opts.Dir, t.UTC().Format("2006-01-02")+diagnosticsSegmentExt)
+}
+
+// segments - returns segment file names, oldest first.
+func (s *DiagnosticsStore) segments() ([]string, error) {
+	entries, err := ioutil.ReadDir(s.opts.Dir)
+	if err != nil {
+		return nil, err
+	}
+	var segments []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), diagnosticsSegmentExt) {
+			segments = append(segments, entry.Name())
+		}
+	}
+	sort.Strings(segments)
+	return segments, nil
+}
+
+func (s *DiagnosticsStore) readSegment(segment string, fn func(DiagnosticsSnapshot)) error {
+	f, err := os.Open(filepath.Join(s.opts.Dir, segment))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 256<<20)
+	for scanner.Scan() {
+		var snapshot DiagnosticsSnapshot
+		// Skip lines torn by a crash in the middle of a write.
+		if err := json.Unmarshal(scanner.Bytes(), &snapshot); err != nil {
+			continue
+		}
+		fn(snapshot)
+	}
+	return scanner.Err()
+}
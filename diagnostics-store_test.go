@@ -0,0 +1,169 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDiagnosticsStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diagnostics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := OpenDiagnosticsStore(DiagnosticsStoreOptions{Dir: dir, MaxAge: 48 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	day := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		ts := day.Add(time.Duration(i) * 24 * time.Hour)
+		if err = s.Record(DiagnosticsKindMetrics, ts, map[string]int{"day": i}); err != nil {
+			t.Fatal(err)
+		}
+		if err = s.Record(DiagnosticsKindHealth, ts.Add(time.Hour), map[string]int{"day": i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Only the segments within the last 48 hours must survive.
+	all, err := s.Query("", day, day.Add(10*24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 6 {
+		t.Fatalf("expected 6 snapshots after retention, got %d", len(all))
+	}
+
+	metrics, err := s.Query(DiagnosticsKindMetrics, day.Add(3*24*time.Hour), day.Add(4*24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metrics) != 2 || string(metrics[0].Data) != `{"day":3}` {
+		t.Fatalf("unexpected metrics snapshots %v", metrics)
+	}
+
+	latest, err := s.Latest(DiagnosticsKindHealth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(latest.Data) != `{"day":4}` {
+		t.Fatalf("unexpected latest snapshot %s", latest.Data)
+	}
+
+	if _, err = s.Latest("unknown"); err != ErrNoSnapshot {
+		t.Fatalf("expected ErrNoSnapshot, got %v", err)
+	}
+}
+
+func TestDiagnosticsStoreMaxBytes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diagnostics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := OpenDiagnosticsStore(DiagnosticsStoreOptions{Dir: dir, MaxBytes: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A single busy day stays within the limit.
+	day := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 100; i++ {
+		if err = s.Record(DiagnosticsKindMetrics, day.Add(time.Duration(i)*time.Minute), map[string]int{"minute": i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fi, err := os.Stat(s.segmentPath(day))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Size() > 1024 {
+		t.Errorf("expected at most 1024 bytes, got %d", fi.Size())
+	}
+	all, err := s.Query("", day, day.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) == 0 || len(all) == 100 || string(all[len(all)-1].Data) != `{"minute":99}` {
+		t.Fatalf("expected the newest snapshots to be kept, got %d", len(all))
+	}
+
+	// The latest snapshot is kept even if it alone exceeds the limit.
+	large := strings.Repeat("x", 2048)
+	if err = s.Record(DiagnosticsKindMetrics, day.Add(24*time.Hour), large); err != nil {
+		t.Fatal(err)
+	}
+	if all, err = s.Query("", day, day.Add(48*time.Hour)); err != nil || len(all) != 1 {
+		t.Fatalf("expected only the latest snapshot, got %d: %v", len(all), err)
+	}
+}
+
+func TestDiagnosticsStoreBackdated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diagnostics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := OpenDiagnosticsStore(DiagnosticsStoreOptions{Dir: dir, MaxAge: 48 * time.Hour, MaxBytes: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	day := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	newest := day.Add(5 * 24 * time.Hour)
+	snapshot := strings.Repeat("x", 300)
+	if err = s.Record(DiagnosticsKindMetrics, day, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Record(DiagnosticsKindMetrics, newest, snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	// Exceeding MaxBytes with a backdated snapshot removes the oldest
+	// day, but neither the backdated nor the newest snapshot.
+	if err = s.Record(DiagnosticsKindHealth, day.Add(3*24*time.Hour), strings.Repeat("y", 700)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.Latest(DiagnosticsKindHealth); err != nil {
+		t.Fatalf("expected the backdated snapshot to be kept, got %v", err)
+	}
+	all, err := s.Query(DiagnosticsKindMetrics, day, newest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 || !all[0].Time.Equal(newest) {
+		t.Fatalf("expected only the newest metrics snapshot, got %v", all)
+	}
+
+	// Pruning long after the last snapshot keeps the newest one.
+	if err = s.Prune(newest.Add(30 * 24 * time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if all, err = s.Query("", day, newest); err != nil || len(all) != 1 || !all[0].Time.Equal(newest) {
+		t.Fatalf("expected only the newest snapshot, got %v: %v", all, err)
+	}
+}
@@ -0,0 +1,69 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+// DrivePerfMode - workload of a drive perf test.
+type DrivePerfMode string
+
+// Drive perf modes.
+const (
+	// DrivePerfSeqWrite writes large blocks sequentially.
+	DrivePerfSeqWrite DrivePerfMode = "seq-write"
+	// DrivePerfSeqRead reads large blocks sequentially.
+	DrivePerfSeqRead DrivePerfMode = "seq-read"
+	// DrivePerfRand4K reads and writes 4 KiB blocks at random offsets.
+	DrivePerfRand4K DrivePerfMode = "rand-4k"
+	// DrivePerfMixed reads 70% and writes 30% of randomly sized
+	// blocks, close to a typical object workload.
+	DrivePerfMixed DrivePerfMode = "mixed-70-30"
+)
+
+// DrivePerfModes - all drive perf modes.
+var DrivePerfModes = []DrivePerfMode{
+	DrivePerfSeqWrite,
+	DrivePerfSeqRead,
+	DrivePerfRand4K,
+	DrivePerfMixed,
+}
+
+// IsValid - returns true if m is a known mode.
+func (m DrivePerfMode) IsValid() bool {
+	for _, mode := range DrivePerfModes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// ByMode - returns the serial and parallel results of mode.
+func (d DrivePerfInfos) ByMode(mode DrivePerfMode) (serial, parallel []DrivePerfInfo) {
+	match := func(p DrivePerfInfo) bool {
+		return p.Mode == mode || (p.Mode == "" && mode == DrivePerfSeqWrite)
+	}
+	for _, p := range d.SerialPerf {
+		if match(p) {
+			serial = append(serial, p)
+		}
+	}
+	for _, p := range d.ParallelPerf {
+		if match(p) {
+			parallel = append(parallel, p)
+		}
+	}
+	return serial, parallel
+}
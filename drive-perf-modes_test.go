@@ -0,0 +1,76 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestDrivePerfModes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("perfdrivemodes") != "seq-read,mixed-70-30" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"Code":"AccessDenied"}`))
+			return
+		}
+		w.Write([]byte(`{"version":"` + HealthInfoVersion + `"}`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := []HealthDataType{HealthDataTypePerfDrive}
+	resp, _, err := adm.ServerHealthInfoWithOpts(context.Background(), types, HealthInfoOpts{
+		DrivePerfModes: []DrivePerfMode{DrivePerfSeqRead, DrivePerfMixed},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	closeResponse(resp)
+
+	_, _, err = adm.ServerHealthInfoWithOpts(context.Background(), types, HealthInfoOpts{
+		DrivePerfModes: []DrivePerfMode{"rand-1m"},
+	})
+	if err == nil {
+		t.Error("expected an error for an unknown mode")
+	}
+
+	infos := DrivePerfInfos{
+		SerialPerf: []DrivePerfInfo{
+			{Path: "/data1"},
+			{Path: "/data1", Mode: DrivePerfRand4K, IOPS: 20000},
+		},
+		ParallelPerf: []DrivePerfInfo{
+			{Path: "/data1", Mode: DrivePerfSeqWrite},
+		},
+	}
+	serial, parallel := infos.ByMode(DrivePerfSeqWrite)
+	if len(serial) != 1 || serial[0].Mode != "" || len(parallel) != 1 {
+		t.Errorf("unexpected seq-write results %v %v", serial, parallel)
+	}
+	serial, parallel = infos.ByMode(DrivePerfRand4K)
+	if len(serial) != 1 || serial[0].IOPS != 20000 || len(parallel) != 0 {
+		t.Errorf("unexpected rand-4k results %v %v", serial, parallel)
+	}
+}
@@ -0,0 +1,118 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DrivePerf - raw read and write performance of a drive.
+type DrivePerf struct {
+	Path  string `json:"path"`
+	Error string `json:"error,omitempty"`
+
+	// Set is the erasure set of the drive, nil if not reported.
+	Set *SpeedtestTarget `json:"set,omitempty"`
+
+	ReadThroughput  uint64 `json:"readThroughput"`  // bytes per second
+	WriteThroughput uint64 `json:"writeThroughput"` // bytes per second
+	ReadIOPS        uint64 `json:"readIOPS"`
+	WriteIOPS       uint64 `json:"writeIOPS"`
+}
+
+// DriveSpeedTestResult - drive speedtest results of a server.
+type DriveSpeedTestResult struct {
+	Version   string      `json:"version"`
+	Endpoint  string      `json:"endpoint"`
+	DrivePerf []DrivePerf `json:"drivePerf,omitempty"`
+	// Error is set if the server failed to test its drives.
+	Error string `json:"error,omitempty"`
+}
+
+// DriveSpeedTestOpts - options of DriveSpeedtest.
+type DriveSpeedTestOpts struct {
+	// Serial tests the drives of a server one at a time instead of
+	// in parallel.
+	Serial bool
+	// BlockSize of reads and writes, FileSize of the test file per
+	// drive. Server defaults are used if zero.
+	BlockSize uint64
+	FileSize  uint64
+}
+
+// DriveSpeedtest - runs the raw drive read/write benchmark of the
+// servers, bypassing erasure coding, and sends the results of each
+// server on the returned channel, which is closed once all servers
+// reported.
+func (adm *AdminClient) DriveSpeedtest(ctx context.Context, opts DriveSpeedTestOpts) (chan DriveSpeedTestResult, error) {
+	if opts.FileSize > 0 && opts.BlockSize > opts.FileSize {
+		return nil, ErrInvalidArgument("Block size must not exceed the file size.")
+	}
+
+	queryValues := url.Values{}
+	queryValues.Set("serial", strconv.FormatBool(opts.Serial))
+	if opts.BlockSize > 0 {
+		queryValues.Set("blocksize", strconv.FormatUint(opts.BlockSize, 10))
+	}
+	if opts.FileSize > 0 {
+		queryValues.Set("filesize", strconv.FormatUint(opts.FileSize, 10))
+	}
+
+	resp, err := adm.executeMethod(ctx, http.MethodPost, requestData{
+		relPath:           adminAPIPrefix + "/speedtest/drive",
+		queryValues:       queryValues,
+		unboundedResponse: true,
+		messageStream:     true,
+	})
+	if err != nil {
+		closeResponse(resp)
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer closeResponse(resp)
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	ch := make(chan DriveSpeedTestResult)
+	go func() {
+		defer closeResponse(resp)
+		defer close(ch)
+		dec := adm.newResponseDecoder(resp)
+		for {
+			result := DriveSpeedTestResult{}
+			if err := dec.Decode(&result); err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					select {
+					case ch <- DriveSpeedTestResult{Error: err.Error()}:
+					case <-ctx.Done():
+					}
+				}
+				return
+			}
+			select {
+			case ch <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
@@ -0,0 +1,65 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestDriveSpeedtest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/minio/admin/v3/speedtest/drive" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("serial") != "true" || q.Get("blocksize") != "4194304" || q.Get("filesize") != "1073741824" {
+			t.Errorf("unexpected query %v", q)
+		}
+		w.Write([]byte(`{"version":"1","endpoint":"node1:9000","drivePerf":[{"path":"/data1","set":{"pool":0,"set":1},"readThroughput":2000000000,"writeThroughput":1000000000,"readIOPS":480,"writeIOPS":240}]}` + "\n"))
+		w.Write([]byte(`{"version":"1","endpoint":"node2:9000","error":"drive offline"}` + "\n"))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, err := adm.DriveSpeedtest(context.Background(), DriveSpeedTestOpts{Serial: true, BlockSize: 4 << 20, FileSize: 1 << 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []DriveSpeedTestResult
+	for r := range ch {
+		results = append(results, r)
+	}
+	if len(results) != 2 || results[1].Error != "drive offline" || len(results[0].DrivePerf) != 1 {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	perf := results[0].DrivePerf[0]
+	if perf.ReadThroughput != 2000000000 || perf.WriteIOPS != 240 || perf.Set == nil || perf.Set.Set != 1 {
+		t.Errorf("unexpected drive perf %+v", perf)
+	}
+
+	if _, err = adm.DriveSpeedtest(context.Background(), DriveSpeedTestOpts{BlockSize: 2, FileSize: 1}); err == nil {
+		t.Error("expected an error for a block size above the file size")
+	}
+}
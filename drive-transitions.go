@@ -0,0 +1,162 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// DriveTransition - a change of the state of a drive, e.g. from
+// DriveStateOk to DriveStateOffline.
+type DriveTransition struct {
+	Endpoint string    `json:"endpoint"`
+	Node     string    `json:"node"`
+	From     string    `json:"from"`
+	To       string    `json:"to"`
+	Time     time.Time `json:"time"`
+	Reason   string    `json:"reason,omitempty"`
+}
+
+// DriveTransitionOpts - filters for GetDriveTransitions.
+type DriveTransitionOpts struct {
+	// Since only returns transitions after this time, the server
+	// keeps a bounded history.
+	Since time.Time
+	// Endpoint only returns transitions of this drive.
+	Endpoint string
+}
+
+// GetDriveTransitions - returns the recent drive state transitions of
+// the cluster, oldest first.
+func (adm *AdminClient) GetDriveTransitions(ctx context.Context, opts DriveTransitionOpts) ([]DriveTransition, error) {
+	v := url.Values{}
+	if !opts.Since.IsZero() {
+		v.Set("since", opts.Since.UTC().Format(time.RFC3339Nano))
+	}
+	if opts.Endpoint != "" {
+		v.Set("endpoint", opts.Endpoint)
+	}
+	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{
+		relPath:     adminAPIPrefix + "/drive-transitions",
+		queryValues: v,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	var transitions []DriveTransition
+	if err = adm.decodeResponse(resp, &transitions); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(transitions, func(i, j int) bool {
+		return transitions[i].Time.Before(transitions[j].Time)
+	})
+	return transitions, nil
+}
+
+// DriveCondition - classification of a drive from its transitions.
+type DriveCondition string
+
+// Drive conditions
+const (
+	DriveStable      DriveCondition = "stable"
+	DriveFlapping    DriveCondition = "flapping"
+	DriveHardFailure DriveCondition = "hard-failure"
+)
+
+// DriveHistory - the transitions of a drive and its classification.
+type DriveHistory struct {
+	Endpoint    string            `json:"endpoint"`
+	Node        string            `json:"node"`
+	State       string            `json:"state"`
+	Condition   DriveCondition    `json:"condition"`
+	Transitions []DriveTransition `json:"transitions"`
+	// OfflineSince is set while the drive is not DriveStateOk.
+	OfflineSince time.Time `json:"offlineSince,omitempty"`
+}
+
+// DefaultDriveFlapThreshold - failures after which a drive is flapping,
+// used by ClassifyDriveTransitions if no threshold is given.
+const DefaultDriveFlapThreshold = 3
+
+// ClassifyDriveTransitions - groups transitions by drive. A drive that
+// went offline at least flapThreshold times is flapping, a drive that
+// is not ok and never recovered is a hard failure. A flapThreshold of
+// 0 or less means DefaultDriveFlapThreshold.
+func ClassifyDriveTransitions(transitions []DriveTransition, flapThreshold int) []DriveHistory {
+	if flapThreshold <= 0 {
+		flapThreshold = DefaultDriveFlapThreshold
+	}
+	byDrive := make(map[string]*DriveHistory)
+	sorted := append([]DriveTransition(nil), transitions...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	var histories []*DriveHistory
+	for _, t := range sorted {
+		h, ok := byDrive[t.Endpoint]
+		if !ok {
+			h = &DriveHistory{Endpoint: t.Endpoint, Node: t.Node}
+			byDrive[t.Endpoint] = h
+			histories = append(histories, h)
+		}
+		h.Transitions = append(h.Transitions, t)
+		h.State = t.To
+		switch {
+		case t.To == DriveStateOk:
+			h.OfflineSince = time.Time{}
+		case h.OfflineSince.IsZero():
+			h.OfflineSince = t.Time
+		}
+	}
+
+	result := make([]DriveHistory, 0, len(histories))
+	for _, h := range histories {
+		var failures, recoveries int
+		for _, t := range h.Transitions {
+			switch {
+			case t.From == DriveStateOk && t.To != DriveStateOk:
+				failures++
+			case t.From != DriveStateOk && t.To == DriveStateOk:
+				recoveries++
+			}
+		}
+		switch {
+		case failures >= flapThreshold:
+			h.Condition = DriveFlapping
+		case h.State != DriveStateOk && recoveries == 0:
+			h.Condition = DriveHardFailure
+		default:
+			h.Condition = DriveStable
+		}
+		result = append(result, *h)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Endpoint < result[j].Endpoint
+	})
+	return result
+}
@@ -0,0 +1,65 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClassifyDriveTransitions(t *testing.T) {
+	t0 := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return t0.Add(time.Duration(minutes) * time.Minute) }
+	transitions := []DriveTransition{
+		{Endpoint: "http://n1/d1", From: DriveStateOk, To: DriveStateOffline, Time: at(1)},
+		{Endpoint: "http://n1/d1", From: DriveStateOffline, To: DriveStateOk, Time: at(2)},
+		{Endpoint: "http://n1/d1", From: DriveStateOk, To: DriveStateOffline, Time: at(3)},
+		{Endpoint: "http://n1/d1", From: DriveStateOffline, To: DriveStateOk, Time: at(4)},
+		{Endpoint: "http://n1/d1", From: DriveStateOk, To: DriveStateOffline, Time: at(5)},
+		{Endpoint: "http://n2/d1", From: DriveStateOk, To: DriveStateFaulty, Time: at(7)},
+		{Endpoint: "http://n2/d1", From: DriveStateFaulty, To: DriveStateOffline, Time: at(8)},
+		{Endpoint: "http://n3/d1", From: DriveStateOk, To: DriveStateOffline, Time: at(2)},
+		{Endpoint: "http://n3/d1", From: DriveStateOffline, To: DriveStateOk, Time: at(6)},
+	}
+	histories := ClassifyDriveTransitions(transitions, 3)
+	if len(histories) != 3 {
+		t.Fatalf("expected 3 drives, got %d", len(histories))
+	}
+	expected := []struct {
+		condition    DriveCondition
+		state        string
+		offlineSince time.Time
+	}{
+		{DriveFlapping, DriveStateOffline, at(5)},
+		{DriveHardFailure, DriveStateOffline, at(7)},
+		{DriveStable, DriveStateOk, time.Time{}},
+	}
+	for i, e := range expected {
+		h := histories[i]
+		if h.Condition != e.condition || h.State != e.state || !h.OfflineSince.Equal(e.offlineSince) {
+			t.Errorf("%s: expected %+v, got %s %s %s", h.Endpoint, e, h.Condition, h.State, h.OfflineSince)
+		}
+	}
+
+	// Without a threshold the default applies instead of every drive
+	// flapping.
+	for i, h := range ClassifyDriveTransitions(transitions, 0) {
+		if h.Condition != expected[i].condition {
+			t.Errorf("%s: expected %s with the default threshold, got %s", h.Endpoint, expected[i].condition, h.Condition)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"time"
+)
+
+// smartctlTimeout bounds every smartctl run, some controllers take
+// long to answer and a hung drive may not answer at all.
+const smartctlTimeout = 30 * time.Second
+
+// SMARTInfo - SMART health of a drive.
+type SMARTInfo struct {
+	Device string `json:"device" yaml:"device"`
+	Error  string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Model  string `json:"model,omitempty" yaml:"model,omitempty"`
+	Serial string `json:"serial,omitempty" yaml:"serial,omitempty"`
+	// Passed is the overall health self-assessment of the drive.
+	Passed             bool   `json:"passed" yaml:"passed"`
+	ReallocatedSectors uint64 `json:"reallocated_sectors,omitempty" yaml:"reallocated_sectors,omitempty"`
+	PendingSectors     uint64 `json:"pending_sectors,omitempty" yaml:"pending_sectors,omitempty"`
+	MediaErrors        uint64 `json:"media_errors,omitempty" yaml:"media_errors,omitempty"`
+	// WearLevel is the share of the rated endurance of an SSD used, in
+	// percent, nil for drives without wear indicator.
+	WearLevel *int `json:"wear_level,omitempty" yaml:"wear_level,omitempty"`
+	// Temperature in degrees Celsius.
+	Temperature  int    `json:"temperature,omitempty" yaml:"temperature,omitempty"`
+	PowerOnHours uint64 `json:"power_on_hours,omitempty" yaml:"power_on_hours,omitempty"`
+}
+
+// DrivesSMART - SMART health of the drives of a node.
+type DrivesSMART struct {
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Drives []SMARTInfo `json:"drives,omitempty" yaml:"drives,omitempty"`
+}
+
+// GetDrivesSMART returns the SMART health of all drives of a node
+// running linux, read with smartctl which needs to be installed and
+// usually requires root.
+func GetDrivesSMART(ctx context.Context, addr string) DrivesSMART {
+	if runtime.GOOS != "linux" {
+		return DrivesSMART{
+			Addr:  addr,
+			Error: "unsupported operating system " + runtime.GOOS,
+		}
+	}
+
+	out, err := runSmartctl(ctx, "--scan", "-j")
+	if err != nil {
+		return DrivesSMART{
+			Addr:  addr,
+			Error: err.Error(),
+		}
+	}
+	var scan struct {
+		Devices []struct {
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"devices"`
+	}
+	if err = json.Unmarshal(out, &scan); err != nil {
+		return DrivesSMART{
+			Addr:  addr,
+			Error: err.Error(),
+		}
+	}
+
+	info := DrivesSMART{Addr: addr}
+	for _, dev := range scan.Devices {
+		smart := SMARTInfo{Device: dev.Name}
+		out, err := runSmartctl(ctx, "-j", "-a", "-d", dev.Type, dev.Name)
+		if err == nil {
+			smart, err = parseSmartctl(out)
+			smart.Device = dev.Name
+		}
+		if err != nil {
+			smart.Error = err.Error()
+		}
+		info.Drives = append(info.Drives, smart)
+	}
+	return info
+}
+
+// runSmartctl - runs smartctl, which reports drive problems in its exit
+// status bits. Only bits 0 and 1, bad arguments and a device which
+// could not be opened, mean that there is no output to parse.
+func runSmartctl(ctx context.Context, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, smartctlTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, "smartctl", args...).Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode()&0x3 == 0 {
+		return out, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("smartctl: %w", err)
+	}
+	return out, nil
+}
+
+// smartctlOutput - the parts of `smartctl -j -a` output used.
+type smartctlOutput struct {
+	ModelName    string `json:"model_name"`
+	SerialNumber string `json:"serial_number"`
+	SmartStatus  *struct {
+		Passed bool `json:"passed"`
+	} `json:"smart_status"`
+	Temperature struct {
+		Current int `json:"current"`
+	} `json:"temperature"`
+	PowerOnTime struct {
+		Hours uint64 `json:"hours"`
+	} `json:"power_on_time"`
+	ATASmartAttributes struct {
+		Table []struct {
+			ID    int `json:"id"`
+			Value int `json:"value"`
+			Raw   struct {
+				Value uint64 `json:"value"`
+			} `json:"raw"`
+		} `json:"table"`
+	} `json:"ata_smart_attributes"`
+	NVMeSmartHealth *struct {
+		PercentageUsed int    `json:"percentage_used"`
+		MediaErrors    uint64 `json:"media_errors"`
+	} `json:"nvme_smart_health_information_log"`
+	Smartctl struct {
+		Messages []struct {
+			String   string `json:"string"`
+			Severity string `json:"severity"`
+		} `json:"messages"`
+	} `json:"smartctl"`
+}
+
+// ATA SMART attribute IDs.
+const (
+	smartReallocatedSectors = 5
+	smartWearLevelingCount  = 177
+	smartPendingSectors     = 197
+	smartMediaWearout       = 233
+)
+
+// parseSmartctl - parses `smartctl -j -a` output of an ATA, SCSI or
+// NVMe drive.
+func parseSmartctl(data []byte) (SMARTInfo, error) {
+	var out smartctlOutput
+	if err := json.Unmarshal(data, &out); err != nil {
+		return SMARTInfo{}, err
+	}
+	if out.SmartStatus == nil {
+		for _, msg := range out.Smartctl.Messages {
+			if msg.Severity == "error" {
+				return SMARTInfo{}, errors.New(msg.String)
+			}
+		}
+		return SMARTInfo{}, errors.New("SMART is not available")
+	}
+
+	info := SMARTInfo{
+		Model:        out.ModelName,
+		Serial:       out.SerialNumber,
+		Passed:       out.SmartStatus.Passed,
+		Temperature:  out.Temperature.Current,
+		PowerOnHours: out.PowerOnTime.Hours,
+	}
+	for _, attr := range out.ATASmartAttributes.Table {
+		switch attr.ID {
+		case smartReallocatedSectors:
+			info.ReallocatedSectors = attr.Raw.Value
+		case smartPendingSectors:
+			info.PendingSectors = attr.Raw.Value
+		case smartWearLevelingCount, smartMediaWearout:
+			// The normalized value counts down from 100 as the
+			// drive wears.
+			if info.WearLevel == nil && attr.Value > 0 && attr.Value <= 100 {
+				used := 100 - attr.Value
+				info.WearLevel = &used
+			}
+		}
+	}
+	if nvme := out.NVMeSmartHealth; nvme != nil {
+		used := nvme.PercentageUsed
+		info.WearLevel = &used
+		info.MediaErrors = nvme.MediaErrors
+	}
+	return info, nil
+}
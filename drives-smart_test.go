@@ -0,0 +1,66 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import "testing"
+
+func TestParseSmartctl(t *testing.T) {
+	ata := []byte(`{
+		"model_name": "ST8000NM0055",
+		"serial_number": "ZA1",
+		"smart_status": {"passed": false},
+		"temperature": {"current": 41},
+		"power_on_time": {"hours": 35000},
+		"ata_smart_attributes": {"table": [
+			{"id": 5, "name": "Reallocated_Sector_Ct", "value": 90, "raw": {"value": 120}},
+			{"id": 197, "name": "Current_Pending_Sector", "value": 100, "raw": {"value": 8}}
+		]}
+	}`)
+	info, err := parseSmartctl(ata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Passed || info.ReallocatedSectors != 120 || info.PendingSectors != 8 || info.Temperature != 41 || info.PowerOnHours != 35000 {
+		t.Errorf("unexpected ATA SMART info %+v", info)
+	}
+	if info.WearLevel != nil {
+		t.Errorf("expected no wear level for a hard drive, got %d", *info.WearLevel)
+	}
+
+	ssd := []byte(`{
+		"smart_status": {"passed": true},
+		"ata_smart_attributes": {"table": [{"id": 177, "value": 93, "raw": {"value": 412}}]}
+	}`)
+	if info, err = parseSmartctl(ssd); err != nil || info.WearLevel == nil || *info.WearLevel != 7 {
+		t.Errorf("unexpected SSD SMART info %+v, %v", info, err)
+	}
+
+	nvme := []byte(`{
+		"model_name": "Samsung SSD 980 PRO",
+		"smart_status": {"passed": true},
+		"temperature": {"current": 38},
+		"nvme_smart_health_information_log": {"percentage_used": 3, "media_errors": 1}
+	}`)
+	if info, err = parseSmartctl(nvme); err != nil || !info.Passed || info.WearLevel == nil || *info.WearLevel != 3 || info.MediaErrors != 1 {
+		t.Errorf("unexpected NVMe SMART info %+v, %v", info, err)
+	}
+
+	failed := []byte(`{"smartctl": {"messages": [{"string": "Smartctl open device: /dev/sdz failed: No such device", "severity": "error"}]}}`)
+	if _, err = parseSmartctl(failed); err == nil || err.Error() != "Smartctl open device: /dev/sdz failed: No such device" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,273 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// procRoot is where procfs is mounted, replaced in tests.
+var procRoot = "/proc"
+
+// dualStackProbeTimeout bounds every connection attempt to a peer.
+const dualStackProbeTimeout = 5 * time.Second
+
+// IP families of dual-stack probes and listeners.
+const (
+	IPFamilyV4 = "ipv4"
+	IPFamilyV6 = "ipv6"
+)
+
+// IPListener - a TCP socket listening on the port of the server.
+type IPListener struct {
+	Family string `json:"family" yaml:"family"`
+	// Addr is the bound address, e.g. "0.0.0.0:9000" or "[::]:9000".
+	Addr string `json:"addr" yaml:"addr"`
+}
+
+// IPProbe - a connection attempt to a peer over one IP family.
+type IPProbe struct {
+	Family string `json:"family" yaml:"family"`
+	IP     string `json:"ip" yaml:"ip"`
+	Error  string `json:"error,omitempty" yaml:"error,omitempty"`
+	// RTT is the time to connect in seconds.
+	RTT float64 `json:"rtt,omitempty" yaml:"rtt,omitempty"`
+}
+
+// DualStackPeer - reachability of a peer over IPv4 and IPv6, probed
+// with the first address of every family its host resolves to.
+type DualStackPeer struct {
+	Addr   string    `json:"addr" yaml:"addr"`
+	Error  string    `json:"error,omitempty" yaml:"error,omitempty"`
+	Probes []IPProbe `json:"probes,omitempty" yaml:"probes,omitempty"`
+}
+
+// probe - returns the probe of family, nil if the peer has no address
+// of family.
+func (p DualStackPeer) probe(family string) *IPProbe {
+	for i := range p.Probes {
+		if p.Probes[i].Family == family {
+			return &p.Probes[i]
+		}
+	}
+	return nil
+}
+
+// DualStackInfo - IPv4 and IPv6 configuration of a node, its listener
+// bindings and its reachability of peers over both families.
+type DualStackInfo struct {
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	// IPv6Enabled is false if IPv6 is disabled in the kernel.
+	IPv6Enabled bool `json:"ipv6_enabled" yaml:"ipv6_enabled"`
+	// BindV6Only is true if IPv6 sockets do not accept IPv4.
+	BindV6Only bool `json:"bindv6only" yaml:"bindv6only"`
+	// IPv6Addrs are the global IPv6 addresses of the node.
+	IPv6Addrs []string `json:"ipv6_addrs,omitempty" yaml:"ipv6_addrs,omitempty"`
+	Port      int      `json:"port" yaml:"port"`
+	// Listeners are the sockets listening on Port.
+	Listeners []IPListener    `json:"listeners,omitempty" yaml:"listeners,omitempty"`
+	Peers     []DualStackPeer `json:"peers,omitempty" yaml:"peers,omitempty"`
+}
+
+// Accepts - returns true if a listener accepts connections of family,
+// IPv6 wildcard sockets accept IPv4 unless BindV6Only is set.
+func (d DualStackInfo) Accepts(family string) bool {
+	for _, l := range d.Listeners {
+		if l.Family == family {
+			return true
+		}
+		if family == IPFamilyV4 && l.Family == IPFamilyV6 && !d.BindV6Only && strings.HasPrefix(l.Addr, "[::]:") {
+			return true
+		}
+	}
+	return false
+}
+
+// Problems - returns the dual-stack misconfigurations found, which
+// cause intermittent internode failures depending on the address
+// family a connection picks.
+func (d DualStackInfo) Problems() []string {
+	var problems []string
+	if d.Port > 0 && len(d.Listeners) > 0 {
+		if !d.Accepts(IPFamilyV4) {
+			problems = append(problems, fmt.Sprintf("%s: port %d does not accept IPv4 connections", d.Addr, d.Port))
+		}
+		if d.IPv6Enabled && len(d.IPv6Addrs) > 0 && !d.Accepts(IPFamilyV6) {
+			problems = append(problems, fmt.Sprintf("%s: port %d does not accept IPv6 connections although the node has IPv6 addresses", d.Addr, d.Port))
+		}
+	}
+	for _, p := range d.Peers {
+		v4, v6 := p.probe(IPFamilyV4), p.probe(IPFamilyV6)
+		if v6 != nil && !d.IPv6Enabled {
+			problems = append(problems, fmt.Sprintf("%s: peer %s resolves to IPv6 but IPv6 is disabled", d.Addr, p.Addr))
+			continue
+		}
+		if v4 == nil || v6 == nil {
+			continue
+		}
+		switch {
+		case v4.Error == "" && v6.Error != "":
+			problems = append(problems, fmt.Sprintf("%s: peer %s is reachable over IPv4 but not over IPv6 (%s)", d.Addr, p.Addr, v6.IP))
+		case v4.Error != "" && v6.Error == "":
+			problems = append(problems, fmt.Sprintf("%s: peer %s is reachable over IPv6 but not over IPv4 (%s)", d.Addr, p.Addr, v4.IP))
+		}
+	}
+	return problems
+}
+
+// GetDualStackInfo returns the IPv4 and IPv6 configuration of a node,
+// the sockets listening on port and the reachability of peers, given as
+// URLs like http://node2:9000, over both families. Listeners are only
+// reported on linux.
+func GetDualStackInfo(ctx context.Context, addr string, port int, peers []string) DualStackInfo {
+	info := DualStackInfo{Addr: addr, Port: port}
+	disabled, err := ioutil.ReadFile(filepath.Join(procRoot, "sys/net/ipv6/conf/all/disable_ipv6"))
+	info.IPv6Enabled = err == nil && strings.TrimSpace(string(disabled)) == "0"
+	bindV6Only, _ := ioutil.ReadFile(filepath.Join(procRoot, "sys/net/ipv6/bindv6only"))
+	info.BindV6Only = strings.TrimSpace(string(bindV6Only)) == "1"
+
+	if addrs, err := net.InterfaceAddrs(); err == nil {
+		for _, a := range addrs {
+			if ipnet, ok := a.(*net.IPNet); ok && ipnet.IP.To4() == nil && ipnet.IP.IsGlobalUnicast() {
+				info.IPv6Addrs = append(info.IPv6Addrs, ipnet.IP.String())
+			}
+		}
+	}
+
+	for _, f := range []struct{ file, family string }{{"net/tcp", IPFamilyV4}, {"net/tcp6", IPFamilyV6}} {
+		listeners, err := readTCPListeners(filepath.Join(procRoot, f.file), f.family, port)
+		if err != nil && !os.IsNotExist(err) {
+			info.Error = err.Error()
+		}
+		info.Listeners = append(info.Listeners, listeners...)
+	}
+
+	for _, peer := range peers {
+		info.Peers = append(info.Peers, probeDualStackPeer(ctx, net.DefaultResolver, peer))
+	}
+	return info
+}
+
+// probeDualStackPeer - connects to the first IPv4 and IPv6 address of
+// a peer.
+func probeDualStackPeer(ctx context.Context, resolver *net.Resolver, peer string) DualStackPeer {
+	p := DualStackPeer{Addr: peer}
+	u, err := url.Parse(peer)
+	if err != nil {
+		p.Error = err.Error()
+		return p
+	}
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	ips, err := resolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		p.Error = err.Error()
+		return p
+	}
+	for _, family := range []string{IPFamilyV4, IPFamilyV6} {
+		for _, ip := range ips {
+			if (ip.IP.To4() != nil) != (family == IPFamilyV4) {
+				continue
+			}
+			probe := IPProbe{Family: family, IP: ip.String()}
+			dialer := net.Dialer{Timeout: dualStackProbeTimeout}
+			network := "tcp4"
+			if family == IPFamilyV6 {
+				network = "tcp6"
+			}
+			start := time.Now()
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+			if err != nil {
+				probe.Error = err.Error()
+			} else {
+				probe.RTT = time.Since(start).Seconds()
+				conn.Close()
+			}
+			p.Probes = append(p.Probes, probe)
+			break
+		}
+	}
+	return p
+}
+
+// readTCPListeners - returns the sockets of a /proc/net/tcp or tcp6 file
+// listening on port, all ports if zero.
+func readTCPListeners(path, family string, port int) ([]IPListener, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseTCPListeners(f, family, port), nil
+}
+
+// parseTCPListeners - parses lines like
+//
+//	0: 00000000:2328 00000000:0000 0A ...
+//
+// where the local address is hex encoded in host byte order, 32 bits
+// at a time, and state 0A is LISTEN.
+func parseTCPListeners(r io.Reader, family string, port int) []IPListener {
+	var listeners []IPListener
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || fields[3] != "0A" {
+			continue
+		}
+		i := strings.IndexByte(fields[1], ':')
+		if i < 0 {
+			continue
+		}
+		p, err := strconv.ParseUint(fields[1][i+1:], 16, 16)
+		if err != nil || (port != 0 && int(p) != port) {
+			continue
+		}
+		raw, err := hex.DecodeString(fields[1][:i])
+		if err != nil || (len(raw) != net.IPv4len && len(raw) != net.IPv6len) {
+			continue
+		}
+		ip := make(net.IP, len(raw))
+		for w := 0; w < len(raw); w += 4 {
+			ip[w], ip[w+1], ip[w+2], ip[w+3] = raw[w+3], raw[w+2], raw[w+1], raw[w]
+		}
+		listeners = append(listeners, IPListener{
+			Family: family,
+			Addr:   net.JoinHostPort(ip.String(), strconv.Itoa(int(p))),
+		})
+	}
+	return listeners
+}
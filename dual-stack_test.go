@@ -0,0 +1,114 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTCPListeners(t *testing.T) {
+	tcp := `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000:2328 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 1 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:2329 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 2 1 0000000000000000 100 0 0 10 0
+   2: 0100007F:2328 0100007F:C350 01 00000000:00000000 00:00000000 00000000     0        0 3 1 0000000000000000 20 4 30 10 -1
+`
+	listeners := parseTCPListeners(strings.NewReader(tcp), IPFamilyV4, 9000)
+	if expected := []IPListener{{Family: IPFamilyV4, Addr: "0.0.0.0:9000"}}; !reflect.DeepEqual(listeners, expected) {
+		t.Errorf("expected %+v, got %+v", expected, listeners)
+	}
+
+	tcp6 := `  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000000000000000000000000000:2328 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 4 1 0000000000000000 100 0 0 10 0
+   1: 00000000000000000000000001000000:2328 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 5 1 0000000000000000 100 0 0 10 0
+`
+	listeners = parseTCPListeners(strings.NewReader(tcp6), IPFamilyV6, 9000)
+	expected := []IPListener{{Family: IPFamilyV6, Addr: "[::]:9000"}, {Family: IPFamilyV6, Addr: "[::1]:9000"}}
+	if !reflect.DeepEqual(listeners, expected) {
+		t.Errorf("expected %+v, got %+v", expected, listeners)
+	}
+}
+
+func TestGetDualStackInfo(t *testing.T) {
+	root, err := ioutil.TempDir("", "procfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	write := func(path, content string) {
+		path = filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("sys/net/ipv6/conf/all/disable_ipv6", "0\n")
+	write("sys/net/ipv6/bindv6only", "1\n")
+	write("net/tcp6", "  sl  local_address st\n   0: 00000000000000000000000000000000:2328 00000000000000000000000000000000:0000 0A\n")
+
+	defer func(old string) { procRoot = old }(procRoot)
+	procRoot = root
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(ts.URL, "http://"))
+
+	info := GetDualStackInfo(context.Background(), "node1:9000", 9000, []string{"http://localhost:" + port})
+	if !info.IPv6Enabled || !info.BindV6Only || info.Error != "" {
+		t.Errorf("unexpected kernel settings %+v", info)
+	}
+	if len(info.Listeners) != 1 || info.Accepts(IPFamilyV4) || !info.Accepts(IPFamilyV6) {
+		t.Errorf("expected a single IPv6 only listener, got %+v", info.Listeners)
+	}
+	if len(info.Peers) != 1 || info.Peers[0].Error != "" {
+		t.Fatalf("unexpected peers %+v", info.Peers)
+	}
+	if v4 := info.Peers[0].probe(IPFamilyV4); v4 == nil || v4.Error != "" || v4.IP != "127.0.0.1" {
+		t.Errorf("expected the peer to be reachable over IPv4, got %+v", info.Peers[0])
+	}
+
+	problems := info.Problems()
+	if len(problems) == 0 || !strings.Contains(problems[0], "does not accept IPv4") {
+		t.Errorf("expected the listener not accepting IPv4 to be reported, got %v", problems)
+	}
+
+	// localhost resolves to both families on dual-stack hosts while
+	// the test server only listens on IPv4.
+	peer := DualStackPeer{Addr: "http://node2:9000", Probes: []IPProbe{
+		{Family: IPFamilyV4, IP: "10.0.0.2"},
+		{Family: IPFamilyV6, IP: "fd00::2", Error: "connection refused"},
+	}}
+	info = DualStackInfo{Addr: "node1:9000", IPv6Enabled: true, Peers: []DualStackPeer{peer}}
+	if problems = info.Problems(); len(problems) != 1 || !strings.Contains(problems[0], "not over IPv6 (fd00::2)") {
+		t.Errorf("expected the unreachable IPv6 address to be reported, got %v", problems)
+	}
+	var health HealthInfo
+	health.Sys.DualStack = []DualStackInfo{info}
+	if s := health.Summarize(); len(s.ConfigWarnings) != 1 {
+		t.Errorf("expected a config warning, got %+v", s)
+	}
+}
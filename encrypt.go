@@ -18,6 +18,7 @@ package madmin
 
 import (
 	"bytes"
+	"crypto/rand"
 	"crypto/sha256"
 	"errors"
 	"io"
@@ -36,7 +37,22 @@ import (
 //    salt | AEAD ID | nonce | encrypted data
 //     32      1         8      ~ len(data)
 func EncryptData(password string, data []byte) ([]byte, error) {
-	salt := sioutil.MustRandom(32)
+	return encryptData(rand.Reader, password, data)
+}
+
+// encryptData - encrypts data with the secret key of the client,
+// generating the salt and nonce with the rand source of the client.
+func (adm *AdminClient) encryptData(data []byte) ([]byte, error) {
+	return encryptData(adm.randReader(), adm.getSecretKey(), data)
+}
+
+// encryptData - implements EncryptData reading the salt and nonce
+// from random.
+func encryptData(random io.Reader, password string, data []byte) ([]byte, error) {
+	salt := make([]byte, 32)
+	if _, err := io.ReadFull(random, salt); err != nil {
+		return nil, err
+	}
 
 	var (
 		id     byte
@@ -67,7 +83,10 @@ func EncryptData(password string, data []byte) ([]byte, error) {
 		}
 	}
 
-	nonce := sioutil.MustRandom(stream.NonceSize())
+	nonce := make([]byte, stream.NonceSize())
+	if _, err = io.ReadFull(random, nonce); err != nil {
+		return nil, err
+	}
 
 	// ciphertext = salt || AEAD ID | nonce | encrypted data
 	cLen := int64(len(salt)+1+len(nonce)+len(data)) + stream.Overhead(int64(len(data)))
@@ -94,3 +94,30 @@ func TestDecryptData(t *testing.T) {
 		})
 	}
 }
+
+func TestEncryptDataRandSource(t *testing.T) {
+	adm, err := New("localhost:9000", "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypt := func() []byte {
+		adm.SetRandSource(bytes.NewReader(make([]byte, 64)))
+		data, err := adm.encryptData([]byte("config"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	first, second := encrypt(), encrypt()
+	if !bytes.Equal(first, second) {
+		t.Error("expected equal ciphertexts for equal rand sources")
+	}
+	if plaintext, err := DecryptData("minioadmin", bytes.NewReader(first)); err != nil || string(plaintext) != "config" {
+		t.Errorf("failed to decrypt: %v", err)
+	}
+
+	adm.SetRandSource(bytes.NewReader(nil))
+	if _, err = adm.encryptData([]byte("config")); err == nil {
+		t.Error("expected an error for an exhausted rand source")
+	}
+}
@@ -0,0 +1,132 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"net"
+	"os"
+	"strings"
+	"sync"
+)
+
+// EndpointPolicy - how a client with several endpoints picks the
+// endpoint of a request.
+type EndpointPolicy int
+
+// Endpoint selection policies.
+const (
+	// EndpointRoundRobin sends every request to the next endpoint.
+	EndpointRoundRobin EndpointPolicy = iota
+	// EndpointSticky sends requests to the same endpoint until a
+	// request fails with a network error, then moves to the next one.
+	EndpointSticky
+	// EndpointPreferLocal is EndpointSticky starting with an endpoint
+	// of the local host, if any.
+	EndpointPreferLocal
+)
+
+// endpointSelector - picks the endpoints of requests, shared by all
+// copies of a client.
+type endpointSelector struct {
+	mu      sync.Mutex
+	policy  EndpointPolicy
+	hosts   []string
+	current int
+}
+
+// next - returns the endpoint of a request.
+func (s *endpointSelector) next() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	host := s.hosts[s.current]
+	if s.policy == EndpointRoundRobin {
+		s.current = (s.current + 1) % len(s.hosts)
+	}
+	return host
+}
+
+// failed - moves sticky selections away from host after a network
+// error of a request sent to it.
+func (s *endpointSelector) failed(host string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.policy != EndpointRoundRobin && s.hosts[s.current] == host {
+		s.current = (s.current + 1) % len(s.hosts)
+	}
+}
+
+// WithEndpoints - spreads the requests of the client over the endpoint
+// passed to New and endpoints, host:port of other servers of the
+// cluster, as selected by policy. Requests failing with network errors
+// are retried on other endpoints. WithTargetNode overrides the
+// selection of single calls.
+func WithEndpoints(policy EndpointPolicy, endpoints ...string) Option {
+	return func(adm *AdminClient) error {
+		if policy < EndpointRoundRobin || policy > EndpointPreferLocal {
+			return ErrInvalidArgument("Unknown endpoint policy.")
+		}
+		s := &endpointSelector{policy: policy, hosts: []string{adm.endpointURL.Host}}
+		for _, endpoint := range endpoints {
+			u, err := getEndpointURL(endpoint, adm.secure)
+			if err != nil {
+				return err
+			}
+			s.hosts = append(s.hosts, u.Host)
+		}
+		if policy == EndpointPreferLocal {
+			for i, host := range s.hosts {
+				if isLocalHost(host) {
+					s.current = i
+					break
+				}
+			}
+		}
+		adm.endpoints = s
+		return nil
+	}
+}
+
+// isLocalHost - returns true if host is localhost, the hostname of the
+// local host or one of its IP addresses. Host names are not resolved.
+func isLocalHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	if hostname, err := os.Hostname(); err == nil && strings.EqualFold(host, hostname) {
+		return true
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() {
+		return true
+	}
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return false
+	}
+	for _, a := range addrs {
+		if ipnet, ok := a.(*net.IPNet); ok && ipnet.IP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
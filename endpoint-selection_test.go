@@ -0,0 +1,102 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestWithEndpoints(t *testing.T) {
+	hits := make(map[string]int)
+	handler := func(name string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits[name]++
+			w.Write([]byte(`{}`))
+		})
+	}
+	node1 := httptest.NewServer(handler("node1"))
+	defer node1.Close()
+	node2 := httptest.NewServer(handler("node2"))
+	defer node2.Close()
+	down := httptest.NewServer(handler("down"))
+	down.Close()
+	host := func(ts *httptest.Server) string {
+		u, _ := url.Parse(ts.URL)
+		return u.Host
+	}
+
+	call := func(adm *AdminClient, ctx context.Context, n int) map[string]int {
+		for k := range hits {
+			delete(hits, k)
+		}
+		for i := 0; i < n; i++ {
+			resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{relPath: adminAPIPrefix + "/endpoints"})
+			closeResponse(resp)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		return hits
+	}
+	newClient := func(policy EndpointPolicy, endpoints ...string) *AdminClient {
+		adm, err := New(host(node1), "minioadmin", "minioadmin", false, WithEndpoints(policy, endpoints...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		adm.SetRetryPolicy(RetryPolicy{MaxRetry: 3, Unit: time.Millisecond, Cap: time.Millisecond})
+		return adm
+	}
+
+	adm := newClient(EndpointRoundRobin, host(node2))
+	if h := call(adm, context.Background(), 4); h["node1"] != 2 || h["node2"] != 2 {
+		t.Errorf("expected requests to alternate, got %v", h)
+	}
+	if h := call(adm, WithTargetNode(context.Background(), host(node2)), 3); h["node2"] != 3 {
+		t.Errorf("expected requests to go to the target node, got %v", h)
+	}
+
+	// Sticky clients move on once the endpoint is unreachable, even
+	// though refused connections are not retried by default.
+	adm, err := New(host(down), "minioadmin", "minioadmin", false, WithEndpoints(EndpointSticky, host(node2), host(node1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm.SetRetryPolicy(RetryPolicy{MaxRetry: 3, Unit: time.Millisecond, Cap: time.Millisecond})
+	if h := call(adm, context.Background(), 3); h["node2"] != 3 || h["node1"] != 0 {
+		t.Errorf("expected requests to stick to the first reachable endpoint, got %v", h)
+	}
+
+	adm, err = New("192.0.2.1:9000", "minioadmin", "minioadmin", false, WithEndpoints(EndpointPreferLocal, host(node2), host(node1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h := call(adm, context.Background(), 2); h["node2"] != 2 {
+		t.Errorf("expected requests to go to the first local endpoint, got %v", h)
+	}
+
+	if _, err = New(host(node1), "minioadmin", "minioadmin", false, WithEndpoints(EndpointSticky, "bad host:9000")); err == nil {
+		t.Error("expected an invalid endpoint to be rejected")
+	}
+	if !isLocalHost("127.0.0.1:9000") || !isLocalHost("localhost") || isLocalHost("192.0.2.1:9000") {
+		t.Error("unexpected local host detection")
+	}
+}
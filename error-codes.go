@@ -0,0 +1,178 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+// ErrorCode - the Code of an ErrorResponse returned by the server.
+type ErrorCode string
+
+// Error codes returned by the admin APIs.
+const (
+	// Authentication and authorization
+	ErrCodeAccessDenied          ErrorCode = "AccessDenied"
+	ErrCodeInvalidAccessKeyID    ErrorCode = "InvalidAccessKeyId"
+	ErrCodeSignatureDoesNotMatch ErrorCode = "SignatureDoesNotMatch"
+	ErrCodeRequestTimeTooSkewed  ErrorCode = "RequestTimeTooSkewed"
+	ErrCodeExpiredToken          ErrorCode = "ExpiredToken"
+	ErrCodeInvalidToken          ErrorCode = "InvalidToken"
+	ErrCodeInsecureClientRequest ErrorCode = "XMinioInsecureClientRequest"
+	ErrCodeCredentialsMismatch   ErrorCode = "XMinioAdminCredentialsMismatch"
+	ErrCodeInvalidAccessKey      ErrorCode = "XMinioAdminInvalidAccessKey"
+	ErrCodeInvalidSecretKey      ErrorCode = "XMinioAdminInvalidSecretKey"
+
+	// Invalid requests
+	ErrCodeInvalidArgument      ErrorCode = "InvalidArgument"
+	ErrCodeInvalidRequest       ErrorCode = "InvalidRequest"
+	ErrCodeAdminInvalidArgument ErrorCode = "XMinioAdminInvalidArgument"
+	ErrCodeNotImplemented       ErrorCode = "NotImplemented"
+
+	// Missing resources
+	ErrCodeNoSuchBucket             ErrorCode = "NoSuchBucket"
+	ErrCodeNoSuchUser               ErrorCode = "XMinioAdminNoSuchUser"
+	ErrCodeNoSuchGroup              ErrorCode = "XMinioAdminNoSuchGroup"
+	ErrCodeNoSuchPolicy             ErrorCode = "XMinioAdminNoSuchPolicy"
+	ErrCodeNoSuchTier               ErrorCode = "XMinioAdminNoSuchTier"
+	ErrCodeNoSuchQuotaConfig        ErrorCode = "XMinioAdminNoSuchQuotaConfiguration"
+	ErrCodeRemoteTargetNotFound     ErrorCode = "XMinioAdminRemoteTargetNotFoundError"
+	ErrCodeHealNoSuchProcess        ErrorCode = "XMinioHealNoSuchProcess"
+	ErrCodeHealInvalidClientToken   ErrorCode = "XMinioHealInvalidClientToken"
+	ErrCodeProfilerNotEnabled       ErrorCode = "XMinioAdminProfilerNotEnabled"
+	ErrCodeRemoteTargetNotVersioned ErrorCode = "XMinioAdminRemoteTargetNotVersionedError"
+
+	// Conflicts with the current state
+	ErrCodeGroupNotEmpty          ErrorCode = "XMinioAdminGroupNotEmpty"
+	ErrCodeTierAlreadyExists      ErrorCode = "XMinioAdminTierAlreadyExists"
+	ErrCodeTierBackendInUse       ErrorCode = "XMinioAdminTierBackendInUse"
+	ErrCodeHealAlreadyRunning     ErrorCode = "XMinioHealAlreadyRunning"
+	ErrCodeHealOverlappingPaths   ErrorCode = "XMinioHealOverlappingPaths"
+	ErrCodeRemoteRemoveDisallowed ErrorCode = "XMinioAdminRemoteRemoveDisallowed"
+
+	// Configuration
+	ErrCodeConfigNoQuorum        ErrorCode = "XMinioAdminConfigNoQuorum"
+	ErrCodeConfigTooLarge        ErrorCode = "XMinioAdminConfigTooLarge"
+	ErrCodeConfigBadJSON         ErrorCode = "XMinioAdminConfigBadJSON"
+	ErrCodeConfigDuplicateKeys   ErrorCode = "XMinioAdminConfigDuplicateKeys"
+	ErrCodeNotificationTargets   ErrorCode = "XMinioAdminNotificationTargetsTestFailed"
+	ErrCodeTierInsufficientCreds ErrorCode = "XMinioAdminTierInsufficientCreds"
+
+	// Capacity
+	ErrCodeBucketQuotaExceeded ErrorCode = "XMinioAdminBucketQuotaExceeded"
+	ErrCodeStorageFull         ErrorCode = "XMinioStorageFull"
+
+	// Transient server conditions
+	ErrCodeInternalError         ErrorCode = "InternalError"
+	ErrCodeSlowDown              ErrorCode = "SlowDown"
+	ErrCodeRequestTimeout        ErrorCode = "RequestTimeout"
+	ErrCodeServerNotInitialized  ErrorCode = "XMinioServerNotInitialized"
+	ErrCodeReplicationConnection ErrorCode = "XMinioAdminReplicationRemoteConnectionError"
+)
+
+// ErrorCategory - a class of error codes calling for the same handling.
+type ErrorCategory string
+
+// Error categories
+const (
+	ErrorCategoryAuth           ErrorCategory = "auth"
+	ErrorCategoryInvalidRequest ErrorCategory = "invalid-request"
+	ErrorCategoryNotFound       ErrorCategory = "not-found"
+	ErrorCategoryConflict       ErrorCategory = "conflict"
+	ErrorCategoryConfig         ErrorCategory = "config"
+	ErrorCategoryCapacity       ErrorCategory = "capacity"
+	ErrorCategoryTransient      ErrorCategory = "transient"
+	ErrorCategoryUnknown        ErrorCategory = "unknown"
+)
+
+// ErrorCodeInfo - describes an error code.
+type ErrorCodeInfo struct {
+	Code     ErrorCode     `json:"code"`
+	Category ErrorCategory `json:"category"`
+	// Retryable is true if the client retries requests failing with
+	// this code.
+	Retryable   bool   `json:"retryable"`
+	Remediation string `json:"remediation"`
+}
+
+var errorCodeInfos = map[ErrorCode]ErrorCodeInfo{
+	ErrCodeAccessDenied:          {Category: ErrorCategoryAuth, Remediation: "Attach a policy granting the admin action to the user or service account."},
+	ErrCodeInvalidAccessKeyID:    {Category: ErrorCategoryAuth, Remediation: "Check the access key, the user may not exist or be disabled."},
+	ErrCodeSignatureDoesNotMatch: {Category: ErrorCategoryAuth, Remediation: "Check the secret key and that no proxy rewrites the request."},
+	ErrCodeRequestTimeTooSkewed:  {Category: ErrorCategoryAuth, Remediation: "Synchronize the clocks of the client and the servers, e.g. with NTP."},
+	ErrCodeExpiredToken:          {Category: ErrorCategoryAuth, Remediation: "Obtain new temporary credentials."},
+	ErrCodeInvalidToken:          {Category: ErrorCategoryAuth, Remediation: "Check the session token of the temporary credentials."},
+	ErrCodeInsecureClientRequest: {Category: ErrorCategoryAuth, Remediation: "Connect over TLS, the API refuses to transfer secrets in clear text."},
+	ErrCodeCredentialsMismatch:   {Category: ErrorCategoryAuth, Remediation: "Use the same root credentials on all servers."},
+	ErrCodeInvalidAccessKey:      {Category: ErrorCategoryInvalidRequest, Remediation: "Use an access key of 3 to 20 characters without reserved characters."},
+	ErrCodeInvalidSecretKey:      {Category: ErrorCategoryInvalidRequest, Remediation: "Use a secret key of 8 to 40 characters."},
+
+	ErrCodeInvalidArgument:      {Category: ErrorCategoryInvalidRequest, Remediation: "Check the arguments of the request."},
+	ErrCodeInvalidRequest:       {Category: ErrorCategoryInvalidRequest, Remediation: "Check the arguments of the request."},
+	ErrCodeAdminInvalidArgument: {Category: ErrorCategoryInvalidRequest, Remediation: "Check the arguments of the request."},
+	ErrCodeNotImplemented:       {Category: ErrorCategoryInvalidRequest, Remediation: "Upgrade the server, it does not support this API."},
+
+	ErrCodeNoSuchBucket:             {Category: ErrorCategoryNotFound, Remediation: "Check the bucket name or create the bucket."},
+	ErrCodeNoSuchUser:               {Category: ErrorCategoryNotFound, Remediation: "Check the user name or add the user."},
+	ErrCodeNoSuchGroup:              {Category: ErrorCategoryNotFound, Remediation: "Check the group name or add members to create the group."},
+	ErrCodeNoSuchPolicy:             {Category: ErrorCategoryNotFound, Remediation: "Check the policy name or add the policy."},
+	ErrCodeNoSuchTier:               {Category: ErrorCategoryNotFound, Remediation: "Check the tier name or add the tier."},
+	ErrCodeNoSuchQuotaConfig:        {Category: ErrorCategoryNotFound, Remediation: "The bucket has no quota configured."},
+	ErrCodeRemoteTargetNotFound:     {Category: ErrorCategoryNotFound, Remediation: "Check the ARN of the remote target."},
+	ErrCodeHealNoSuchProcess:        {Category: ErrorCategoryNotFound, Remediation: "The heal sequence finished or was stopped, start a new one."},
+	ErrCodeHealInvalidClientToken:   {Category: ErrorCategoryNotFound, Remediation: "Use the client token returned when the heal sequence started."},
+	ErrCodeProfilerNotEnabled:       {Category: ErrorCategoryNotFound, Remediation: "Start profiling before downloading the profiling data."},
+	ErrCodeRemoteTargetNotVersioned: {Category: ErrorCategoryConfig, Remediation: "Enable versioning on the remote bucket."},
+
+	ErrCodeGroupNotEmpty:          {Category: ErrorCategoryConflict, Remediation: "Remove all members before removing the group."},
+	ErrCodeTierAlreadyExists:      {Category: ErrorCategoryConflict, Remediation: "Choose another tier name or edit the existing tier."},
+	ErrCodeTierBackendInUse:       {Category: ErrorCategoryConflict, Remediation: "Use a bucket or prefix not used by another tier."},
+	ErrCodeHealAlreadyRunning:     {Category: ErrorCategoryConflict, Remediation: "Wait for the running heal sequence or force start a new one."},
+	ErrCodeHealOverlappingPaths:   {Category: ErrorCategoryConflict, Remediation: "Wait for the heal sequence on the overlapping path to finish."},
+	ErrCodeRemoteRemoveDisallowed: {Category: ErrorCategoryConflict, Remediation: "Remove the replication rules using the target first."},
+
+	ErrCodeConfigNoQuorum:        {Category: ErrorCategoryConfig, Remediation: "Bring enough drives online to reach write quorum."},
+	ErrCodeConfigTooLarge:        {Category: ErrorCategoryConfig, Remediation: "Reduce the size of the configuration."},
+	ErrCodeConfigBadJSON:         {Category: ErrorCategoryConfig, Remediation: "Fix the JSON syntax of the configuration."},
+	ErrCodeConfigDuplicateKeys:   {Category: ErrorCategoryConfig, Remediation: "Remove the duplicate keys from the configuration."},
+	ErrCodeNotificationTargets:   {Category: ErrorCategoryConfig, Remediation: "Check that the notification targets are reachable."},
+	ErrCodeTierInsufficientCreds: {Category: ErrorCategoryConfig, Remediation: "Grant the tier credentials access to the remote bucket."},
+
+	ErrCodeBucketQuotaExceeded: {Category: ErrorCategoryCapacity, Remediation: "Raise the bucket quota or remove objects."},
+	ErrCodeStorageFull:         {Category: ErrorCategoryCapacity, Remediation: "Add capacity or remove objects."},
+
+	ErrCodeInternalError:         {Category: ErrorCategoryTransient, Remediation: "Retry later and check the server logs if the error persists."},
+	ErrCodeSlowDown:              {Category: ErrorCategoryTransient, Remediation: "Reduce the request rate."},
+	ErrCodeRequestTimeout:        {Category: ErrorCategoryTransient, Remediation: "Retry the request."},
+	ErrCodeServerNotInitialized:  {Category: ErrorCategoryTransient, Remediation: "Wait for the server to finish starting."},
+	ErrCodeReplicationConnection: {Category: ErrorCategoryTransient, Remediation: "Check the network connectivity to the remote target."},
+}
+
+// LookupErrorCode - returns the category and remediation of an error
+// code, false if the code is unknown in which case the category is
+// ErrorCategoryUnknown.
+func LookupErrorCode(code string) (ErrorCodeInfo, bool) {
+	info, ok := errorCodeInfos[ErrorCode(code)]
+	if !ok {
+		info.Category = ErrorCategoryUnknown
+	}
+	info.Code = ErrorCode(code)
+	info.Retryable = isS3CodeRetryable(code)
+	return info, ok
+}
+
+// CodeInfo - returns the category and remediation of the error code.
+func (e ErrorResponse) CodeInfo() ErrorCodeInfo {
+	info, _ := LookupErrorCode(e.Code)
+	return info
+}
@@ -0,0 +1,39 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import "testing"
+
+func TestLookupErrorCode(t *testing.T) {
+	for code, info := range errorCodeInfos {
+		if info.Category == "" || info.Remediation == "" {
+			t.Errorf("%s: incomplete %+v", code, info)
+		}
+	}
+
+	info, ok := LookupErrorCode("SlowDown")
+	if !ok || info.Code != ErrCodeSlowDown || info.Category != ErrorCategoryTransient || !info.Retryable {
+		t.Errorf("unexpected %+v", info)
+	}
+	info = ErrorResponse{Code: "XMinioAdminNoSuchUser"}.CodeInfo()
+	if info.Category != ErrorCategoryNotFound || info.Retryable {
+		t.Errorf("unexpected %+v", info)
+	}
+	if info, ok = LookupErrorCode("XMinioSomethingNew"); ok || info.Category != ErrorCategoryUnknown {
+		t.Errorf("unexpected %+v", info)
+	}
+}
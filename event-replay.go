@@ -0,0 +1,98 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrEventReplayUnsupported is returned by ReplayEvents if the server
+// cannot replay bucket notifications.
+var ErrEventReplayUnsupported = errors.New("server does not support event replay")
+
+// EventReplayOpts - options of ReplayEvents.
+type EventReplayOpts struct {
+	Bucket string
+	Prefix string
+	// TargetARN is the notification target receiving the events, i.e.
+	// arn:minio:sqs::primary:webhook.
+	TargetARN string
+	// Events to replay, i.e. s3:ObjectCreated:*, all events of the
+	// bucket notification configuration if empty.
+	Events []string
+	// Start and End of the time range, End defaults to now.
+	Start time.Time
+	End   time.Time
+	// PollInterval between status requests, 5 seconds if zero.
+	PollInterval time.Duration
+	// OnProgress is called with every status, if set.
+	OnProgress func(BatchJobStatus)
+}
+
+// eventReplayJob - the batch job YAML replaying events.
+func eventReplayJob(opts EventReplayOpts) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "replay:\n  apiVersion: v1\n  bucket: %s\n  prefix: %s\n  target: %s\n",
+		strconv.Quote(opts.Bucket), strconv.Quote(opts.Prefix), strconv.Quote(opts.TargetARN))
+	if len(opts.Events) > 0 {
+		b.WriteString("  events:\n")
+		for _, event := range opts.Events {
+			fmt.Fprintf(&b, "    - %s\n", strconv.Quote(event))
+		}
+	}
+	fmt.Fprintf(&b, "  from: %s\n  to: %s\n", opts.Start.UTC().Format(time.RFC3339), opts.End.UTC().Format(time.RFC3339))
+	return b.String()
+}
+
+// ReplayEvents - starts a batch job sending the notification events
+// of objects changed in a time range to a target again, i.e. after the
+// target was down, and waits for it to end. Like PutLegalHoldBulk, the
+// job is recorded in the job store.
+func (adm *AdminClient) ReplayEvents(ctx context.Context, opts EventReplayOpts) (BatchJobStatus, error) {
+	if opts.Bucket == "" {
+		return BatchJobStatus{}, ErrInvalidArgument("Bucket name cannot be empty.")
+	}
+	if opts.TargetARN == "" {
+		return BatchJobStatus{}, ErrInvalidArgument("Target ARN cannot be empty.")
+	}
+	if opts.End.IsZero() {
+		opts.End = time.Now()
+	}
+	if !opts.Start.Before(opts.End) {
+		return BatchJobStatus{}, ErrInvalidArgument("Start must be before end of the time range.")
+	}
+
+	result, err := adm.startRecordedBatchJob(ctx, eventReplayJob(opts), opts.Bucket, opts.Prefix)
+	if err != nil {
+		// Unknown job types are not implemented, servers without batch
+		// jobs respond with 404 and no error body. Failures after the
+		// job started are returned as they are.
+		if code := ToErrorResponse(err).Code; code == "NotImplemented" ||
+			strings.HasPrefix(code, strconv.Itoa(http.StatusNotFound)+" ") ||
+			strings.HasPrefix(code, strconv.Itoa(http.StatusNotImplemented)+" ") {
+			return BatchJobStatus{}, fmt.Errorf("%w: %v", ErrEventReplayUnsupported, err)
+		}
+		return BatchJobStatus{}, err
+	}
+	return adm.waitBatchJob(ctx, result, opts.PollInterval, opts.OnProgress)
+}
@@ -0,0 +1,90 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReplayEvents(t *testing.T) {
+	// missing is the path the server does not know.
+	var missing string
+	var job string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == missing {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.URL.Path {
+		case libraryAdminURLPrefix + adminAPIPrefix + "/start-job":
+			body, _ := ioutil.ReadAll(r.Body)
+			job = string(body)
+			w.Write([]byte(`{"id":"replay1","type":"replay"}`))
+		case libraryAdminURLPrefix + adminAPIPrefix + "/status-job":
+			w.Write([]byte(`{"lastMetric":{"jobID":"replay1","complete":true,"objects":42}}`))
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)
+	opts := EventReplayOpts{
+		Bucket:    "bucket",
+		TargetARN: "arn:minio:sqs::primary:webhook",
+		Events:    []string{"s3:ObjectCreated:*"},
+		Start:     start,
+		End:       start.Add(time.Hour),
+	}
+	status, err := adm.ReplayEvents(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.Complete || status.Objects != 42 {
+		t.Errorf("unexpected status %+v", status)
+	}
+	for _, want := range []string{"replay:\n", `target: "arn:minio:sqs::primary:webhook"`, `- "s3:ObjectCreated:*"`, "from: 2021-05-01T10:00:00Z", "to: 2021-05-01T11:00:00Z"} {
+		if !strings.Contains(job, want) {
+			t.Errorf("job %q lacks %q", job, want)
+		}
+	}
+
+	// Only a missing start endpoint means replay is unsupported.
+	missing = libraryAdminURLPrefix + adminAPIPrefix + "/status-job"
+	if _, err = adm.ReplayEvents(context.Background(), opts); err == nil || errors.Is(err, ErrEventReplayUnsupported) {
+		t.Errorf("expected the status error, got %v", err)
+	}
+	missing = libraryAdminURLPrefix + adminAPIPrefix + "/start-job"
+	if _, err = adm.ReplayEvents(context.Background(), opts); !errors.Is(err, ErrEventReplayUnsupported) {
+		t.Errorf("expected %v, got %v", ErrEventReplayUnsupported, err)
+	}
+	opts.End = start
+	if _, err = adm.ReplayEvents(context.Background(), opts); err == nil {
+		t.Error("expected an error for an empty time range")
+	}
+}
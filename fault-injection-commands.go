@@ -0,0 +1,195 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// FaultType - type of fault to inject.
+type FaultType string
+
+// Supported fault types.
+const (
+	// FaultDriveLatency delays every call to a drive.
+	FaultDriveLatency FaultType = "drive-latency"
+	// FaultNetworkPartition drops internode traffic to the given peers.
+	FaultNetworkPartition FaultType = "network-partition"
+	// FaultAPIError fails matching S3 API calls with an error code.
+	FaultAPIError FaultType = "api-error"
+)
+
+// MaxFaultDuration is the longest a fault may stay injected, every
+// fault expires on the server on its own.
+const MaxFaultDuration = time.Hour
+
+// ErrFaultInjectionNotAcknowledged is returned unless the caller
+// acknowledged the target is not a production deployment.
+var ErrFaultInjectionNotAcknowledged = errors.New("fault injection requires NonProduction to be acknowledged")
+
+// FaultInjectOpts - options for InjectFault.
+type FaultInjectOpts struct {
+	Type FaultType `json:"type"`
+	// Node limits the fault to a single server, all servers if empty.
+	Node string `json:"node,omitempty"`
+	// Drive path, only for FaultDriveLatency.
+	Drive   string        `json:"drive,omitempty"`
+	Latency time.Duration `json:"latency,omitempty"`
+	// Peers cut off from Node, only for FaultNetworkPartition.
+	Peers []string `json:"peers,omitempty"`
+	// API name and error code, only for FaultAPIError.
+	API       string `json:"api,omitempty"`
+	ErrorCode string `json:"errorCode,omitempty"`
+	// Probability of the fault hitting a call in range (0, 1], defaults to 1.
+	Probability float64 `json:"probability,omitempty"`
+	// Duration after which the fault is removed, mandatory and
+	// never longer than MaxFaultDuration.
+	Duration time.Duration `json:"duration"`
+
+	// NonProduction must be set to confirm the target deployment is
+	// a test system, it is never sent to the server.
+	NonProduction bool `json:"-"`
+}
+
+// Validate - checks the safety interlocks and fault parameters.
+func (o FaultInjectOpts) Validate() error {
+	if !o.NonProduction {
+		return ErrFaultInjectionNotAcknowledged
+	}
+	if o.Duration <= 0 || o.Duration > MaxFaultDuration {
+		return ErrInvalidArgument("Fault duration must be between 0 and " + MaxFaultDuration.String() + ".")
+	}
+	if o.Probability < 0 || o.Probability > 1 {
+		return ErrInvalidArgument("Fault probability must be in range (0, 1].")
+	}
+	switch o.Type {
+	case FaultDriveLatency:
+		if o.Latency <= 0 {
+			return ErrInvalidArgument("Drive latency fault requires a positive latency.")
+		}
+	case FaultNetworkPartition:
+		if o.Node == "" || len(o.Peers) == 0 {
+			return ErrInvalidArgument("Network partition fault requires a node and its peers.")
+		}
+	case FaultAPIError:
+		if o.API == "" || o.ErrorCode == "" {
+			return ErrInvalidArgument("API error fault requires an API name and an error code.")
+		}
+	default:
+		return ErrInvalidArgument("Unknown fault type " + string(o.Type))
+	}
+	return nil
+}
+
+// FaultInjection - a fault currently injected on the server.
+type FaultInjection struct {
+	ID      string          `json:"id"`
+	Opts    FaultInjectOpts `json:"opts"`
+	Created time.Time       `json:"created"`
+	Expires time.Time       `json:"expires"`
+}
+
+// InjectFault - injects a fault on a server built with fault injection
+// support, servers without it reject the call.
+func (adm *AdminClient) InjectFault(ctx context.Context, opts FaultInjectOpts) (FaultInjection, error) {
+	if err := opts.Validate(); err != nil {
+		return FaultInjection{}, err
+	}
+	if opts.Probability == 0 {
+		opts.Probability = 1
+	}
+	data, err := json.Marshal(opts)
+	if err != nil {
+		return FaultInjection{}, err
+	}
+
+	// Execute POST on /minio/admin/v3/debug/faults to inject a fault.
+	resp, err := adm.executeMethod(ctx, http.MethodPost, requestData{
+		relPath: adminAPIPrefix + "/debug/faults",
+		content: data,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return FaultInjection{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return FaultInjection{}, httpRespToErrorResponse(resp)
+	}
+
+	var fault FaultInjection
+	if err = adm.decodeResponse(resp, &fault); err != nil {
+		return FaultInjection{}, err
+	}
+	return fault, nil
+}
+
+// ListFaults - lists all faults currently injected.
+func (adm *AdminClient) ListFaults(ctx context.Context) ([]FaultInjection, error) {
+	// Execute GET on /minio/admin/v3/debug/faults
+	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{
+		relPath: adminAPIPrefix + "/debug/faults",
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var faults []FaultInjection
+	if err = adm.unmarshalResponse(resp, b, &faults); err != nil {
+		return nil, err
+	}
+	return faults, nil
+}
+
+// ClearFault - removes an injected fault before it expires, an empty
+// id removes all faults.
+func (adm *AdminClient) ClearFault(ctx context.Context, id string) error {
+	queryValues := url.Values{}
+	if id != "" {
+		queryValues.Set("id", id)
+	}
+
+	// Execute DELETE on /minio/admin/v3/debug/faults
+	resp, err := adm.executeMethod(ctx, http.MethodDelete, requestData{
+		relPath:     adminAPIPrefix + "/debug/faults",
+		queryValues: queryValues,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+	return nil
+}
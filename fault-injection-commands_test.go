@@ -0,0 +1,139 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestFaultInjectOptsValidate(t *testing.T) {
+	valid := FaultInjectOpts{
+		Type:          FaultDriveLatency,
+		Latency:       time.Second,
+		Duration:      time.Minute,
+		NonProduction: true,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := valid
+	opts.NonProduction = false
+	if err := opts.Validate(); err != ErrFaultInjectionNotAcknowledged {
+		t.Fatalf("expected ErrFaultInjectionNotAcknowledged, got %v", err)
+	}
+	for _, d := range []time.Duration{0, -time.Second, MaxFaultDuration + time.Second} {
+		opts = valid
+		opts.Duration = d
+		if err := opts.Validate(); err == nil {
+			t.Errorf("expected duration %s to be rejected", d)
+		}
+	}
+	opts = valid
+	opts.Duration = MaxFaultDuration
+	if err := opts.Validate(); err != nil {
+		t.Errorf("expected MaxFaultDuration to be accepted, got %v", err)
+	}
+	opts = valid
+	opts.Probability = 1.5
+	if err := opts.Validate(); err == nil {
+		t.Error("expected probability above 1 to be rejected")
+	}
+	opts = FaultInjectOpts{Type: FaultNetworkPartition, Node: "node1:9000", Duration: time.Minute, NonProduction: true}
+	if err := opts.Validate(); err == nil {
+		t.Error("expected network partition without peers to be rejected")
+	}
+}
+
+func TestInjectFault(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != libraryAdminURLPrefix+adminAPIPrefix+"/debug/faults" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.Method {
+		case http.MethodPost:
+			body, _ := ioutil.ReadAll(r.Body)
+			var fields map[string]interface{}
+			if err := json.Unmarshal(body, &fields); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			// The acknowledgement is never sent and the probability
+			// defaults to 1.
+			if _, ok := fields["NonProduction"]; ok || fields["probability"] != 1.0 {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"Code":"InvalidArgument","Message":"unexpected fault"}`))
+				return
+			}
+			w.Write([]byte(`{"id":"fault1","opts":` + string(body) + `,"created":"2021-05-01T00:00:00Z","expires":"2021-05-01T00:01:00Z"}`))
+		case http.MethodGet:
+			w.Write([]byte(`[{"id":"fault1","opts":{"type":"api-error","api":"PutObject","errorCode":"SlowDown","probability":0.5,"duration":60000000000}}]`))
+		case http.MethodDelete:
+			if r.URL.Query().Get("id") != "fault1" {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	opts := FaultInjectOpts{Type: FaultAPIError, API: "PutObject", ErrorCode: "SlowDown", Duration: time.Minute}
+	if _, err = adm.InjectFault(ctx, opts); !errors.Is(err, ErrFaultInjectionNotAcknowledged) {
+		t.Fatalf("expected ErrFaultInjectionNotAcknowledged, got %v", err)
+	}
+	if requests != 0 {
+		t.Fatal("expected unacknowledged faults not to be sent")
+	}
+
+	opts.NonProduction = true
+	fault, err := adm.InjectFault(ctx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fault.ID != "fault1" || fault.Opts.Probability != 1 || fault.Opts.NonProduction || fault.Opts.Duration != time.Minute {
+		t.Fatalf("unexpected fault %+v", fault)
+	}
+
+	faults, err := adm.ListFaults(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(faults) != 1 || faults[0].Opts.Type != FaultAPIError || faults[0].Opts.Probability != 0.5 {
+		t.Fatalf("unexpected faults %+v", faults)
+	}
+
+	if err = adm.ClearFault(ctx, "fault1"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,327 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GoroutineFrame - a frame of a goroutine stack.
+type GoroutineFrame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+// GoroutineStack - a goroutine stack, leaf first, and the number of
+// goroutines sharing it.
+type GoroutineStack struct {
+	// State like "chan receive", empty for dumps without states.
+	State  string           `json:"state,omitempty"`
+	Frames []GoroutineFrame `json:"frames"`
+	Count  int              `json:"count"`
+	// WaitMinutes is the longest time a goroutine has been blocked.
+	WaitMinutes int `json:"waitMinutes,omitempty"`
+}
+
+func (s GoroutineStack) key() string {
+	var b strings.Builder
+	b.WriteString(s.State)
+	for _, f := range s.Frames {
+		fmt.Fprintf(&b, "\n%s %s:%d", f.Function, f.File, f.Line)
+	}
+	return b.String()
+}
+
+// AggregatedGoroutineStack - a stack shared by goroutines of one or
+// more nodes.
+type AggregatedGoroutineStack struct {
+	GoroutineStack
+	// Nodes counts the goroutines with this stack per node.
+	Nodes map[string]int `json:"nodes"`
+}
+
+// GoroutineReport - deduplicated goroutine stacks of a cluster.
+type GoroutineReport struct {
+	Total int            `json:"total"`
+	Nodes map[string]int `json:"nodes"`
+	// Stacks by descending count.
+	Stacks []AggregatedGoroutineStack `json:"stacks"`
+}
+
+var (
+	goroutineHeaderRegex = regexp.MustCompile(`^goroutine \d+ \[([^\]]*)\]:$`)
+	goroutineWaitRegex   = regexp.MustCompile(`^(\d+) minutes$`)
+	goroutineFileRegex   = regexp.MustCompile(`^(.*):(\d+)(?: \+0x[0-9a-f]+)?$`)
+)
+
+// ParseGoroutineDump - parses a goroutine dump as written by the
+// goroutine profile with debug=1 or debug=2, i.e. panics and SIGQUIT.
+// Identical stacks are returned once with their count.
+func ParseGoroutineDump(r io.Reader) ([]GoroutineStack, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64<<10), 1<<20)
+
+	var stacks []GoroutineStack
+	var cur *GoroutineStack
+	var pendingFunc string
+	flush := func() {
+		if cur != nil && len(cur.Frames) > 0 {
+			stacks = append(stacks, *cur)
+		}
+		cur, pendingFunc = nil, ""
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.TrimSpace(line) == "":
+			flush()
+		case strings.HasPrefix(line, "goroutine profile:"):
+		case goroutineHeaderRegex.MatchString(line):
+			// debug=2: goroutine 7 [chan receive, 5 minutes]:
+			flush()
+			cur = &GoroutineStack{Count: 1}
+			var states []string
+			for _, part := range strings.Split(goroutineHeaderRegex.FindStringSubmatch(line)[1], ", ") {
+				if m := goroutineWaitRegex.FindStringSubmatch(part); m != nil {
+					cur.WaitMinutes, _ = strconv.Atoi(m[1])
+					continue
+				}
+				states = append(states, part)
+			}
+			cur.State = strings.Join(states, ", ")
+		case strings.Contains(line, " @ 0x"):
+			// debug=1: 5 @ 0x43a2c5 0x4066d5 ...
+			flush()
+			count, err := strconv.Atoi(strings.SplitN(line, " ", 2)[0])
+			if err != nil {
+				return nil, fmt.Errorf("malformed goroutine dump line %q", line)
+			}
+			cur = &GoroutineStack{Count: count}
+		case strings.HasPrefix(line, "#\t"):
+			// debug=1: #	0x4383f2	pkg.fn+0x65	/path/file.go:222
+			fields := strings.Split(line, "\t")
+			if cur == nil || len(fields) < 4 {
+				continue
+			}
+			fn := fields[2]
+			if i := strings.LastIndex(fn, "+0x"); i > 0 {
+				fn = fn[:i]
+			}
+			cur.Frames = append(cur.Frames, goroutineFrame(fn, fields[3]))
+		case cur == nil || strings.HasPrefix(line, "..."):
+		case strings.HasPrefix(line, "\t"):
+			if pendingFunc != "" {
+				cur.Frames = append(cur.Frames, goroutineFrame(pendingFunc, strings.TrimSpace(line)))
+				pendingFunc = ""
+			}
+		default:
+			// debug=2 function line: pkg.(*T).fn(0x1, 0x2) or
+			// created by pkg.fn in goroutine 1
+			fn := line
+			if i := strings.Index(fn, " in goroutine "); i > 0 {
+				fn = fn[:i]
+			}
+			if strings.HasSuffix(fn, ")") {
+				if i := strings.LastIndex(fn, "("); i > 0 {
+					fn = fn[:i]
+				}
+			}
+			pendingFunc = fn
+		}
+	}
+	flush()
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Deduplicate stacks of debug=2 dumps.
+	byKey := make(map[string]int)
+	var deduped []GoroutineStack
+	for _, s := range stacks {
+		k := s.key()
+		if i, ok := byKey[k]; ok {
+			deduped[i].Count += s.Count
+			if s.WaitMinutes > deduped[i].WaitMinutes {
+				deduped[i].WaitMinutes = s.WaitMinutes
+			}
+			continue
+		}
+		byKey[k] = len(deduped)
+		deduped = append(deduped, s)
+	}
+	return deduped, nil
+}
+
+func goroutineFrame(fn, location string) GoroutineFrame {
+	f := GoroutineFrame{Function: fn, File: location}
+	if m := goroutineFileRegex.FindStringSubmatch(location); m != nil {
+		f.File = m[1]
+		f.Line, _ = strconv.Atoi(m[2])
+	}
+	return f
+}
+
+// AggregateGoroutineDumps - deduplicates the stacks of the goroutine
+// dumps of several nodes, keyed by node name.
+func AggregateGoroutineDumps(dumps map[string]io.Reader) (GoroutineReport, error) {
+	report := GoroutineReport{Nodes: make(map[string]int)}
+	byKey := make(map[string]int)
+	nodes := make([]string, 0, len(dumps))
+	for node := range dumps {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	for _, node := range nodes {
+		stacks, err := ParseGoroutineDump(dumps[node])
+		if err != nil {
+			return GoroutineReport{}, fmt.Errorf("%s: %w", node, err)
+		}
+		for _, s := range stacks {
+			report.Total += s.Count
+			report.Nodes[node] += s.Count
+			k := s.key()
+			i, ok := byKey[k]
+			if !ok {
+				i = len(report.Stacks)
+				byKey[k] = i
+				agg := AggregatedGoroutineStack{GoroutineStack: s, Nodes: make(map[string]int)}
+				agg.Count = 0
+				report.Stacks = append(report.Stacks, agg)
+			}
+			agg := &report.Stacks[i]
+			agg.Count += s.Count
+			agg.Nodes[node] += s.Count
+			if s.WaitMinutes > agg.WaitMinutes {
+				agg.WaitMinutes = s.WaitMinutes
+			}
+		}
+	}
+	sort.SliceStable(report.Stacks, func(i, j int) bool {
+		return report.Stacks[i].Count > report.Stacks[j].Count
+	})
+	return report, nil
+}
+
+// WriteTo - writes a compact text report, one block per stack.
+func (r GoroutineReport) WriteTo(w io.Writer) (int64, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%d goroutines on %d nodes\n", r.Total, len(r.Nodes))
+	for _, s := range r.Stacks {
+		nodes := make([]string, 0, len(s.Nodes))
+		for node, n := range s.Nodes {
+			nodes = append(nodes, node+"="+strconv.Itoa(n))
+		}
+		sort.Strings(nodes)
+		fmt.Fprintf(&b, "\n%d", s.Count)
+		if s.State != "" {
+			fmt.Fprintf(&b, " [%s", s.State)
+			if s.WaitMinutes > 0 {
+				fmt.Fprintf(&b, ", %d minutes", s.WaitMinutes)
+			}
+			b.WriteString("]")
+		}
+		fmt.Fprintf(&b, " %s\n", strings.Join(nodes, " "))
+		for _, f := range s.Frames {
+			fmt.Fprintf(&b, "    %s\n        %s:%d\n", f.Function, f.File, f.Line)
+		}
+	}
+	return b.WriteTo(w)
+}
+
+// goroutineDumpNode - returns the node of a goroutine dump in the
+// profiling data archive, named profile-<node>-goroutines*.txt. Dumps
+// with states (debug=2) are preferred.
+func goroutineDumpNode(name string) (node string, priority int) {
+	name = path.Base(name)
+	i := strings.LastIndex(name, "-goroutines")
+	if !strings.HasPrefix(name, "profile-") || i < len("profile-") {
+		return "", 0
+	}
+	node = name[len("profile-"):i]
+	switch {
+	case strings.Contains(name[i:], "debug=2"):
+		return node, 3
+	case strings.HasSuffix(name, "-goroutines.txt"):
+		return node, 2
+	}
+	return node, 1
+}
+
+// ClusterGoroutines - collects goroutine dumps from all nodes through
+// the profiling API and returns their deduplicated stacks.
+func (adm *AdminClient) ClusterGoroutines(ctx context.Context) (GoroutineReport, error) {
+	results, err := adm.StartProfiling(ctx, ProfilerGoroutines)
+	if err != nil {
+		return GoroutineReport{}, err
+	}
+	for _, r := range results {
+		if !r.Success {
+			return GoroutineReport{}, fmt.Errorf("unable to start goroutine profiling on %s: %s", r.NodeName, r.Error)
+		}
+	}
+
+	body, err := adm.DownloadProfilingData(ctx)
+	if err != nil {
+		return GoroutineReport{}, err
+	}
+	defer body.Close()
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return GoroutineReport{}, err
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return GoroutineReport{}, err
+	}
+
+	best := make(map[string]*zip.File)
+	priorities := make(map[string]int)
+	for _, f := range archive.File {
+		node, priority := goroutineDumpNode(f.Name)
+		if node != "" && priority > priorities[node] {
+			best[node], priorities[node] = f, priority
+		}
+	}
+	dumps := make(map[string]io.Reader, len(best))
+	for node, f := range best {
+		rc, err := f.Open()
+		if err != nil {
+			return GoroutineReport{}, err
+		}
+		dump, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return GoroutineReport{}, err
+		}
+		dumps[node] = bytes.NewReader(dump)
+	}
+	return AggregateGoroutineDumps(dumps)
+}
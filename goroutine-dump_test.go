@@ -0,0 +1,131 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const testGoroutineDump = `goroutine 1 [chan receive, 12 minutes]:
+main.(*server).wait(0xc000010000, 0x1)
+	/src/main.go:42 +0x1f
+main.main()
+	/src/main.go:10 +0x25
+
+goroutine 7 [chan receive, 3 minutes]:
+main.(*server).wait(0xc000010008, 0x2)
+	/src/main.go:42 +0x1f
+main.main()
+	/src/main.go:10 +0x25
+
+goroutine 9 [running]:
+main.worker(...)
+	/src/worker.go:7
+created by main.main in goroutine 1
+	/src/main.go:12 +0x3a
+`
+
+const testGoroutineProfile = `goroutine profile: total 3
+3 @ 0x43a2c5 0x4066d5
+#	0x4066d4	main.(*server).wait+0x1f	/src/main.go:42
+#	0x43a2c4	main.main+0x25	/src/main.go:10
+`
+
+func TestParseGoroutineDump(t *testing.T) {
+	stacks, err := ParseGoroutineDump(strings.NewReader(testGoroutineDump))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stacks) != 2 {
+		t.Fatalf("expected 2 stacks, got %+v", stacks)
+	}
+	wait := stacks[0]
+	if wait.Count != 2 || wait.State != "chan receive" || wait.WaitMinutes != 12 ||
+		wait.Frames[0] != (GoroutineFrame{Function: "main.(*server).wait", File: "/src/main.go", Line: 42}) {
+		t.Errorf("unexpected stack %+v", wait)
+	}
+	if created := stacks[1].Frames[1]; created.Function != "created by main.main" || created.Line != 12 {
+		t.Errorf("unexpected frame %+v", created)
+	}
+
+	stacks, err = ParseGoroutineDump(strings.NewReader(testGoroutineProfile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stacks) != 1 || stacks[0].Count != 3 || stacks[0].Frames[0].Function != "main.(*server).wait" || stacks[0].Frames[1].Line != 10 {
+		t.Errorf("unexpected stacks %+v", stacks)
+	}
+}
+
+func TestClusterGoroutines(t *testing.T) {
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for name, dump := range map[string]string{
+		"profile-node1:9000-goroutines-before,debug=2.txt": testGoroutineDump,
+		"profile-node1:9000-goroutines.txt":                testGoroutineProfile,
+		"profile-node-2:9000-goroutines.txt":               testGoroutineProfile,
+		"profile-node1:9000-cpu.pprof":                     "",
+	} {
+		w, _ := zw.Create(name)
+		w.Write([]byte(dump))
+	}
+	zw.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/profiling/start"):
+			w.Write([]byte(`[{"nodeName":"node1:9000","success":true},{"nodeName":"node-2:9000","success":true}]`))
+		case strings.HasSuffix(r.URL.Path, "/profiling/download"):
+			w.Write(archive.Bytes())
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := adm.ClusterGoroutines(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Total != 6 || report.Nodes["node1:9000"] != 3 || report.Nodes["node-2:9000"] != 3 {
+		t.Fatalf("unexpected totals %d %v", report.Total, report.Nodes)
+	}
+	// The stateless stack of node-2 differs from the states of node1.
+	if len(report.Stacks) != 3 || report.Stacks[0].Count != 3 || report.Stacks[0].Nodes["node-2:9000"] != 3 {
+		t.Fatalf("unexpected stacks %+v", report.Stacks)
+	}
+
+	var buf bytes.Buffer
+	if _, err = report.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "2 [chan receive, 12 minutes] node1:9000=2\n") {
+		t.Errorf("unexpected report\n%s", buf.String())
+	}
+}
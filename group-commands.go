@@ -94,7 +94,7 @@ func (adm *AdminClient) GetGroupDescription(ctx context.Context, group string) (
 	}
 
 	gd := GroupDesc{}
-	if err = json.Unmarshal(data, &gd); err != nil {
+	if err = adm.unmarshalResponse(resp, data, &gd); err != nil {
 		return nil, err
 	}
 
@@ -123,7 +123,7 @@ func (adm *AdminClient) ListGroups(ctx context.Context) ([]string, error) {
 	}
 
 	groups := []string{}
-	if err = json.Unmarshal(data, &groups); err != nil {
+	if err = adm.unmarshalResponse(resp, data, &groups); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,193 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Checks of HardwareRecommendation.
+const (
+	HardwareCheckCoresPerDrive = "cores-per-drive"
+	HardwareCheckMemPerTiB     = "memory-per-tib"
+	HardwareCheckNetVsDrives   = "network-vs-drives"
+	HardwareCheckIOScheduler   = "io-scheduler"
+)
+
+// hardwareChecklistURL documents the hardware sizing guidelines.
+const hardwareChecklistURL = "https://min.io/docs/minio/linux/operations/checklists/hardware.html"
+
+// HardwareAdvisorThresholds - limits RecommendHardware warns about,
+// zero fields use the value of DefaultHardwareAdvisorThresholds.
+type HardwareAdvisorThresholds struct {
+	// MinCoresPerDrive is the minimum number of logical CPUs per drive,
+	// erasure coding and checksums are CPU bound.
+	MinCoresPerDrive float64
+	// MinMemPerTiB is the minimum RAM per TiB of raw drive capacity.
+	MinMemPerTiB ByteSize
+	// MinNetToDriveRatio is the minimum network throughput relative to
+	// the aggregate drive throughput of a node.
+	MinNetToDriveRatio float64
+}
+
+// DefaultHardwareAdvisorThresholds - default limits of RecommendHardware.
+var DefaultHardwareAdvisorThresholds = HardwareAdvisorThresholds{
+	MinCoresPerDrive:   0.5,
+	MinMemPerTiB:       1 * GiB,
+	MinNetToDriveRatio: 1,
+}
+
+// HardwareRecommendation - a sizing issue found in a health report.
+type HardwareRecommendation struct {
+	Node      string `json:"node"`
+	Check     string `json:"check"`
+	Message   string `json:"message"`
+	Reference string `json:"reference"`
+}
+
+// hardwareNode - the sizing relevant data of a node.
+type hardwareNode struct {
+	cores           int
+	mem             ByteSize
+	drives          int
+	capacity        ByteSize
+	driveThroughput ByteSize
+	netThroughput   ByteSize
+	// Block devices with an unsuitable I/O scheduler.
+	schedulers []Partition
+}
+
+// RecommendHardware - inspects the CPU, memory, drive and network data
+// of a health report and returns the sizing issues of every node. Checks
+// are skipped for nodes missing the data they need, e.g. the network
+// check needs perf results.
+func RecommendHardware(info HealthInfo, thresholds HardwareAdvisorThresholds) []HardwareRecommendation {
+	if thresholds.MinCoresPerDrive <= 0 {
+		thresholds.MinCoresPerDrive = DefaultHardwareAdvisorThresholds.MinCoresPerDrive
+	}
+	if thresholds.MinMemPerTiB <= 0 {
+		thresholds.MinMemPerTiB = DefaultHardwareAdvisorThresholds.MinMemPerTiB
+	}
+	if thresholds.MinNetToDriveRatio <= 0 {
+		thresholds.MinNetToDriveRatio = DefaultHardwareAdvisorThresholds.MinNetToDriveRatio
+	}
+
+	nodes := make(map[string]*hardwareNode)
+	node := func(addr string) *hardwareNode {
+		n, ok := nodes[addr]
+		if !ok {
+			n = &hardwareNode{}
+			nodes[addr] = n
+		}
+		return n
+	}
+	for _, c := range info.Sys.CPUInfo {
+		for _, socket := range c.CPUs {
+			node(c.Addr).cores += socket.Cores
+		}
+	}
+	for _, m := range info.Sys.MemInfo {
+		node(m.Addr).mem = m.Total
+	}
+	for _, p := range info.Sys.Partitions {
+		for _, part := range p.Partitions {
+			if part.Scheduler != "" && part.Scheduler != recommendedScheduler(part) {
+				node(p.Addr).schedulers = append(node(p.Addr).schedulers, part)
+			}
+		}
+	}
+	for _, s := range info.Minio.Info.Servers {
+		n := node(s.Endpoint)
+		n.drives = len(s.Disks)
+		for _, d := range s.Disks {
+			n.capacity += ByteSize(d.TotalSpace)
+		}
+	}
+	for _, d := range info.Perf.Drives {
+		// Drives are measured once per mode, only sequential writes
+		// are compared to the network.
+		serial, perf := d.ByMode(DrivePerfSeqWrite)
+		if len(perf) == 0 {
+			perf = serial
+		}
+		for _, p := range perf {
+			node(d.Addr).driveThroughput += p.Throughput.Avg
+		}
+	}
+	for _, n := range info.Perf.Net {
+		for _, peer := range n.RemotePeers {
+			if peer.Error == "" && peer.Throughput.Avg > node(n.Addr).netThroughput {
+				node(n.Addr).netThroughput = peer.Throughput.Avg
+			}
+		}
+	}
+
+	var recs []HardwareRecommendation
+	add := func(addr, check, format string, args ...interface{}) {
+		recs = append(recs, HardwareRecommendation{
+			Node:      addr,
+			Check:     check,
+			Message:   fmt.Sprintf(format, args...),
+			Reference: hardwareChecklistURL,
+		})
+	}
+	addrs := make([]string, 0, len(nodes))
+	for addr := range nodes {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		n := nodes[addr]
+		if n.cores > 0 && n.drives > 0 && float64(n.cores)/float64(n.drives) < thresholds.MinCoresPerDrive {
+			add(addr, HardwareCheckCoresPerDrive, "%d CPUs for %d drives, at least %d are recommended",
+				n.cores, n.drives, int(thresholds.MinCoresPerDrive*float64(n.drives)+0.5))
+		}
+		if n.mem > 0 && n.capacity > 0 {
+			if required := ByteSize(float64(thresholds.MinMemPerTiB) * float64(n.capacity) / float64(TiB)); n.mem < required {
+				add(addr, HardwareCheckMemPerTiB, "%s of RAM for %s of drive capacity, at least %s are recommended",
+					n.mem, n.capacity, required)
+			}
+		}
+		if n.netThroughput > 0 && n.driveThroughput > 0 &&
+			float64(n.netThroughput) < thresholds.MinNetToDriveRatio*float64(n.driveThroughput) {
+			add(addr, HardwareCheckNetVsDrives, "network throughput of %s/s is below the aggregate drive throughput of %s/s, the network limits performance",
+				n.netThroughput, n.driveThroughput)
+		}
+		for _, part := range n.schedulers {
+			add(addr, HardwareCheckIOScheduler, "%s uses the %s I/O scheduler, %s is recommended for %s",
+				part.Device, part.Scheduler, recommendedScheduler(part), driveKind(part))
+		}
+	}
+	return recs
+}
+
+// recommendedScheduler - SSDs and NVMe drives do best without I/O
+// scheduling, HDDs with mq-deadline.
+func recommendedScheduler(p Partition) string {
+	if p.IsRotational {
+		return "mq-deadline"
+	}
+	return "none"
+}
+
+func driveKind(p Partition) string {
+	if p.IsRotational {
+		return "HDDs"
+	}
+	return "SSDs"
+}
@@ -0,0 +1,82 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import "testing"
+
+func TestRecommendHardware(t *testing.T) {
+	var info HealthInfo
+	info.Sys.CPUInfo = []CPUs{
+		{Addr: "node1:9000", CPUs: []CPU{{Cores: 4}}},
+		{Addr: "node2:9000", CPUs: []CPU{{Cores: 16}, {Cores: 16}}},
+	}
+	info.Sys.MemInfo = []MemInfo{
+		{Addr: "node1:9000", Total: 64 * GiB},
+		{Addr: "node2:9000", Total: 16 * GiB},
+	}
+	drives := make([]Disk, 16)
+	for i := range drives {
+		drives[i].TotalSpace = uint64(2 * TiB)
+	}
+	info.Minio.Info.Servers = []ServerProperties{
+		{Endpoint: "node1:9000", Disks: drives},
+		{Endpoint: "node2:9000", Disks: drives},
+	}
+	info.Perf.Drives = []DrivePerfInfos{
+		{Addr: "node1:9000", ParallelPerf: []DrivePerfInfo{{Throughput: Throughput{Avg: 1 * GiB}}, {Throughput: Throughput{Avg: 1 * GiB}}}},
+		{Addr: "node2:9000", SerialPerf: []DrivePerfInfo{{Throughput: Throughput{Avg: 1 * GiB}}}},
+	}
+	info.Perf.Net = []NetPerfInfo{
+		{Addr: "node1:9000", RemotePeers: []PeerNetPerfInfo{{Throughput: Throughput{Avg: 1 * GiB}}}},
+		{Addr: "node2:9000", RemotePeers: []PeerNetPerfInfo{{Throughput: Throughput{Avg: 3 * GiB}}, {Error: "timeout"}}},
+	}
+
+	recs := RecommendHardware(info, HardwareAdvisorThresholds{})
+	expected := []struct{ node, check string }{
+		{"node1:9000", HardwareCheckCoresPerDrive},
+		{"node1:9000", HardwareCheckNetVsDrives},
+		{"node2:9000", HardwareCheckMemPerTiB},
+	}
+	if len(recs) != len(expected) {
+		t.Fatalf("expected %d recommendations, got %+v", len(expected), recs)
+	}
+	for i, e := range expected {
+		if recs[i].Node != e.node || recs[i].Check != e.check || recs[i].Reference == "" {
+			t.Errorf("expected %s on %s, got %+v", e.check, e.node, recs[i])
+		}
+	}
+	if recs[0].Message != "4 CPUs for 16 drives, at least 8 are recommended" {
+		t.Errorf("unexpected message %q", recs[0].Message)
+	}
+
+	if recs = RecommendHardware(info, HardwareAdvisorThresholds{MinCoresPerDrive: 0.1, MinMemPerTiB: 512 * MiB, MinNetToDriveRatio: 0.5}); len(recs) != 0 {
+		t.Errorf("expected no recommendations with relaxed thresholds, got %+v", recs)
+	}
+
+	// Drives measured in several modes are compared by their sequential
+	// write throughput.
+	info.Perf.Drives[1].SerialPerf = []DrivePerfInfo{
+		{Mode: DrivePerfSeqWrite, Throughput: Throughput{Avg: 1 * GiB}},
+		{Mode: DrivePerfSeqRead, Throughput: Throughput{Avg: 2 * GiB}},
+		{Mode: DrivePerfRand4K, Throughput: Throughput{Avg: 1 * GiB}},
+	}
+	for _, rec := range RecommendHardware(info, HardwareAdvisorThresholds{}) {
+		if rec.Node == "node2:9000" && rec.Check == HardwareCheckNetVsDrives {
+			t.Errorf("unexpected recommendation %+v", rec)
+		}
+	}
+}
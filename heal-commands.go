@@ -19,6 +19,7 @@ package madmin
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -79,9 +80,9 @@ type HealStopSuccess HealStartSuccess
 
 // HealTaskStatus - status struct for a heal task
 type HealTaskStatus struct {
-	Summary       string    `json:"summary"`
-	FailureDetail string    `json:"detail"`
-	StartTime     time.Time `json:"startTime"`
+	Summary       string    `json:"summary" table:"SUMMARY"`
+	FailureDetail string    `json:"detail" table:"DETAIL"`
+	StartTime     time.Time `json:"startTime" table:"STARTED"`
 	HealSettings  HealOpts  `json:"settings"`
 
 	Items []HealResultItem `json:"items,omitempty"`
@@ -121,11 +122,11 @@ type HealDriveInfo struct {
 // HealResultItem - struct for an individual heal result item
 type HealResultItem struct {
 	ResultIndex  int64        `json:"resultId"`
-	Type         HealItemType `json:"type"`
-	Bucket       string       `json:"bucket"`
-	Object       string       `json:"object"`
-	VersionID    string       `json:"versionId"`
-	Detail       string       `json:"detail"`
+	Type         HealItemType `json:"type" table:"TYPE"`
+	Bucket       string       `json:"bucket" table:"BUCKET"`
+	Object       string       `json:"object" table:"OBJECT"`
+	VersionID    string       `json:"versionId" table:"VERSION"`
+	Detail       string       `json:"detail" table:"DETAIL"`
 	ParityBlocks int          `json:"parityBlocks,omitempty"`
 	DataBlocks   int          `json:"dataBlocks,omitempty"`
 	DiskCount    int          `json:"diskCount"`
@@ -137,7 +138,7 @@ type HealResultItem struct {
 	After struct {
 		Drives []HealDriveInfo `json:"drives"`
 	} `json:"after"`
-	ObjectSize int64 `json:"objectSize"`
+	ObjectSize int64 `json:"objectSize" table:"SIZE,bytes"`
 }
 
 // GetMissingCounts - returns the number of missing disks before
@@ -278,9 +279,9 @@ func (adm *AdminClient) Heal(ctx context.Context, bucket, prefix string,
 		// similar struct as healStart will have the
 		// heal sequence information about the heal which
 		// was stopped.
-		err = json.Unmarshal(respBytes, &healStart)
+		err = adm.unmarshalResponse(resp, respBytes, &healStart)
 	} else {
-		err = json.Unmarshal(respBytes, &healTaskStatus)
+		err = adm.unmarshalResponse(resp, respBytes, &healTaskStatus)
 	}
 	if err != nil {
 		// May be the server responded with error after success
@@ -303,16 +304,16 @@ type BgHealState struct {
 	HealDisks []string
 
 	// SetStatus contains information for each set.
-	Sets []SetStatus `json:"sets"`
+	Sets []SetStatus `json:"sets" table:",rows"`
 }
 
 // SetStatus contains information about the heal status of a set.
 type SetStatus struct {
 	ID           string `json:"id"`
-	PoolIndex    int    `json:"pool_index"`
-	SetIndex     int    `json:"set_index"`
-	HealStatus   string `json:"heal_status"`
-	HealPriority string `json:"heal_priority"`
+	PoolIndex    int    `json:"pool_index" table:"POOL"`
+	SetIndex     int    `json:"set_index" table:"SET"`
+	HealStatus   string `json:"heal_status" table:"STATUS"`
+	HealPriority string `json:"heal_priority" table:"PRIORITY"`
 	Disks        []Disk `json:"disks"`
 }
 
@@ -414,9 +415,111 @@ func (adm *AdminClient) BackgroundHealStatus(ctx context.Context) (BgHealState,
 
 	var healState BgHealState
 
-	err = json.Unmarshal(respBytes, &healState)
+	err = adm.unmarshalResponse(resp, respBytes, &healState)
 	if err != nil {
 		return BgHealState{}, err
 	}
 	return healState, nil
 }
+
+// healResultsPollInterval - interval between heal status requests of
+// a HealResultStream.
+var healResultsPollInterval = time.Second
+
+// HealResultStream - the results of a heal sequence, polled from the
+// server as they are consumed, so that only one batch of results is
+// held in memory.
+type HealResultStream struct {
+	ctx         context.Context
+	adm         *AdminClient
+	bucket      string
+	prefix      string
+	opts        HealOpts
+	clientToken string
+
+	items  []HealResultItem
+	item   HealResultItem
+	status HealTaskStatus
+	polled bool
+	done   bool
+	err    error
+}
+
+// HealResults - starts a heal sequence and returns a stream of its
+// results. Close the stream when done, which stops the sequence if it
+// has not finished.
+func (adm *AdminClient) HealResults(ctx context.Context, bucket, prefix string, opts HealOpts, forceStart bool) (*HealResultStream, error) {
+	start, _, err := adm.Heal(ctx, bucket, prefix, opts, "", forceStart, false)
+	if err != nil {
+		return nil, err
+	}
+	return &HealResultStream{
+		ctx:         ctx,
+		adm:         adm,
+		bucket:      bucket,
+		prefix:      prefix,
+		opts:        opts,
+		clientToken: start.ClientToken,
+	}, nil
+}
+
+// Next - advances to the next heal result, polling the server when the
+// current batch is consumed.
+func (s *HealResultStream) Next() bool {
+	for len(s.items) == 0 {
+		if s.done || s.err != nil {
+			return false
+		}
+		if s.polled {
+			select {
+			case <-s.ctx.Done():
+				s.err = s.ctx.Err()
+				return false
+			case <-time.After(healResultsPollInterval):
+			}
+		}
+		_, status, err := s.adm.Heal(s.ctx, s.bucket, s.prefix, s.opts, s.clientToken, false, false)
+		if err != nil {
+			s.err = err
+			return false
+		}
+		s.polled = true
+		s.items, status.Items = status.Items, nil
+		s.status = status
+		switch status.Summary {
+		case healSummaryFinished, healSummaryStopped:
+			s.done = true
+			if status.FailureDetail != "" {
+				s.err = errors.New(status.FailureDetail)
+			}
+		}
+	}
+	s.item, s.items = s.items[0], s.items[1:]
+	return true
+}
+
+// Item - returns the current heal result.
+func (s *HealResultStream) Item() HealResultItem {
+	return s.item
+}
+
+// Status - returns the status of the last poll, without results.
+func (s *HealResultStream) Status() HealTaskStatus {
+	return s.status
+}
+
+// Err - returns the error which ended the stream, if any.
+func (s *HealResultStream) Err() error {
+	return s.err
+}
+
+// Close - stops the heal sequence unless it finished.
+func (s *HealResultStream) Close() error {
+	s.items = nil
+	if s.done {
+		return nil
+	}
+	s.done = true
+	_, _, err := s.adm.Heal(context.Background(), s.bucket, s.prefix, s.opts, "", false, true)
+	return err
+}
@@ -0,0 +1,180 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// defaultAggregatorWorkers - concurrent node collections if
+// AggregatorOptions.Workers is not set.
+const defaultAggregatorWorkers = 16
+
+// HealthNodeCollector - collects the health data of a node, e.g. with
+// an internode call. It should return when ctx is done.
+type HealthNodeCollector func(ctx context.Context, node string) (HealthInfo, error)
+
+// AggregatorOptions - options of an Aggregator.
+type AggregatorOptions struct {
+	// Workers bounds the concurrent node collections, 16 by default.
+	Workers int
+	// NodeTimeout bounds the collection of each node, zero for none.
+	NodeTimeout time.Duration
+	// Clock of the report timestamp, SystemClock by default.
+	Clock Clock
+}
+
+// Aggregator - collects the health data of many nodes with a bounded
+// worker pool and merges it into one report, for servers aggregating
+// per-node health.
+type Aggregator struct {
+	collect HealthNodeCollector
+	opts    AggregatorOptions
+}
+
+// NewAggregator - returns an Aggregator collecting nodes with collect.
+func NewAggregator(collect HealthNodeCollector, opts AggregatorOptions) (*Aggregator, error) {
+	if collect == nil {
+		return nil, ErrInvalidArgument("Node collector must be set.")
+	}
+	if opts.Workers < 0 || opts.NodeTimeout < 0 {
+		return nil, ErrInvalidArgument("Workers and node timeout must not be negative.")
+	}
+	if opts.Workers == 0 {
+		opts.Workers = defaultAggregatorWorkers
+	}
+	if opts.Clock == nil {
+		opts.Clock = SystemClock
+	}
+	return &Aggregator{collect: collect, opts: opts}, nil
+}
+
+// Aggregate - collects all nodes and merges their SysInfo and PerfInfo
+// in the order of nodes. Nodes which fail, time out or panic are
+// returned as errors and left out of the report.
+func (a *Aggregator) Aggregate(ctx context.Context, nodes []string) (HealthInfo, []HealthNodeError) {
+	type result struct {
+		info HealthInfo
+		err  error
+	}
+	results := make([]result, len(nodes))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < a.opts.Workers && w < len(nodes); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i].info, results[i].err = a.collectNode(ctx, nodes[i])
+			}
+		}()
+	}
+	for i := range nodes {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	info := HealthInfo{Version: HealthInfoVersion, TimeStamp: a.opts.Clock.Now().UTC()}
+	var errs []HealthNodeError
+	for i, r := range results {
+		if r.err != nil {
+			code := healthErrorCodeOf(r.err)
+			if _, ok := r.err.(healthCollectorPanic); ok {
+				code = ErrCollectorPanic
+			}
+			errs = append(errs, newHealthNodeError(nodes[i], r.err.Error(), code))
+			continue
+		}
+		MergeSysInfo(&info.Sys, r.info.Sys)
+		MergePerfInfo(&info.Perf, r.info.Perf)
+	}
+	return info, errs
+}
+
+// healthCollectorPanic - a panic of a node collector.
+type healthCollectorPanic struct {
+	value interface{}
+}
+
+func (p healthCollectorPanic) Error() string {
+	return fmt.Sprintf("collector panic: %v", p.value)
+}
+
+// collectNode - collects a node within the node timeout, abandoning
+// collectors which do not return when their context is done.
+func (a *Aggregator) collectNode(ctx context.Context, node string) (HealthInfo, error) {
+	if a.opts.NodeTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.opts.NodeTimeout)
+		defer cancel()
+	}
+	type result struct {
+		info HealthInfo
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		var r result
+		defer func() {
+			if p := recover(); p != nil {
+				r.err = healthCollectorPanic{value: p}
+			}
+			done <- r
+		}()
+		r.info, r.err = a.collect(ctx, node)
+	}()
+	select {
+	case r := <-done:
+		return r.info, r.err
+	case <-ctx.Done():
+		return HealthInfo{}, ctx.Err()
+	}
+}
+
+// MergeSysInfo - appends the per-node system information of src to dst.
+func MergeSysInfo(dst *SysInfo, src SysInfo) {
+	dst.CPUInfo = append(dst.CPUInfo, src.CPUInfo...)
+	dst.Partitions = append(dst.Partitions, src.Partitions...)
+	dst.OSInfo = append(dst.OSInfo, src.OSInfo...)
+	dst.MemInfo = append(dst.MemInfo, src.MemInfo...)
+	dst.ProcInfo = append(dst.ProcInfo, src.ProcInfo...)
+	dst.ParallelFS = append(dst.ParallelFS, src.ParallelFS...)
+	dst.NASMounts = append(dst.NASMounts, src.NASMounts...)
+	dst.NetHw = append(dst.NetHw, src.NetHw...)
+	dst.DrivesSMART = append(dst.DrivesSMART, src.DrivesSMART...)
+	dst.Services = append(dst.Services, src.Services...)
+	dst.Kubernetes = append(dst.Kubernetes, src.Kubernetes...)
+	dst.TimeInfo = append(dst.TimeInfo, src.TimeInfo...)
+	dst.DualStack = append(dst.DualStack, src.DualStack...)
+	dst.TLSTrust = append(dst.TLSTrust, src.TLSTrust...)
+}
+
+// MergePerfInfo - appends the per-node performance results of src to
+// dst. The cluster-wide NetParallel result is kept from the first node
+// reporting it.
+func MergePerfInfo(dst *PerfInfo, src PerfInfo) {
+	dst.Drives = append(dst.Drives, src.Drives...)
+	dst.Net = append(dst.Net, src.Net...)
+	if dst.NetParallel.Addr == "" {
+		dst.NetParallel = src.NetParallel
+	}
+}
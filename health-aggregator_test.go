@@ -0,0 +1,109 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAggregator(t *testing.T) {
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	block := make(chan struct{})
+	defer close(block)
+	collect := func(ctx context.Context, node string) (HealthInfo, error) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+
+		switch node {
+		case "failed":
+			return HealthInfo{}, errors.New("permission denied")
+		case "hung":
+			<-block // Ignores ctx.
+		case "panic":
+			panic("nil map")
+		}
+		var info HealthInfo
+		info.Sys.CPUInfo = []CPUs{{Addr: node}}
+		info.Perf.Net = []NetPerfInfo{{Addr: node}}
+		return info, nil
+	}
+
+	a, err := NewAggregator(collect, AggregatorOptions{Workers: 2, NodeTimeout: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, errs := a.Aggregate(context.Background(), []string{"node1", "failed", "hung", "node2", "panic", "node3"})
+
+	var addrs []string
+	for _, c := range info.Sys.CPUInfo {
+		addrs = append(addrs, c.Addr)
+	}
+	if !reflect.DeepEqual(addrs, []string{"node1", "node2", "node3"}) || len(info.Perf.Net) != 3 {
+		t.Errorf("unexpected merged nodes %v", addrs)
+	}
+	codes := make(map[string]HealthErrorCode)
+	for _, e := range errs {
+		codes[e.Addr] = e.Code
+	}
+	want := map[string]HealthErrorCode{"failed": ErrPermissionDenied, "hung": ErrTimeout, "panic": ErrCollectorPanic}
+	if !reflect.DeepEqual(codes, want) {
+		t.Errorf("expected errors %v, got %v", want, codes)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if maxRunning > 2 {
+		t.Errorf("expected at most 2 concurrent collections, got %d", maxRunning)
+	}
+
+	if _, err = NewAggregator(nil, AggregatorOptions{}); err == nil {
+		t.Error("expected an error without collector")
+	}
+}
+
+func TestMergeSysInfoCoversFields(t *testing.T) {
+	// Every per-node slice of SysInfo must be merged.
+	var src SysInfo
+	v := reflect.ValueOf(&src).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		f.Set(reflect.MakeSlice(f.Type(), 1, 1))
+	}
+	var dst SysInfo
+	MergeSysInfo(&dst, src)
+	MergeSysInfo(&dst, src)
+	d := reflect.ValueOf(dst)
+	for i := 0; i < d.NumField(); i++ {
+		if d.Field(i).Len() != 2 {
+			t.Errorf("SysInfo.%s is not merged", d.Type().Field(i).Name)
+		}
+	}
+}
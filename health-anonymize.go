@@ -0,0 +1,264 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AnonymizationTable - maps the tokens of an anonymized health report
+// to the values they replace, keep it to read the report back with
+// DeanonymizeHealthInfo.
+type AnonymizationTable map[string]string
+
+var (
+	anonIPv4Regex = regexp.MustCompile(`\b\d{1,3}(\.\d{1,3}){3}\b`)
+	anonIPv6Regex = regexp.MustCompile(`[0-9A-Fa-f]{0,4}(:[0-9A-Fa-f]{0,4}){2,7}`)
+
+	// Secrets passed on a command line are removed for good, they are
+	// not recorded in the table.
+	anonCmdLineSecrets = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)(\b\w*(?:secret|password|passwd|token|key)\w*=)\S+`),
+		regexp.MustCompile(`(?i)(--?\w*(?:secret|password|passwd|token|key)[\w-]*[ =])\S+`),
+	}
+)
+
+// anonymizer - collects the values to anonymize and their tokens.
+type anonymizer struct {
+	tokens map[string]string // value -> token
+	counts map[string]int    // kind -> values of the kind
+}
+
+func (a *anonymizer) add(kind, value string) {
+	if len(value) < 2 || a.tokens[value] != "" {
+		return
+	}
+	a.counts[kind]++
+	token := kind + "-" + strconv.Itoa(a.counts[kind])
+	if kind == "path" {
+		token = "/" + token
+	}
+	a.tokens[value] = token
+}
+
+// addHost - adds the host of a URL, host:port or bare host.
+func (a *anonymizer) addHost(value string) {
+	if u, err := url.Parse(value); err == nil && u.Host != "" {
+		a.addHost(u.Host)
+		if u.Path != "" && u.Path != "/" {
+			a.add("path", u.Path)
+		}
+		return
+	}
+	if host, _, err := net.SplitHostPort(value); err == nil {
+		value = host
+	}
+	if net.ParseIP(value) != nil {
+		a.add("ip", value)
+		return
+	}
+	a.add("host", value)
+}
+
+// collect - finds the hostnames, IPs, usernames and paths within v and
+// removes command line secrets.
+func (a *anonymizer) collect(v interface{}) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, c := range t {
+			if s, ok := c.(string); ok {
+				switch k {
+				case "addr", "endpoint", "hostname", "remote":
+					a.addHost(s)
+				case "mountpoint", "path", "cwd", "exec_path", "drive_path":
+					if strings.HasPrefix(s, "/") {
+						a.add("path", s)
+					}
+				case "username":
+					a.add("user", s)
+				case "cmd_line":
+					for _, re := range anonCmdLineSecrets {
+						s = re.ReplaceAllString(s, "${1}"+DefaultRedactionReplacement)
+					}
+					t[k] = s
+				}
+			}
+			if k == "network" {
+				if hosts, ok := c.(map[string]interface{}); ok {
+					for host := range hosts {
+						a.addHost(host)
+					}
+				}
+			}
+			a.collect(c)
+		}
+	case []interface{}:
+		for _, c := range t {
+			a.collect(c)
+		}
+	case string:
+		for _, ip := range anonIPv4Regex.FindAllString(t, -1) {
+			if net.ParseIP(ip) != nil {
+				a.add("ip", ip)
+			}
+		}
+		for _, ip := range anonIPv6Regex.FindAllString(t, -1) {
+			if strings.Count(ip, ":") >= 2 && net.ParseIP(ip) != nil {
+				a.add("ip", ip)
+			}
+		}
+	}
+}
+
+// boundedReplacer - replaces values only where they are not part of a
+// longer name, e.g. node1 is not replaced within node10.
+type boundedReplacer struct {
+	// pairs by their first byte, longest first.
+	pairs map[byte][][2]string
+}
+
+func newBoundedReplacer(m map[string]string) boundedReplacer {
+	r := boundedReplacer{pairs: make(map[byte][][2]string)}
+	for from, to := range m {
+		if from != "" {
+			r.pairs[from[0]] = append(r.pairs[from[0]], [2]string{from, to})
+		}
+	}
+	for _, pairs := range r.pairs {
+		sort.Slice(pairs, func(i, j int) bool {
+			if len(pairs[i][0]) != len(pairs[j][0]) {
+				return len(pairs[i][0]) > len(pairs[j][0])
+			}
+			return pairs[i][0] < pairs[j][0]
+		})
+	}
+	return r
+}
+
+func isNameByte(c byte) bool {
+	return c == '-' || c == '_' || c == '.' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func (r boundedReplacer) replace(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		replaced := false
+		for _, p := range r.pairs[s[i]] {
+			// Paths carry their own separator, e.g. after a port.
+			if i > 0 && isNameByte(s[i-1]) && p[0][0] != '/' {
+				continue
+			}
+			end := i + len(p[0])
+			if strings.HasPrefix(s[i:], p[0]) && (end == len(s) || !isNameByte(s[end]) || s[end] == '.' && end+1 < len(s) && !isNameByte(s[end+1])) {
+				b.WriteString(p[1])
+				i, replaced = end, true
+				break
+			}
+		}
+		if !replaced {
+			b.WriteByte(s[i])
+			i++
+		}
+	}
+	return b.String()
+}
+
+// apply - replaces in all strings and object keys of v.
+func (r boundedReplacer) apply(v interface{}) interface{} {
+	switch t := v.(type) {
+	case string:
+		return r.replace(t)
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, c := range t {
+			m[r.replace(k)] = r.apply(c)
+		}
+		return m
+	case []interface{}:
+		for i, c := range t {
+			t[i] = r.apply(c)
+		}
+	}
+	return v
+}
+
+// AnonymizeHealthInfo - returns a copy of info with hostnames, IPs,
+// usernames and filesystem paths replaced by tokens like host-1, ip-2,
+// user-1 and /path-3, consistently across the whole report, and
+// secrets removed from command lines. The table maps the tokens back.
+func AnonymizeHealthInfo(info HealthInfo) (HealthInfo, AnonymizationTable, error) {
+	doc, err := healthInfoDoc(info)
+	if err != nil {
+		return HealthInfo{}, nil, err
+	}
+	a := &anonymizer{tokens: make(map[string]string), counts: make(map[string]int)}
+	a.collect(doc)
+
+	anonymized, err := healthInfoFromDoc(newBoundedReplacer(a.tokens).apply(doc))
+	if err != nil {
+		return HealthInfo{}, nil, err
+	}
+	table := make(AnonymizationTable, len(a.tokens))
+	for value, token := range a.tokens {
+		table[token] = value
+	}
+	return anonymized, table, nil
+}
+
+// Anonymize - see AnonymizeHealthInfo.
+func (info HealthInfo) Anonymize() (HealthInfo, AnonymizationTable, error) {
+	return AnonymizeHealthInfo(info)
+}
+
+// DeanonymizeHealthInfo - restores the values replaced by
+// AnonymizeHealthInfo, removed secrets stay removed.
+func DeanonymizeHealthInfo(info HealthInfo, table AnonymizationTable) (HealthInfo, error) {
+	doc, err := healthInfoDoc(info)
+	if err != nil {
+		return HealthInfo{}, err
+	}
+	return healthInfoFromDoc(newBoundedReplacer(table).apply(doc))
+}
+
+func healthInfoDoc(info HealthInfo) (interface{}, error) {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc interface{}
+	err = dec.Decode(&doc)
+	return doc, err
+}
+
+func healthInfoFromDoc(doc interface{}) (HealthInfo, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return HealthInfo{}, err
+	}
+	var info HealthInfo
+	err = json.Unmarshal(data, &info)
+	return info, err
+}
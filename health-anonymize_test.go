@@ -0,0 +1,75 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAnonymizeHealthInfo(t *testing.T) {
+	var info HealthInfo
+	info.Sys.MemInfo = []MemInfo{{Addr: "node1:9000"}, {Addr: "node10:9000", Error: "dial tcp 10.0.0.12:9000: connection refused"}}
+	info.Sys.Partitions = []Partitions{{Addr: "node1:9000", Partitions: []Partition{{Device: "/dev/sdb", Mountpoint: "/mnt/data1"}}}}
+	info.Sys.ProcInfo = []ProcInfo{{
+		Addr:     "node1:9000",
+		Username: "minio-user",
+		CmdLine:  "minio server --secret-key hunter2 http://node{1...10}:9000/mnt/data{1...4} MINIO_ROOT_PASSWORD=hunter3",
+		ExecPath: "/usr/local/bin/minio",
+	}}
+	info.Minio.Info.Servers = []ServerProperties{{
+		Endpoint: "node1:9000",
+		Network:  map[string]string{"node10:9000": "online"},
+		Disks:    []Disk{{Endpoint: "http://node1:9000/mnt/data1", DrivePath: "/mnt/data1"}},
+	}}
+
+	anon, table, err := info.Anonymize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := anon.JSON()
+	for _, secret := range []string{"node1", "10.0.0.12", "minio-user", "/mnt/data1", "hunter2", "hunter3"} {
+		if strings.Contains(data, secret) {
+			t.Errorf("%q was not anonymized:\n%s", secret, data)
+		}
+	}
+	if anon.Sys.MemInfo[0].Addr == anon.Sys.MemInfo[1].Addr {
+		t.Errorf("node1 and node10 share token %s", anon.Sys.MemInfo[0].Addr)
+	}
+	if anon.Sys.MemInfo[0].Addr != anon.Minio.Info.Servers[0].Endpoint || !strings.HasSuffix(anon.Sys.MemInfo[0].Addr, ":9000") {
+		t.Errorf("expected consistent tokens keeping the port, got %s and %s", anon.Sys.MemInfo[0].Addr, anon.Minio.Info.Servers[0].Endpoint)
+	}
+	if anon.Sys.Partitions[0].Partitions[0].Device != "/dev/sdb" {
+		t.Errorf("device was anonymized: %s", anon.Sys.Partitions[0].Partitions[0].Device)
+	}
+	if _, ok := anon.Minio.Info.Servers[0].Network[anon.Sys.MemInfo[1].Addr]; !ok {
+		t.Errorf("network map key was not anonymized: %v", anon.Minio.Info.Servers[0].Network)
+	}
+
+	restored, err := DeanonymizeHealthInfo(anon, table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info.Sys.ProcInfo[0].CmdLine = "minio server --secret-key REDACTED http://node{1...10}:9000/mnt/data{1...4} MINIO_ROOT_PASSWORD=REDACTED"
+	if !reflect.DeepEqual(restored.Sys, info.Sys) {
+		t.Errorf("expected %+v, got %+v", info.Sys, restored.Sys)
+	}
+	if !reflect.DeepEqual(restored.Minio.Info.Servers, info.Minio.Info.Servers) {
+		t.Errorf("expected %+v, got %+v", info.Minio.Info.Servers, restored.Minio.Info.Servers)
+	}
+}
@@ -0,0 +1,114 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// Canonicalize - sorts the slices of the report, nodes by address,
+// CPUs by physical ID, partitions by device and drives by path, so that
+// two reports of the same cluster diff cleanly. Reports collected by a
+// HealthScheduler and the output of JSON are canonical already.
+func (info *HealthInfo) Canonicalize() {
+	// Every per-node slice of SysInfo has an addr.
+	sys := reflect.ValueOf(&info.Sys).Elem()
+	for i := 0; i < sys.NumField(); i++ {
+		sortByField(sys.Field(i), "Addr")
+	}
+	for _, c := range info.Sys.CPUInfo {
+		sort.SliceStable(c.CPUs, func(i, j int) bool {
+			return lessNumeric(c.CPUs[i].PhysicalID, c.CPUs[j].PhysicalID)
+		})
+		sort.SliceStable(c.LogicalCPUs, func(i, j int) bool { return c.LogicalCPUs[i].ID < c.LogicalCPUs[j].ID })
+		sort.SliceStable(c.NUMANodes, func(i, j int) bool { return c.NUMANodes[i].ID < c.NUMANodes[j].ID })
+	}
+	for _, p := range info.Sys.Partitions {
+		sort.SliceStable(p.Partitions, func(i, j int) bool {
+			if p.Partitions[i].Device != p.Partitions[j].Device {
+				return p.Partitions[i].Device < p.Partitions[j].Device
+			}
+			return p.Partitions[i].Mountpoint < p.Partitions[j].Mountpoint
+		})
+	}
+
+	sortByField(reflect.ValueOf(info.Perf.Drives), "Addr")
+	for _, d := range info.Perf.Drives {
+		sortByField(reflect.ValueOf(d.SerialPerf), "Path")
+		sortByField(reflect.ValueOf(d.ParallelPerf), "Path")
+	}
+	sortByField(reflect.ValueOf(info.Perf.Net), "Addr")
+	for _, n := range info.Perf.Net {
+		sortByField(reflect.ValueOf(n.RemotePeers), "Addr")
+	}
+	sortByField(reflect.ValueOf(info.Perf.NetParallel.RemotePeers), "Addr")
+
+	servers := info.Minio.Info.Servers
+	sortByField(reflect.ValueOf(servers), "Endpoint")
+	for _, s := range servers {
+		sort.SliceStable(s.Disks, func(i, j int) bool {
+			a, b := s.Disks[i], s.Disks[j]
+			switch {
+			case a.PoolIndex != b.PoolIndex:
+				return a.PoolIndex < b.PoolIndex
+			case a.SetIndex != b.SetIndex:
+				return a.SetIndex < b.SetIndex
+			case a.DiskIndex != b.DiskIndex:
+				return a.DiskIndex < b.DiskIndex
+			}
+			return a.Endpoint < b.Endpoint
+		})
+	}
+}
+
+// sortByField - stably sorts a slice of structs by a string field.
+func sortByField(slice reflect.Value, field string) {
+	if slice.Kind() != reflect.Slice || slice.Len() < 2 {
+		return
+	}
+	sort.SliceStable(slice.Interface(), func(i, j int) bool {
+		return slice.Index(i).FieldByName(field).String() < slice.Index(j).FieldByName(field).String()
+	})
+}
+
+// lessNumeric - compares numerically if both strings are integers.
+func lessNumeric(a, b string) bool {
+	x, errA := strconv.Atoi(a)
+	y, errB := strconv.Atoi(b)
+	if errA == nil && errB == nil {
+		return x < y
+	}
+	return a < b
+}
+
+// canonicalJSON - returns info as indented JSON in canonical order,
+// without reordering the slices of info.
+func canonicalJSON(info HealthInfo) ([]byte, error) {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	var clone HealthInfo
+	if err = json.Unmarshal(data, &clone); err != nil {
+		return nil, err
+	}
+	clone.Canonicalize()
+	return json.MarshalIndent(clone, " ", "    ")
+}
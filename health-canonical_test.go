@@ -0,0 +1,70 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHealthInfoCanonicalize(t *testing.T) {
+	var info HealthInfo
+	info.Sys.CPUInfo = []CPUs{
+		{Addr: "node2", CPUs: []CPU{{PhysicalID: "10"}, {PhysicalID: "2"}}},
+		{Addr: "node1"},
+	}
+	info.Sys.Partitions = []Partitions{{Addr: "node1", Partitions: []Partition{{Device: "/dev/sdb"}, {Device: "/dev/sda"}}}}
+	info.Sys.Services = []SysServices{{Addr: "node3"}, {Addr: "node1"}}
+	info.Perf.Drives = []DrivePerfInfos{{Addr: "node1", SerialPerf: []DrivePerfInfo{{Path: "/d2"}, {Path: "/d1"}}}}
+	info.Minio.Info.Servers = []ServerProperties{
+		{Endpoint: "node2:9000"},
+		{Endpoint: "node1:9000", Disks: []Disk{{SetIndex: 1}, {SetIndex: 0, DiskIndex: 1}, {SetIndex: 0}}},
+	}
+
+	json := info.JSON()
+	if info.Sys.CPUInfo[0].Addr != "node2" {
+		t.Fatal("JSON must not reorder the report")
+	}
+
+	info.Canonicalize()
+	if info.Sys.CPUInfo[0].Addr != "node1" || info.Sys.CPUInfo[1].CPUs[0].PhysicalID != "2" {
+		t.Errorf("unexpected CPUs %+v", info.Sys.CPUInfo)
+	}
+	if info.Sys.Partitions[0].Partitions[0].Device != "/dev/sda" {
+		t.Errorf("unexpected partitions %+v", info.Sys.Partitions)
+	}
+	if info.Sys.Services[0].Addr != "node1" {
+		t.Errorf("unexpected services %+v", info.Sys.Services)
+	}
+	if info.Perf.Drives[0].SerialPerf[0].Path != "/d1" {
+		t.Errorf("unexpected drive perf %+v", info.Perf.Drives)
+	}
+	srv := info.Minio.Info.Servers[0]
+	if srv.Endpoint != "node1:9000" || srv.Disks[0].DiskIndex != 0 || srv.Disks[1].DiskIndex != 1 || srv.Disks[2].SetIndex != 1 {
+		t.Errorf("unexpected servers %+v", info.Minio.Info.Servers)
+	}
+	if info.JSON() != json {
+		t.Error("expected JSON of the canonical report to match")
+	}
+
+	shuffled := info
+	shuffled.Sys.Services = []SysServices{info.Sys.Services[1], info.Sys.Services[0]}
+	shuffled.Canonicalize()
+	if !reflect.DeepEqual(shuffled.Sys.Services, info.Sys.Services) {
+		t.Error("expected canonical order to be independent of input order")
+	}
+}
@@ -0,0 +1,140 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ConditionStatus - status of a StatusCondition.
+type ConditionStatus string
+
+// Statuses of Kubernetes conditions.
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// Types of the conditions of ConvertToConditions.
+const (
+	ConditionHealthy        = "Healthy"
+	ConditionNodesOnline    = "NodesOnline"
+	ConditionDrivesOnline   = "DrivesOnline"
+	ConditionSpaceAvailable = "SpaceAvailable"
+	ConditionConfigValid    = "ConfigValid"
+)
+
+// StatusCondition - a Kubernetes style status condition, with the JSON
+// encoding of metav1.Condition so that it can be copied into the status
+// of custom resources.
+type StatusCondition struct {
+	Type               string          `json:"type"`
+	Status             ConditionStatus `json:"status"`
+	ObservedGeneration int64           `json:"observedGeneration,omitempty"`
+	LastTransitionTime time.Time       `json:"lastTransitionTime"`
+	// Reason is a CamelCase identifier of the status.
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// ConvertToConditions - returns the conditions of a health summary, one
+// overall Healthy condition and one per aspect of the summary. The
+// transition time of all conditions is the current time of clock,
+// SystemClock if nil, use MergeConditions to keep the times of
+// unchanged conditions.
+func ConvertToConditions(summary ClusterHealthSummary, clock Clock) []StatusCondition {
+	if clock == nil {
+		clock = SystemClock
+	}
+	now := clock.Now().UTC().Truncate(time.Second)
+	condition := func(typ string, status ConditionStatus, reason, message string) StatusCondition {
+		return StatusCondition{Type: typ, Status: status, LastTransitionTime: now, Reason: reason, Message: message}
+	}
+
+	var conditions []StatusCondition
+	switch summary.Status {
+	case HealthStatusGreen:
+		conditions = append(conditions, condition(ConditionHealthy, ConditionTrue, "ClusterHealthy", "cluster is healthy"))
+	case HealthStatusYellow:
+		conditions = append(conditions, condition(ConditionHealthy, ConditionFalse, "ClusterDegraded", strings.Join(summary.Reasons, "; ")))
+	case HealthStatusRed:
+		conditions = append(conditions, condition(ConditionHealthy, ConditionFalse, "ClusterUnhealthy", strings.Join(summary.Reasons, "; ")))
+	default:
+		conditions = append(conditions, condition(ConditionHealthy, ConditionUnknown, "NoHealthData", "cluster health is unknown"))
+	}
+
+	switch {
+	case summary.TotalNodes == 0:
+		conditions = append(conditions, condition(ConditionNodesOnline, ConditionUnknown, "NoNodeData", "no node reported its state"))
+	case summary.OnlineNodes < summary.TotalNodes:
+		conditions = append(conditions, condition(ConditionNodesOnline, ConditionFalse, "NodesOffline",
+			fmt.Sprintf("%d of %d nodes offline", summary.TotalNodes-summary.OnlineNodes, summary.TotalNodes)))
+	default:
+		conditions = append(conditions, condition(ConditionNodesOnline, ConditionTrue, "AllNodesOnline",
+			fmt.Sprintf("%d nodes online", summary.TotalNodes)))
+	}
+
+	switch {
+	case summary.TotalDrives == 0:
+		conditions = append(conditions, condition(ConditionDrivesOnline, ConditionUnknown, "NoDriveData", "no drive reported its state"))
+	case summary.FailedDrives > 0:
+		conditions = append(conditions, condition(ConditionDrivesOnline, ConditionFalse, "DrivesFailed",
+			fmt.Sprintf("%d of %d drives failed", summary.FailedDrives, summary.TotalDrives)))
+	default:
+		conditions = append(conditions, condition(ConditionDrivesOnline, ConditionTrue, "AllDrivesOnline",
+			fmt.Sprintf("%d drives online", summary.TotalDrives)))
+	}
+
+	switch free := summary.MinFreeSpacePercent; {
+	case !summary.CapacityKnown:
+		conditions = append(conditions, condition(ConditionSpaceAvailable, ConditionUnknown, "NoCapacityData", "no drive reported its capacity"))
+	case free < healthFreeSpaceYellowPercent:
+		conditions = append(conditions, condition(ConditionSpaceAvailable, ConditionFalse, "LowFreeSpace",
+			fmt.Sprintf("a drive has only %.1f%% free space", free)))
+	default:
+		conditions = append(conditions, condition(ConditionSpaceAvailable, ConditionTrue, "SufficientFreeSpace",
+			fmt.Sprintf("all drives have at least %.1f%% free space", free)))
+	}
+
+	if len(summary.ConfigWarnings) > 0 {
+		conditions = append(conditions, condition(ConditionConfigValid, ConditionFalse, "ConfigWarnings", strings.Join(summary.ConfigWarnings, "; ")))
+	} else {
+		conditions = append(conditions, condition(ConditionConfigValid, ConditionTrue, "NoConfigWarnings", "no config warnings"))
+	}
+	return conditions
+}
+
+// MergeConditions - returns updated with the transition times of the
+// conditions of existing whose status did not change, as Kubernetes
+// expects of lastTransitionTime. Conditions of existing missing in
+// updated are dropped.
+func MergeConditions(existing, updated []StatusCondition) []StatusCondition {
+	merged := make([]StatusCondition, len(updated))
+	copy(merged, updated)
+	for i, c := range merged {
+		for _, old := range existing {
+			if old.Type == c.Type && old.Status == c.Status && !old.LastTransitionTime.IsZero() {
+				merged[i].LastTransitionTime = old.LastTransitionTime
+				break
+			}
+		}
+	}
+	return merged
+}
@@ -0,0 +1,101 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConvertToConditions(t *testing.T) {
+	summary := ClusterHealthSummary{
+		Status:              HealthStatusRed,
+		Reasons:             []string{"1 of 4 nodes offline", "2 of 16 drives failed"},
+		TotalNodes:          4,
+		OnlineNodes:         3,
+		TotalDrives:         16,
+		FailedDrives:        2,
+		MinFreeSpacePercent: 42.5,
+		CapacityKnown:       true,
+	}
+	now := time.Date(2021, 6, 1, 12, 0, 0, 500, time.UTC)
+	clock := ClockFunc(func() time.Time { return now })
+	conditions := ConvertToConditions(summary, clock)
+	expected := map[string]struct {
+		status          ConditionStatus
+		reason, message string
+	}{
+		ConditionHealthy:        {ConditionFalse, "ClusterUnhealthy", "1 of 4 nodes offline; 2 of 16 drives failed"},
+		ConditionNodesOnline:    {ConditionFalse, "NodesOffline", "1 of 4 nodes offline"},
+		ConditionDrivesOnline:   {ConditionFalse, "DrivesFailed", "2 of 16 drives failed"},
+		ConditionSpaceAvailable: {ConditionTrue, "SufficientFreeSpace", "all drives have at least 42.5% free space"},
+		ConditionConfigValid:    {ConditionTrue, "NoConfigWarnings", "no config warnings"},
+	}
+	if len(conditions) != len(expected) {
+		t.Fatalf("expected %d conditions, got %d", len(expected), len(conditions))
+	}
+	for _, c := range conditions {
+		e, ok := expected[c.Type]
+		if !ok || c.Status != e.status || c.Reason != e.reason || c.Message != e.message || !c.LastTransitionTime.Equal(now.Truncate(time.Second)) {
+			t.Errorf("unexpected condition %+v", c)
+		}
+	}
+
+	data, err := json.Marshal(conditions[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, field := range []string{`"type":"Healthy"`, `"status":"False"`, `"reason":"ClusterUnhealthy"`, `"lastTransitionTime":"`} {
+		if !strings.Contains(string(data), field) {
+			t.Errorf("expected %s in %s", field, data)
+		}
+	}
+
+	// Only conditions which changed status get a new transition time.
+	past := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	existing := ConvertToConditions(summary, clock)
+	for i := range existing {
+		existing[i].LastTransitionTime = past
+	}
+	summary.Status, summary.Reasons, summary.OnlineNodes = HealthStatusYellow, []string{"2 of 16 drives failed"}, 4
+	merged := MergeConditions(existing, ConvertToConditions(summary, clock))
+	for _, c := range merged {
+		changed := c.Type == ConditionNodesOnline
+		if changed == c.LastTransitionTime.Equal(past) {
+			t.Errorf("unexpected transition time of %s: %v", c.Type, c.LastTransitionTime)
+		}
+	}
+	if merged[0].Reason != "ClusterDegraded" || merged[1].Status != ConditionTrue {
+		t.Errorf("expected the updated conditions, got %+v", merged[:2])
+	}
+
+	if c := ConvertToConditions(ClusterHealthSummary{}, nil); c[0].Status != ConditionUnknown || c[1].Status != ConditionUnknown || c[3].Status != ConditionUnknown {
+		t.Errorf("expected unknown conditions for an empty summary, got %+v", c)
+	}
+
+	// A full drive is known to have no space left.
+	var info HealthInfo
+	info.Minio.Info.Servers = []ServerProperties{{
+		State: "online",
+		Disks: []Disk{{State: DriveStateOk, TotalSpace: 100 << 30, AvailableSpace: 0}},
+	}}
+	if c := ConvertToConditions(info.Summarize(), nil); c[3].Status != ConditionFalse || c[3].Reason != "LowFreeSpace" {
+		t.Errorf("expected no space available for a full drive, got %+v", c[3])
+	}
+}
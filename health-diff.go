@@ -0,0 +1,221 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// HealthCapacityDelta - change of the drives of a node.
+type HealthCapacityDelta struct {
+	Node         string   `json:"node"`
+	DrivesBefore int      `json:"drivesBefore"`
+	DrivesAfter  int      `json:"drivesAfter"`
+	TotalBefore  ByteSize `json:"totalBefore"`
+	TotalAfter   ByteSize `json:"totalAfter"`
+	UsedBefore   ByteSize `json:"usedBefore"`
+	UsedAfter    ByteSize `json:"usedAfter"`
+}
+
+// HealthConfigChange - a changed server configuration value, Before or
+// After is empty if the value was added or removed.
+type HealthConfigChange struct {
+	Path   string `json:"path"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// HealthErrorChange - an error which appeared or disappeared.
+type HealthErrorChange struct {
+	Section string `json:"section"`
+	HealthNodeError
+}
+
+// HealthInfoDiff - delta between two health reports.
+type HealthInfoDiff struct {
+	AddedNodes   []string `json:"addedNodes,omitempty"`
+	RemovedNodes []string `json:"removedNodes,omitempty"`
+	// Capacity of nodes present in both reports whose drives changed.
+	Capacity    []HealthCapacityDelta `json:"capacity,omitempty"`
+	ConfigDrift []HealthConfigChange  `json:"configDrift,omitempty"`
+	// NewErrors are only in the second report, ResolvedErrors only in
+	// the first one.
+	NewErrors      []HealthErrorChange `json:"newErrors,omitempty"`
+	ResolvedErrors []HealthErrorChange `json:"resolvedErrors,omitempty"`
+}
+
+// Empty - returns true if the reports do not differ.
+func (d HealthInfoDiff) Empty() bool {
+	return len(d.AddedNodes) == 0 && len(d.RemovedNodes) == 0 && len(d.Capacity) == 0 &&
+		len(d.ConfigDrift) == 0 && len(d.NewErrors) == 0 && len(d.ResolvedErrors) == 0
+}
+
+// DiffHealthInfo - compares two health reports of a cluster, i.e.
+// support bundles taken before and after an incident.
+func DiffHealthInfo(before, after HealthInfo) HealthInfoDiff {
+	var diff HealthInfoDiff
+
+	b, a := healthCapacity(before), healthCapacity(after)
+	for node, ac := range a {
+		bc, ok := b[node]
+		if !ok {
+			diff.AddedNodes = append(diff.AddedNodes, node)
+			continue
+		}
+		if bc != ac {
+			diff.Capacity = append(diff.Capacity, HealthCapacityDelta{
+				Node:         node,
+				DrivesBefore: bc.drives,
+				DrivesAfter:  ac.drives,
+				TotalBefore:  bc.total,
+				TotalAfter:   ac.total,
+				UsedBefore:   bc.used,
+				UsedAfter:    ac.used,
+			})
+		}
+	}
+	for node := range b {
+		if _, ok := a[node]; !ok {
+			diff.RemovedNodes = append(diff.RemovedNodes, node)
+		}
+	}
+	sort.Strings(diff.AddedNodes)
+	sort.Strings(diff.RemovedNodes)
+	sort.Slice(diff.Capacity, func(i, j int) bool { return diff.Capacity[i].Node < diff.Capacity[j].Node })
+
+	bcfg, acfg := flattenConfig(before.Minio.Config.Config), flattenConfig(after.Minio.Config.Config)
+	for path, av := range acfg {
+		if bv, ok := bcfg[path]; !ok || bv != av {
+			diff.ConfigDrift = append(diff.ConfigDrift, HealthConfigChange{Path: path, Before: bv, After: av})
+		}
+	}
+	for path, bv := range bcfg {
+		if _, ok := acfg[path]; !ok {
+			diff.ConfigDrift = append(diff.ConfigDrift, HealthConfigChange{Path: path, Before: bv})
+		}
+	}
+	sort.Slice(diff.ConfigDrift, func(i, j int) bool { return diff.ConfigDrift[i].Path < diff.ConfigDrift[j].Path })
+
+	berrs, aerrs := healthErrorSet(before), healthErrorSet(after)
+	for key, e := range aerrs {
+		if _, ok := berrs[key]; !ok {
+			diff.NewErrors = append(diff.NewErrors, e)
+		}
+	}
+	for key, e := range berrs {
+		if _, ok := aerrs[key]; !ok {
+			diff.ResolvedErrors = append(diff.ResolvedErrors, e)
+		}
+	}
+	sortHealthErrorChanges(diff.NewErrors)
+	sortHealthErrorChanges(diff.ResolvedErrors)
+	return diff
+}
+
+type healthNodeCapacity struct {
+	drives      int
+	total, used ByteSize
+}
+
+// healthCapacity - returns the drive capacity of every server.
+func healthCapacity(info HealthInfo) map[string]healthNodeCapacity {
+	nodes := make(map[string]healthNodeCapacity)
+	for _, s := range info.Minio.Info.Servers {
+		c := healthNodeCapacity{drives: len(s.Disks)}
+		for _, d := range s.Disks {
+			c.total += ByteSize(d.TotalSpace)
+			c.used += ByteSize(d.UsedSpace)
+		}
+		nodes[s.Endpoint] = c
+	}
+	return nodes
+}
+
+// flattenConfig - returns the leaves of a configuration tree by path,
+// i.e. "api.requests_max".
+func flattenConfig(config interface{}) map[string]string {
+	leaves := make(map[string]string)
+	if config == nil {
+		return leaves
+	}
+	// Normalize typed configurations to a JSON tree.
+	var tree interface{}
+	if data, err := json.Marshal(config); err != nil || json.Unmarshal(data, &tree) != nil {
+		return leaves
+	}
+	var walk func(prefix string, v interface{})
+	walk = func(prefix string, v interface{}) {
+		join := func(key string) string {
+			if prefix == "" {
+				return key
+			}
+			return prefix + "." + key
+		}
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, child := range v {
+				walk(join(k), child)
+			}
+		case []interface{}:
+			for i, child := range v {
+				walk(join(strconv.Itoa(i)), child)
+			}
+		case nil:
+			leaves[prefix] = "null"
+		default:
+			leaves[prefix] = fmt.Sprint(v)
+		}
+	}
+	walk("", tree)
+	return leaves
+}
+
+// healthErrorSet - returns the errors of a report by section, node and
+// message.
+func healthErrorSet(info HealthInfo) map[string]HealthErrorChange {
+	errs := make(map[string]HealthErrorChange)
+	add := func(section string, e HealthNodeError) {
+		errs[section+"\x00"+e.Addr+"\x00"+e.Message] = HealthErrorChange{Section: section, HealthNodeError: e}
+	}
+	for section, nodeErrs := range info.ToV2().Errors() {
+		for _, e := range nodeErrs {
+			add(section, e)
+		}
+	}
+	if info.Error != "" {
+		add("report", newHealthNodeError("", info.Error, ""))
+	}
+	if info.Minio.Error != "" {
+		add("minio", newHealthNodeError("", info.Minio.Error, ""))
+	}
+	return errs
+}
+
+func sortHealthErrorChanges(changes []HealthErrorChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Section != changes[j].Section {
+			return changes[i].Section < changes[j].Section
+		}
+		if changes[i].Addr != changes[j].Addr {
+			return changes[i].Addr < changes[j].Addr
+		}
+		return changes[i].Message < changes[j].Message
+	})
+}
@@ -0,0 +1,74 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffHealthInfo(t *testing.T) {
+	var before, after HealthInfo
+	before.Minio.Info.Servers = []ServerProperties{
+		{Endpoint: "node1:9000", Disks: []Disk{{TotalSpace: 100, UsedSpace: 10}, {TotalSpace: 100, UsedSpace: 20}}},
+		{Endpoint: "node2:9000", Disks: []Disk{{TotalSpace: 100}}},
+	}
+	after.Minio.Info.Servers = []ServerProperties{
+		{Endpoint: "node1:9000", Disks: []Disk{{TotalSpace: 100, UsedSpace: 50}}},
+		{Endpoint: "node3:9000", Disks: []Disk{{TotalSpace: 100}}},
+	}
+	before.Minio.Config.Config = map[string]interface{}{
+		"api":   map[string]interface{}{"requests_max": "0", "cors": "*"},
+		"heal":  map[string]interface{}{"bitrotscan": "off"},
+		"sites": []interface{}{"a"},
+	}
+	after.Minio.Config.Config = map[string]interface{}{
+		"api":   map[string]interface{}{"requests_max": "1600", "cors": "*"},
+		"sites": []interface{}{"a", "b"},
+	}
+	before.Sys.CPUInfo = []CPUs{{Addr: "node2:9000", Error: "timeout"}}
+	after.Sys.MemInfo = []MemInfo{{Addr: "node1:9000", Error: "permission denied"}}
+
+	diff := DiffHealthInfo(before, after)
+	if !reflect.DeepEqual(diff.AddedNodes, []string{"node3:9000"}) || !reflect.DeepEqual(diff.RemovedNodes, []string{"node2:9000"}) {
+		t.Errorf("unexpected nodes added %v, removed %v", diff.AddedNodes, diff.RemovedNodes)
+	}
+	expectedCapacity := []HealthCapacityDelta{{
+		Node: "node1:9000", DrivesBefore: 2, DrivesAfter: 1,
+		TotalBefore: 200, TotalAfter: 100, UsedBefore: 30, UsedAfter: 50,
+	}}
+	if !reflect.DeepEqual(diff.Capacity, expectedCapacity) {
+		t.Errorf("unexpected capacity %+v", diff.Capacity)
+	}
+	expectedDrift := []HealthConfigChange{
+		{Path: "api.requests_max", Before: "0", After: "1600"},
+		{Path: "heal.bitrotscan", Before: "off"},
+		{Path: "sites.1", After: "b"},
+	}
+	if !reflect.DeepEqual(diff.ConfigDrift, expectedDrift) {
+		t.Errorf("unexpected config drift %+v", diff.ConfigDrift)
+	}
+	if len(diff.NewErrors) != 1 || diff.NewErrors[0].Section != "mem" || diff.NewErrors[0].Code != ErrPermissionDenied {
+		t.Errorf("unexpected new errors %+v", diff.NewErrors)
+	}
+	if len(diff.ResolvedErrors) != 1 || diff.ResolvedErrors[0].Section != "cpu" {
+		t.Errorf("unexpected resolved errors %+v", diff.ResolvedErrors)
+	}
+	if diff.Empty() || !DiffHealthInfo(after, after).Empty() {
+		t.Error("unexpected Empty result")
+	}
+}
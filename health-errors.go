@@ -0,0 +1,68 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+)
+
+// HealthErrorCode - classifies the error of a health data collector.
+type HealthErrorCode string
+
+// Health data collector error codes.
+const (
+	// ErrUnsupportedOS - the collector does not support the OS.
+	ErrUnsupportedOS HealthErrorCode = "unsupported-os"
+	// ErrPermissionDenied - the server lacks privileges to collect.
+	ErrPermissionDenied HealthErrorCode = "permission-denied"
+	// ErrTimeout - the collection exceeded its deadline.
+	ErrTimeout HealthErrorCode = "timeout"
+	// ErrCollectorPanic - the collector panicked.
+	ErrCollectorPanic HealthErrorCode = "collector-panic"
+	// ErrHealthUnknown - any other error.
+	ErrHealthUnknown HealthErrorCode = "unknown"
+)
+
+// healthErrorCodeOf - classifies a collector error.
+func healthErrorCodeOf(err error) HealthErrorCode {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, os.ErrPermission):
+		return ErrPermissionDenied
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return ErrTimeout
+	}
+	return healthErrorCode(err.Error())
+}
+
+// finishHealthCollector - deferred by collectors, reports a panic as
+// ErrCollectorPanic and classifies errors which lack a code.
+func finishHealthCollector(addr string, addrField, errField *string, code *HealthErrorCode) {
+	if r := recover(); r != nil {
+		*addrField = addr
+		*errField = fmt.Sprintf("collector panic: %v", r)
+		*code = ErrCollectorPanic
+		return
+	}
+	if *errField != "" && *code == "" {
+		*code = healthErrorCode(*errField)
+	}
+}
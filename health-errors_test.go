@@ -0,0 +1,53 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+)
+
+func TestHealthErrorCodes(t *testing.T) {
+	for err, want := range map[error]HealthErrorCode{
+		&os.PathError{Op: "open", Path: "/proc/1/smaps", Err: os.ErrPermission}: ErrPermissionDenied,
+		fmt.Errorf("sensors: %w", context.DeadlineExceeded):                     ErrTimeout,
+		fmt.Errorf("unsupported operating system plan9"):                        ErrUnsupportedOS,
+		fmt.Errorf("no such file or directory"):                                 ErrHealthUnknown,
+	} {
+		if got := healthErrorCodeOf(err); got != want {
+			t.Errorf("%v: expected %s, got %s", err, want, got)
+		}
+	}
+
+	collect := func() (result CPUs) {
+		defer finishHealthCollector("node1", &result.Addr, &result.Error, &result.ErrorCode)
+		var cpus []CPU
+		result.CPUs = append(result.CPUs, cpus[1])
+		return result
+	}
+	if result := collect(); result.Addr != "node1" || result.ErrorCode != ErrCollectorPanic || result.Error == "" {
+		t.Errorf("unexpected result of a panicking collector %+v", result)
+	}
+
+	info := HealthInfo{}
+	info.Sys.CPUInfo = []CPUs{{Addr: "node1", Error: "boom", ErrorCode: ErrCollectorPanic}}
+	if errs := info.ToV2().CPU.Errors; len(errs) != 1 || errs[0].Code != ErrCollectorPanic {
+		t.Errorf("unexpected v2 errors %+v", errs)
+	}
+}
@@ -0,0 +1,384 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+// Units of health report fields.
+const (
+	UnitBytes          = "bytes"
+	UnitBytesPerSecond = "bytes/s"
+	UnitKiB            = "KiB"
+	UnitMHz            = "MHz"
+	UnitPercent        = "percent"
+	UnitSeconds        = "seconds"
+	UnitUnixMillis     = "unix-ms"
+	UnitCount          = "count"
+	UnitOpsPerSecond   = "ops/s"
+	UnitWatts          = "watts"
+)
+
+// HealthFieldDoc - documentation of a HealthInfo field.
+type HealthFieldDoc struct {
+	// Path of the field using its JSON names, "[]" marks arrays,
+	// e.g. "sys.cpus[].cpus[].mhz".
+	Path        string `json:"path"`
+	Description string `json:"description"`
+	Unit        string `json:"unit,omitempty"`
+}
+
+type healthFieldDocs []HealthFieldDoc
+
+func (d *healthFieldDocs) add(path, description, unit string) {
+	*d = append(*d, HealthFieldDoc{Path: path, Description: description, Unit: unit})
+}
+
+// node - documents the address and error fields every per-node
+// section carries.
+func (d *healthFieldDocs) node(prefix, what string) {
+	d.add(prefix+".addr", "address of the node "+what+" was collected from", "")
+	d.add(prefix+".error", "error collecting "+what+" on the node, if any", "")
+}
+
+// cert - documents the fields of a TLSCertInfo.
+func (d *healthFieldDocs) cert(prefix, what string) {
+	d.add(prefix+".subject", "subject of the "+what, "")
+	d.add(prefix+".issuer", "issuer of the "+what, "")
+	d.add(prefix+".not_before", "start of the validity of the "+what, "")
+	d.add(prefix+".not_after", "expiry of the "+what, "")
+	d.add(prefix+".is_ca", "the "+what+" is a CA", "")
+	d.add(prefix+".fingerprint", "SHA-256 fingerprint of the "+what, "")
+}
+
+// perf - documents latency and throughput statistics.
+func (d *healthFieldDocs) perf(prefix, what string) {
+	for _, stat := range []struct{ name, desc string }{
+		{"avg", "average"},
+		{"max", "maximum"},
+		{"min", "minimum"},
+		{"percentile_50", "50th percentile"},
+		{"percentile_90", "90th percentile"},
+		{"percentile_99", "99th percentile"},
+	} {
+		d.add(prefix+".latency."+stat.name, stat.desc+" "+what+" latency", UnitSeconds)
+		d.add(prefix+".throughput."+stat.name, stat.desc+" "+what+" throughput", UnitBytesPerSecond)
+	}
+}
+
+// percentiles - documents requested percentiles and histograms.
+func (d *healthFieldDocs) percentiles(prefix, what string) {
+	d.add(prefix+".percentiles[].percentile", "requested percentile, between 0 and 1", "")
+	d.add(prefix+".percentiles[].latency", "percentile of the "+what+" latency", UnitSeconds)
+	d.add(prefix+".percentiles[].throughput", "percentile of the "+what+" throughput", UnitBytesPerSecond)
+	d.add(prefix+".histogram.bounds", "upper bounds of the "+what+" latency buckets", UnitSeconds)
+	d.add(prefix+".histogram.counts", "samples per "+what+" latency bucket", UnitCount)
+}
+
+func buildHealthFieldDocs() []HealthFieldDoc {
+	var d healthFieldDocs
+	d.add("version", "version of the health report format", "")
+	d.add("error", "error which aborted the collection, if any", "")
+	d.add("timestamp", "time the report was collected", "")
+
+	d.node("sys.cpus[]", "CPU information")
+	d.add("sys.cpus[].error_code", "class of the error: unsupported-os, permission-denied, timeout, collector-panic or unknown", "")
+	d.add("sys.cpus[].cpus[].vendor_id", "CPU vendor", "")
+	d.add("sys.cpus[].cpus[].family", "CPU family", "")
+	d.add("sys.cpus[].cpus[].model", "CPU model number", "")
+	d.add("sys.cpus[].cpus[].stepping", "CPU stepping", "")
+	d.add("sys.cpus[].cpus[].physical_id", "physical socket ID", "")
+	d.add("sys.cpus[].cpus[].model_name", "CPU model name", "")
+	d.add("sys.cpus[].cpus[].mhz", "clock speed", UnitMHz)
+	d.add("sys.cpus[].cpus[].cache_size", "cache size", UnitKiB)
+	d.add("sys.cpus[].cpus[].flags", "CPU feature flags", "")
+	d.add("sys.cpus[].cpus[].microcode", "microcode version", "")
+	d.add("sys.cpus[].cpus[].cores", "number of cores in the socket", UnitCount)
+	d.add("sys.cpus[].cpus[].core_ids", "IDs of the physical cores of the socket", "")
+	d.add("sys.cpus[].logical_cpus[].id", "logical CPU number", "")
+	d.add("sys.cpus[].logical_cpus[].physical_id", "socket of the logical CPU", "")
+	d.add("sys.cpus[].logical_cpus[].core_id", "physical core of the logical CPU", "")
+	d.add("sys.cpus[].logical_cpus[].numa_node", "NUMA node of the logical CPU, -1 if unknown", "")
+	d.add("sys.cpus[].logical_cpus[].governor", "CPU frequency scaling governor", "")
+	d.add("sys.cpus[].logical_cpus[].model_name", "CPU model name of the logical CPU", "")
+	d.add("sys.cpus[].logical_cpus[].capacity", "relative capacity of the core on heterogeneous systems", "")
+	d.add("sys.cpus[].logical_cpus[].core_class", "performance or efficiency core on heterogeneous systems", "")
+	d.add("sys.cpus[].numa_nodes[].id", "NUMA node number", "")
+	d.add("sys.cpus[].numa_nodes[].cpus", "logical CPUs of the NUMA node", "")
+	d.add("sys.cpus[].numa_nodes[].mem_total", "memory attached to the NUMA node", UnitBytes)
+
+	d.node("sys.partitions[]", "partitions")
+	d.add("sys.partitions[].error_code", "class of the error: unsupported-os, permission-denied, timeout, collector-panic or unknown", "")
+	d.add("sys.partitions[].partitions[].error", "error reading the partition usage, if any", "")
+	d.add("sys.partitions[].partitions[].device", "block device", "")
+	d.add("sys.partitions[].partitions[].mountpoint", "mount point", "")
+	d.add("sys.partitions[].partitions[].fs_type", "filesystem type", "")
+	d.add("sys.partitions[].partitions[].mount_options", "comma separated mount options, deprecated in favour of mount_options_list", "")
+	d.add("sys.partitions[].partitions[].mount_options_list", "mount options", "")
+	d.add("sys.partitions[].partitions[].mount_fs_type", "filesystem type reported by statfs", "")
+	d.add("sys.partitions[].partitions[].space_total", "total space", UnitBytes)
+	d.add("sys.partitions[].partitions[].space_free", "free space", UnitBytes)
+	d.add("sys.partitions[].partitions[].inode_total", "total inodes", UnitCount)
+	d.add("sys.partitions[].partitions[].inode_free", "free inodes", UnitCount)
+	d.add("sys.partitions[].partitions[].is_rotational", "whether the drive is an HDD rather than an SSD or NVMe drive", "")
+	d.add("sys.partitions[].partitions[].scheduler", "I/O scheduler of the block device", "")
+	d.add("sys.partitions[].partitions[].model", "block device model", "")
+	d.add("sys.partitions[].partitions[].serial", "block device serial number", "")
+	d.add("sys.partitions[].partitions[].discard", "whether the block device supports discard (TRIM)", "")
+
+	d.node("sys.nethw[]", "network interface information")
+	d.add("sys.nethw[].interfaces[].name", "interface name", "")
+	d.add("sys.nethw[].interfaces[].error", "error reading the interface, if any", "")
+	d.add("sys.nethw[].interfaces[].model", "PCI vendor and device ID of the NIC", "")
+	d.add("sys.nethw[].interfaces[].driver", "kernel driver of the NIC", "")
+	d.add("sys.nethw[].interfaces[].mac", "hardware address", "")
+	d.add("sys.nethw[].interfaces[].state", "operational state, e.g. up or down", "")
+	d.add("sys.nethw[].interfaces[].speed", "negotiated link speed in Mbit/s", "")
+	d.add("sys.nethw[].interfaces[].mtu", "maximum transmission unit", UnitBytes)
+	d.add("sys.nethw[].interfaces[].rx_ring", "receive ring buffer size", UnitCount)
+	d.add("sys.nethw[].interfaces[].tx_ring", "transmit ring buffer size", UnitCount)
+	d.add("sys.nethw[].interfaces[].rx_ring_max", "maximum receive ring buffer size", UnitCount)
+	d.add("sys.nethw[].interfaces[].tx_ring_max", "maximum transmit ring buffer size", UnitCount)
+	d.add("sys.nethw[].interfaces[].bond.mode", "bonding mode, e.g. 802.3ad", "")
+	d.add("sys.nethw[].interfaces[].bond.slaves", "interfaces of the bond", "")
+	d.add("sys.nethw[].interfaces[].bond.driver", "bonding or team", "")
+	d.add("sys.nethw[].interfaces[].bond.mii_status", "link status of the bond", "")
+	d.add("sys.nethw[].interfaces[].bond.active_slave", "active interface of active-backup bonds", "")
+	d.add("sys.nethw[].interfaces[].bond.lacp_rate", "LACP rate, slow or fast", "")
+	d.add("sys.nethw[].interfaces[].bond.aggregator.id", "ID of the active LACP aggregator", "")
+	d.add("sys.nethw[].interfaces[].bond.aggregator.ports", "ports of the active LACP aggregator", UnitCount)
+	d.add("sys.nethw[].interfaces[].bond.aggregator.actor_key", "LACP key of the node", "")
+	d.add("sys.nethw[].interfaces[].bond.aggregator.partner_key", "LACP key of the switch", "")
+	d.add("sys.nethw[].interfaces[].bond.aggregator.partner_mac", "system MAC address of the switch", "")
+	d.add("sys.nethw[].interfaces[].bond.slave_status[].name", "interface name", "")
+	d.add("sys.nethw[].interfaces[].bond.slave_status[].mii_status", "link status of the interface", "")
+	d.add("sys.nethw[].interfaces[].bond.slave_status[].speed", "link speed in Mbit/s", "")
+	d.add("sys.nethw[].interfaces[].bond.slave_status[].duplex", "link duplex", "")
+	d.add("sys.nethw[].interfaces[].bond.slave_status[].link_failures", "link losses since the interface joined the bond", UnitCount)
+	d.add("sys.nethw[].interfaces[].bond.slave_status[].aggregator_id", "LACP aggregator the interface joined", "")
+	d.add("sys.nethw[].interfaces[].bond.slave_status[].actor_churn_state", "LACP churn state of the node, churned if negotiation failed", "")
+	d.add("sys.nethw[].interfaces[].bond.slave_status[].partner_churn_state", "LACP churn state of the switch, churned if negotiation failed", "")
+	d.add("sys.nethw[].interfaces[].master", "bond the interface belongs to", "")
+	d.add("sys.nethw[].interfaces[].rx_errors", "receive errors", UnitCount)
+	d.add("sys.nethw[].interfaces[].tx_errors", "transmit errors", UnitCount)
+	d.add("sys.nethw[].interfaces[].rx_dropped", "dropped received packets", UnitCount)
+	d.add("sys.nethw[].interfaces[].tx_dropped", "dropped transmitted packets", UnitCount)
+
+	d.node("sys.drives_smart[]", "SMART information")
+	d.add("sys.drives_smart[].drives[].device", "block device", "")
+	d.add("sys.drives_smart[].drives[].error", "error reading SMART data of the drive, if any", "")
+	d.add("sys.drives_smart[].drives[].model", "drive model", "")
+	d.add("sys.drives_smart[].drives[].serial", "drive serial number", "")
+	d.add("sys.drives_smart[].drives[].passed", "overall SMART health self-assessment passed", "")
+	d.add("sys.drives_smart[].drives[].reallocated_sectors", "sectors remapped to spare sectors", UnitCount)
+	d.add("sys.drives_smart[].drives[].pending_sectors", "unstable sectors waiting to be remapped", UnitCount)
+	d.add("sys.drives_smart[].drives[].media_errors", "unrecovered NVMe data integrity errors", UnitCount)
+	d.add("sys.drives_smart[].drives[].wear_level", "share of the rated SSD endurance used", UnitPercent)
+	d.add("sys.drives_smart[].drives[].temperature", "drive temperature in degrees Celsius", "")
+	d.add("sys.drives_smart[].drives[].power_on_hours", "hours the drive was powered on", UnitCount)
+
+	d.node("sys.osinfo[]", "operating system information")
+	d.add("sys.osinfo[].error_code", "class of the error: unsupported-os, permission-denied, timeout, collector-panic or unknown", "")
+	d.add("sys.osinfo[].info", "host information: hostname, OS, platform and kernel versions, uptime", "")
+	d.add("sys.osinfo[].sensors", "temperature sensor readings", "")
+	d.add("sys.osinfo[].power[].source", "source of the power reading: rapl or ipmi", "")
+	d.add("sys.osinfo[].power[].name", "RAPL domain or BMC sensor", "")
+	d.add("sys.osinfo[].power[].watts", "power draw", UnitWatts)
+	d.add("sys.osinfo[].thermal_throttles", "CPU thermal throttling events since boot", UnitCount)
+
+	d.node("sys.meminfo[]", "memory information")
+	d.add("sys.meminfo[].error_code", "class of the error: unsupported-os, permission-denied, timeout, collector-panic or unknown", "")
+	d.add("sys.meminfo[].total", "total RAM", UnitBytes)
+	d.add("sys.meminfo[].available", "RAM available to new processes", UnitBytes)
+	d.add("sys.meminfo[].swap_space_total", "total swap space", UnitBytes)
+	d.add("sys.meminfo[].swap_space_free", "free swap space", UnitBytes)
+
+	d.node("sys.procinfo[]", "MinIO process information")
+	d.add("sys.procinfo[].error_code", "class of the error: unsupported-os, permission-denied, timeout, collector-panic or unknown", "")
+	d.add("sys.procinfo[].errors", "errors of fields which could not be collected, by field name", "")
+	d.add("sys.procinfo[].pid", "process ID", "")
+	d.add("sys.procinfo[].is_background", "whether the process runs in the background", "")
+	d.add("sys.procinfo[].cpu_percent", "CPU usage since the process started", UnitPercent)
+	d.add("sys.procinfo[].children_pids", "process IDs of child processes", "")
+	d.add("sys.procinfo[].cmd_line", "command line", "")
+	d.add("sys.procinfo[].num_connections", "open network connections", UnitCount)
+	d.add("sys.procinfo[].create_time", "process start time", UnitUnixMillis)
+	d.add("sys.procinfo[].cwd", "working directory", "")
+	d.add("sys.procinfo[].exec_path", "path of the executable", "")
+	d.add("sys.procinfo[].gids", "group IDs", "")
+	d.add("sys.procinfo[].iocounters", "disk I/O counters", "")
+	d.add("sys.procinfo[].net_iocounters", "network I/O counters per interface", "")
+	d.add("sys.procinfo[].is_running", "whether the process is running", "")
+	d.add("sys.procinfo[].mem_info", "resident and virtual memory", UnitBytes)
+	d.add("sys.procinfo[].mem_maps", "memory mappings", "")
+	d.add("sys.procinfo[].mem_percent", "share of total RAM used", UnitPercent)
+	d.add("sys.procinfo[].name", "process name", "")
+	d.add("sys.procinfo[].nice", "nice value", "")
+	d.add("sys.procinfo[].num_ctx_switches", "voluntary and involuntary context switches", UnitCount)
+	d.add("sys.procinfo[].num_fds", "open file descriptors", UnitCount)
+	d.add("sys.procinfo[].num_threads", "OS threads", UnitCount)
+	d.add("sys.procinfo[].page_faults", "minor and major page faults", UnitCount)
+	d.add("sys.procinfo[].ppid", "parent process ID", "")
+	d.add("sys.procinfo[].status", "process state", "")
+	d.add("sys.procinfo[].tgid", "thread group ID", "")
+	d.add("sys.procinfo[].times", "CPU time spent by the process", UnitSeconds)
+	d.add("sys.procinfo[].uids", "user IDs", "")
+	d.add("sys.procinfo[].username", "user running the process", "")
+	d.add("sys.procinfo[].rlimit", "resource limits and current usage", "")
+
+	d.node("sys.parallelfs[]", "parallel filesystem information")
+	d.add("sys.parallelfs[].mounts[].error", "error probing the mount, if any", "")
+	d.add("sys.parallelfs[].mounts[].mountpoint", "mount point", "")
+	d.add("sys.parallelfs[].mounts[].device", "device or filesystem name", "")
+	d.add("sys.parallelfs[].mounts[].fs_type", "gpfs, lustre or beegfs", "")
+	d.add("sys.parallelfs[].mounts[].healthy", "mount answered a statfs in time", "")
+	d.add("sys.parallelfs[].mounts[].acl", "POSIX ACLs are supported", "")
+	d.add("sys.parallelfs[].mounts[].byte_range_locks", "POSIX byte-range locks are supported", "")
+	d.add("sys.parallelfs[].mounts[].quota_error", "error reading the quota, if any", "")
+	d.add("sys.parallelfs[].mounts[].quota.used", "space used by the server user", UnitBytes)
+	d.add("sys.parallelfs[].mounts[].quota.limit", "hard space limit, zero if unlimited", UnitBytes)
+	d.add("sys.parallelfs[].mounts[].quota.files", "files owned by the server user", UnitCount)
+	d.add("sys.parallelfs[].mounts[].quota.file_limit", "hard file limit, zero if unlimited", UnitCount)
+
+	d.node("sys.nasmounts[]", "NAS mount validation")
+	d.add("sys.nasmounts[].mounts[].path", "drive path the tests ran in", "")
+	d.add("sys.nasmounts[].mounts[].mountpoint", "NFS or SMB mount point of the drive", "")
+	d.add("sys.nasmounts[].mounts[].fs_type", "filesystem type of the mount", "")
+	d.add("sys.nasmounts[].mounts[].checks[].name", "atomic-rename, o-direct, fcntl-locking or sparse-files", "")
+	d.add("sys.nasmounts[].mounts[].checks[].status", "pass or fail", "")
+	d.add("sys.nasmounts[].mounts[].checks[].message", "reason the check failed", "")
+
+	d.node("sys.services[]", "service states")
+	d.add("sys.services[].services[].name", "ntp, firewall or irqbalance", "")
+	d.add("sys.services[].services[].unit", "systemd unit providing the service, empty if none is installed", "")
+	d.add("sys.services[].services[].state", "active, inactive, failed or not-found", "")
+	d.add("sys.services[].services[].enabled", "unit is started at boot", "")
+	d.add("sys.services[].services[].error", "error querying the unit, if any", "")
+	d.add("sys.services[].selinux", "enforcing, permissive or disabled", "")
+
+	d.node("sys.kubernetes[]", "Kubernetes information")
+	d.add("sys.kubernetes[].in_kubernetes", "server runs in a Kubernetes pod", "")
+	d.add("sys.kubernetes[].pod_name", "name of the pod", "")
+	d.add("sys.kubernetes[].namespace", "namespace of the pod", "")
+	d.add("sys.kubernetes[].node_name", "Kubernetes node the pod is scheduled on", "")
+	d.add("sys.kubernetes[].cpu_request", "CPU request of the container", "")
+	d.add("sys.kubernetes[].cpu_limit", "CPU limit of the container", "")
+	d.add("sys.kubernetes[].memory_request", "memory request of the container", "")
+	d.add("sys.kubernetes[].memory_limit", "memory limit of the container", "")
+	d.add("sys.kubernetes[].cgroup_version", "cgroup version, 1 or 2", "")
+	d.add("sys.kubernetes[].cgroup_memory_limit", "memory limit of the cgroup, zero if unlimited", UnitBytes)
+	d.add("sys.kubernetes[].cgroup_cpu_limit", "CPUs the cgroup quota allows, zero if unlimited", UnitCount)
+
+	d.node("sys.timeinfo[]", "time synchronization information")
+	d.add("sys.timeinfo[].time", "local time of the node when collected", "")
+	d.add("sys.timeinfo[].ntp_synchronized", "kernel clock is disciplined by an NTP daemon", "")
+	d.add("sys.timeinfo[].ntp_offset", "offset of the kernel clock to its time source", UnitSeconds)
+	d.add("sys.timeinfo[].ntp_max_error", "maximum error of the kernel clock", UnitSeconds)
+	d.add("sys.timeinfo[].peers[].addr", "URL of the peer", "")
+	d.add("sys.timeinfo[].peers[].error", "error measuring the peer, if any", "")
+	d.add("sys.timeinfo[].peers[].offset", "peer clock minus the local clock, with a resolution of one second", UnitSeconds)
+	d.add("sys.timeinfo[].peers[].rtt", "round-trip time of the measurement", UnitSeconds)
+
+	d.node("sys.dualstack[]", "IPv4 and IPv6 connectivity information")
+	d.add("sys.dualstack[].ipv6_enabled", "IPv6 is enabled in the kernel", "")
+	d.add("sys.dualstack[].bindv6only", "IPv6 sockets do not accept IPv4 connections", "")
+	d.add("sys.dualstack[].ipv6_addrs", "global IPv6 addresses of the node", "")
+	d.add("sys.dualstack[].port", "port of the server", "")
+	d.add("sys.dualstack[].listeners[].family", "address family of the listening socket, ipv4 or ipv6", "")
+	d.add("sys.dualstack[].listeners[].addr", "address the socket is bound to", "")
+	d.add("sys.dualstack[].peers[].addr", "URL of the peer", "")
+	d.add("sys.dualstack[].peers[].error", "error resolving the peer, if any", "")
+	d.add("sys.dualstack[].peers[].probes[].family", "address family of the connection attempt, ipv4 or ipv6", "")
+	d.add("sys.dualstack[].peers[].probes[].ip", "address of the peer connected to", "")
+	d.add("sys.dualstack[].peers[].probes[].error", "error connecting, if any", "")
+	d.add("sys.dualstack[].peers[].probes[].rtt", "time to connect", UnitSeconds)
+
+	d.node("sys.tlstrust[]", "internode TLS trust")
+	d.add("sys.tlstrust[].time", "time the certificate chains were verified", "")
+	d.add("sys.tlstrust[].bundle_fingerprint", "identifies the CA bundle, empty for the system roots", "")
+	d.cert("sys.tlstrust[].ca_bundle[]", "certificate of the CA bundle")
+	d.add("sys.tlstrust[].peers[].addr", "URL of the peer", "")
+	d.add("sys.tlstrust[].peers[].error", "error of the TLS handshake, if any", "")
+	d.cert("sys.tlstrust[].peers[].chain[]", "certificate presented by the peer")
+	d.add("sys.tlstrust[].peers[].trusted", "the chain verifies against the CA bundle", "")
+	d.add("sys.tlstrust[].peers[].verify_error", "why the chain is not trusted", "")
+
+	for _, mode := range []string{"serial", "parallel"} {
+		prefix := "perf.drives[]." + mode + "_perf[]"
+		d.add(prefix+".error", "error measuring the drive, if any", "")
+		d.add(prefix+".path", "drive path", "")
+		d.add(prefix+".mode", "workload measured: seq-write, seq-read, rand-4k or mixed-70-30, empty for seq-write", "")
+		d.add(prefix+".iops", "IO operations per second of the random and mixed workloads", UnitOpsPerSecond)
+		d.perf(prefix, mode+" drive")
+		d.add(prefix+".latency_series.interval", "time covered by every row of the series", UnitSeconds)
+		d.add(prefix+".latency_series.bounds", "upper bounds of the latency buckets", UnitSeconds)
+		d.add(prefix+".latency_series.counts", "samples per latency bucket, one row per interval", UnitCount)
+		d.percentiles(prefix, mode+" drive")
+	}
+	d.node("perf.drives[]", "drive performance")
+
+	d.node("perf.net[]", "network performance")
+	d.node("perf.net[].remote_peers[]", "peer network performance")
+	d.perf("perf.net[].remote_peers[]", "network")
+	d.percentiles("perf.net[].remote_peers[]", "network")
+	d.node("perf.net_parallel", "parallel network performance")
+	d.node("perf.net_parallel.remote_peers[]", "peer network performance")
+	d.perf("perf.net_parallel.remote_peers[]", "parallel network")
+	d.percentiles("perf.net_parallel.remote_peers[]", "parallel network")
+
+	d.add("minio.error", "error collecting MinIO information, if any", "")
+	d.add("minio.config.error", "error reading the server configuration, if any", "")
+	d.add("minio.config.config", "server configuration with secrets removed", "")
+	d.add("minio.info", "server information as returned by ServerInfo", "")
+	d.add("minio.backend.error", "error collecting backend information, if any", "")
+	d.add("minio.backend.vendor", "vendor of a storage backend other than MinIO", "")
+	d.add("minio.backend.version", "version of the storage backend", "")
+	d.add("minio.backend.capabilities", "features supported by the storage backend", "")
+	d.add("minio.backend.kv", "vendor specific key/value pairs", "")
+
+	d.add("capabilities.error", "error probing the backend capabilities, if any", "")
+	for _, c := range []struct{ name, desc string }{
+		{"versioning", "object versioning"},
+		{"object_lock", "object locking"},
+		{"xattr", "extended attributes on all drives"},
+		{"case_sensitive", "case sensitive object names on all drives"},
+	} {
+		d.add("capabilities."+c.name+".supported", c.desc+" is supported", "")
+		d.add("capabilities."+c.name+".error", "why "+c.desc+" is not supported or could not be probed", "")
+	}
+	return d
+}
+
+var healthFields = buildHealthFieldDocs()
+
+var healthFieldsByPath = func() map[string]HealthFieldDoc {
+	m := make(map[string]HealthFieldDoc, len(healthFields))
+	for _, f := range healthFields {
+		m[f.Path] = f
+	}
+	return m
+}()
+
+// HealthFields - returns the documentation of all HealthInfo fields.
+func HealthFields() []HealthFieldDoc {
+	fields := make([]HealthFieldDoc, len(healthFields))
+	copy(fields, healthFields)
+	return fields
+}
+
+// LookupHealthField - returns the documentation of the field at path.
+func LookupHealthField(path string) (HealthFieldDoc, bool) {
+	f, ok := healthFieldsByPath[path]
+	return f, ok
+}
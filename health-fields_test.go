@@ -0,0 +1,75 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// healthFieldPaths - returns the documented leaf paths of typ, descending
+// into the structs of this package.
+func healthFieldPaths(t *testing.T, typ reflect.Type, prefix string, paths map[string]bool) {
+	leaf := prefix
+	for typ.Kind() == reflect.Slice || typ.Kind() == reflect.Ptr {
+		if typ.Kind() == reflect.Slice {
+			prefix += "[]"
+		}
+		typ = typ.Elem()
+	}
+	// Arrays of scalars and foreign types are documented as a whole.
+	if typ.Kind() != reflect.Struct || typ.PkgPath() != reflect.TypeOf(HealthInfo{}).PkgPath() ||
+		typ == reflect.TypeOf(time.Time{}) || typ == reflect.TypeOf(InfoMessage{}) {
+		paths[leaf] = true
+		return
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		if yaml := strings.Split(f.Tag.Get("yaml"), ",")[0]; yaml != name {
+			t.Errorf("%s.%s: yaml name %q does not match json name %q", typ.Name(), f.Name, yaml, name)
+		}
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		healthFieldPaths(t, f.Type, path, paths)
+	}
+}
+
+func TestHealthFieldsDocumented(t *testing.T) {
+	paths := make(map[string]bool)
+	healthFieldPaths(t, reflect.TypeOf(HealthInfo{}), "", paths)
+	for path := range paths {
+		if _, ok := LookupHealthField(path); !ok {
+			t.Errorf("field %s is not documented", path)
+		}
+	}
+	for _, f := range HealthFields() {
+		if !paths[f.Path] {
+			t.Errorf("documented field %s does not exist", f.Path)
+		}
+		if f.Description == "" {
+			t.Errorf("field %s has no description", f.Path)
+		}
+	}
+}
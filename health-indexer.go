@@ -0,0 +1,237 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HealthDocument is a flattened HealthInfo entry ready to be indexed,
+// nested objects are collapsed into dot separated keys. The fields of
+// the entry are kept under "data.", apart from the metadata of the
+// snapshot.
+type HealthDocument map[string]interface{}
+
+// HealthIndexerOptions - options for NewHealthIndexer.
+type HealthIndexerOptions struct {
+	// Endpoint of the Elasticsearch/OpenSearch cluster, e.g. https://search:9200
+	Endpoint string
+	// IndexPrefix defaults to "minio-health", daily indices named
+	// <prefix>-YYYY.MM.DD are written so ILM/ISM policies can roll them over.
+	IndexPrefix string
+	Username    string
+	Password    string
+	// Transport defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+}
+
+// HealthIndexer bulk-writes HealthInfo snapshots to Elasticsearch/OpenSearch.
+type HealthIndexer struct {
+	opts       HealthIndexerOptions
+	httpClient *http.Client
+}
+
+// NewHealthIndexer - returns a new health snapshot indexer.
+func NewHealthIndexer(opts HealthIndexerOptions) (*HealthIndexer, error) {
+	if opts.Endpoint == "" {
+		return nil, ErrInvalidArgument("Indexer endpoint cannot be empty.")
+	}
+	opts.Endpoint = strings.TrimSuffix(opts.Endpoint, "/")
+	if opts.IndexPrefix == "" {
+		opts.IndexPrefix = "minio-health"
+	}
+	if opts.Transport == nil {
+		opts.Transport = http.DefaultTransport
+	}
+	return &HealthIndexer{
+		opts:       opts,
+		httpClient: &http.Client{Transport: opts.Transport},
+	}, nil
+}
+
+// IndexName - returns the daily index name for the given time.
+func (h *HealthIndexer) IndexName(t time.Time) string {
+	return h.opts.IndexPrefix + "-" + t.UTC().Format("2006.01.02")
+}
+
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Status int `json:"status"`
+		Error  struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	} `json:"items"`
+}
+
+// Index - flattens info and writes all of its documents with a single
+// bulk request.
+func (h *HealthIndexer) Index(ctx context.Context, info HealthInfo) error {
+	// The documents are stamped with the time of the index they go to.
+	if info.TimeStamp.IsZero() {
+		info.TimeStamp = time.Now()
+	}
+	docs, err := FlattenHealthInfo(info)
+	if err != nil {
+		return err
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+
+	action, err := json.Marshal(map[string]interface{}{
+		"index": map[string]string{"_index": h.IndexName(info.TimeStamp)},
+	})
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, doc := range docs {
+		body.Write(action)
+		body.WriteByte('\n')
+		if err = enc.Encode(doc); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.opts.Endpoint+"/_bulk", &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if h.opts.Username != "" {
+		req.SetBasicAuth(h.opts.Username, h.opts.Password)
+	}
+
+	resp, err := h.httpClient.Do(req)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("bulk request failed with status %s", resp.Status)
+	}
+
+	var result bulkResponse
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+	if !result.Errors {
+		return nil
+	}
+	var failed int
+	var reason string
+	for _, item := range result.Items {
+		for _, r := range item {
+			if r.Status >= 300 {
+				failed++
+				if reason == "" {
+					reason = r.Error.Type + ": " + r.Error.Reason
+				}
+			}
+		}
+	}
+	return fmt.Errorf("%d of %d health documents failed to index, first error %s", failed, len(docs), reason)
+}
+
+// FlattenHealthInfo - converts a HealthInfo snapshot into one document per
+// node and section, each carrying the snapshot timestamp and version.
+// Entry fields are prefixed with "data.", so that e.g. the version of a
+// server does not replace the version of the snapshot.
+func FlattenHealthInfo(info HealthInfo) ([]HealthDocument, error) {
+	sections := []struct {
+		name    string
+		entries interface{}
+	}{
+		{"cpu", info.Sys.CPUInfo},
+		{"partitions", info.Sys.Partitions},
+		{"osinfo", info.Sys.OSInfo},
+		{"meminfo", info.Sys.MemInfo},
+		{"procinfo", info.Sys.ProcInfo},
+		{"perf_drive", info.Perf.Drives},
+		{"perf_net", info.Perf.Net},
+	}
+
+	var docs []HealthDocument
+	newDoc := func(section string) HealthDocument {
+		doc := HealthDocument{
+			"@timestamp": info.TimeStamp.UTC().Format(time.RFC3339Nano),
+			"version":    info.Version,
+			"section":    section,
+		}
+		if info.Minio.Info.DeploymentID != "" {
+			doc["deployment_id"] = info.Minio.Info.DeploymentID
+		}
+		return doc
+	}
+
+	for _, section := range sections {
+		data, err := json.Marshal(section.entries)
+		if err != nil {
+			return nil, err
+		}
+		var entries []map[string]interface{}
+		if err = json.Unmarshal(data, &entries); err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			doc := newDoc(section.name)
+			flattenDocument("data", entry, doc)
+			docs = append(docs, doc)
+		}
+	}
+
+	for _, server := range info.Minio.Info.Servers {
+		data, err := json.Marshal(server)
+		if err != nil {
+			return nil, err
+		}
+		var entry map[string]interface{}
+		if err = json.Unmarshal(data, &entry); err != nil {
+			return nil, err
+		}
+		// Runtime memory stats are far too verbose for indexing.
+		delete(entry, "mem_stats")
+		doc := newDoc("server")
+		flattenDocument("data", entry, doc)
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+func flattenDocument(prefix string, in map[string]interface{}, out HealthDocument) {
+	for k, v := range in {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if m, ok := v.(map[string]interface{}); ok {
+			flattenDocument(key, m, out)
+			continue
+		}
+		out[key] = v
+	}
+}
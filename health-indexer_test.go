@@ -0,0 +1,113 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFlattenHealthInfo(t *testing.T) {
+	info := HealthInfo{
+		Version:   HealthInfoVersion,
+		TimeStamp: time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC),
+		Sys: SysInfo{
+			MemInfo: []MemInfo{{Addr: "node1", Total: 100}, {Addr: "node2", Error: "timeout"}},
+			Partitions: []Partitions{{
+				Addr:       "node1",
+				Partitions: []Partition{{Device: "/dev/sda"}},
+			}},
+		},
+	}
+	info.Minio.Info.Servers = []ServerProperties{{Endpoint: "node1:9000", Version: "2021-04-22T15:44:28Z"}}
+
+	docs, err := FlattenHealthInfo(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs) != 4 {
+		t.Fatalf("expected 4 documents, got %d", len(docs))
+	}
+	for _, doc := range docs {
+		if doc["@timestamp"] != "2021-05-01T00:00:00Z" || doc["version"] != HealthInfoVersion {
+			t.Errorf("unexpected timestamp %v or version %v", doc["@timestamp"], doc["version"])
+		}
+	}
+	if docs[0]["section"] != "partitions" || docs[0]["data.addr"] != "node1" {
+		t.Errorf("unexpected partitions document %v", docs[0])
+	}
+	if docs[2]["section"] != "meminfo" || docs[2]["data.error"] != "timeout" {
+		t.Errorf("unexpected meminfo document %v", docs[2])
+	}
+	// The version of a server does not replace the snapshot version.
+	if docs[3]["section"] != "server" || docs[3]["data.version"] != "2021-04-22T15:44:28Z" || docs[3]["data.endpoint"] != "node1:9000" {
+		t.Errorf("unexpected server document %v", docs[3])
+	}
+
+	h, err := NewHealthIndexer(HealthIndexerOptions{Endpoint: "http://localhost:9200/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := h.IndexName(info.TimeStamp); name != "minio-health-2021.05.01" {
+		t.Errorf("unexpected index name %s", name)
+	}
+}
+
+func TestHealthIndexerIndexTimeStamp(t *testing.T) {
+	var lines []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var line map[string]interface{}
+			if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+				t.Error(err)
+			}
+			lines = append(lines, line)
+		}
+		w.Write([]byte(`{"errors":false}`))
+	}))
+	defer ts.Close()
+
+	h, err := NewHealthIndexer(HealthIndexerOptions{Endpoint: ts.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := HealthInfo{Sys: SysInfo{MemInfo: []MemInfo{{Addr: "node1"}}}}
+	if err = h.Index(context.Background(), info); err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("expected an action and a document, got %v", lines)
+	}
+
+	// Without a timestamp, the document goes to the index of the time
+	// it is stamped with.
+	index := lines[0]["index"].(map[string]interface{})["_index"].(string)
+	stamp, err := time.Parse(time.RFC3339Nano, lines[1]["@timestamp"].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stamp.Year() < 2021 || index != h.IndexName(stamp) || !strings.HasPrefix(index, "minio-health-") {
+		t.Errorf("expected index %s for timestamp %v", index, stamp)
+	}
+}
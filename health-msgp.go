@@ -0,0 +1,210 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"runtime"
+	"strconv"
+
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/host"
+	"github.com/shirou/gopsutil/net"
+	"github.com/shirou/gopsutil/process"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// HealthInfo and the types it nests are serialized by the generated code
+// in health_gen.go. Fields are keyed by their JSON names so a MessagePack
+// stream transcodes to the JSON schema of HealthInfo. Types of other
+// packages are carried through their JSON encoding, interface values must
+// be MessagePack encodable (e.g. FSBackend or *ErasureBackend).
+
+//go:generate go run ./internal/msgpgen -o health_gen.go HealthInfo ErasureBackend FSBackend
+
+//msgp:tag json
+//msgp:shim cpu.TimesStat as:interface{} using:msgpFromJSON/cpuTimesStatFromMsgp mode:convert
+//msgp:shim host.InfoStat as:interface{} using:msgpFromJSON/hostInfoStatFromMsgp mode:convert
+//msgp:shim host.TemperatureStat as:interface{} using:msgpFromJSON/hostTemperatureStatFromMsgp mode:convert
+//msgp:shim net.IOCountersStat as:interface{} using:msgpFromJSON/netIOCountersStatFromMsgp mode:convert
+//msgp:shim process.IOCountersStat as:interface{} using:msgpFromJSON/procIOCountersStatFromMsgp mode:convert
+//msgp:shim process.MemoryInfoStat as:interface{} using:msgpFromJSON/procMemoryInfoStatFromMsgp mode:convert
+//msgp:shim process.MemoryMapsStat as:interface{} using:msgpFromJSON/procMemoryMapsStatFromMsgp mode:convert
+//msgp:shim process.NumCtxSwitchesStat as:interface{} using:msgpFromJSON/procNumCtxSwitchesStatFromMsgp mode:convert
+//msgp:shim process.PageFaultsStat as:interface{} using:msgpFromJSON/procPageFaultsStatFromMsgp mode:convert
+//msgp:shim process.RlimitStat as:interface{} using:msgpFromJSON/procRlimitStatFromMsgp mode:convert
+//msgp:shim runtime.MemStats as:interface{} using:msgpFromJSON/memStatsFromMsgp mode:convert
+
+// healthMsgpContentType is the content type of MessagePack encoded
+// health info streams.
+const healthMsgpContentType = "application/msgpack"
+
+// msgpFromJSON - converts v to a value msgp can encode through its JSON
+// encoding, integers are kept as integers.
+func msgpFromJSON(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var intf interface{}
+	if err = decoder.Decode(&intf); err != nil {
+		return nil, err
+	}
+	return jsonNumbersToMsgp(intf), nil
+}
+
+// msgpToJSON - decodes a value read by msgp into v through its JSON
+// encoding.
+func msgpToJSON(intf interface{}, v interface{}) error {
+	data, err := json.Marshal(intf)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// jsonNumbersToMsgp - replaces the json.Number values of a decoded JSON
+// value with the narrowest numeric type MessagePack can encode.
+func jsonNumbersToMsgp(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = jsonNumbersToMsgp(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = jsonNumbersToMsgp(e)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if u, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return u
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return v
+}
+
+func cpuTimesStatFromMsgp(v interface{}) (s cpu.TimesStat, err error) {
+	err = msgpToJSON(v, &s)
+	return s, err
+}
+
+func hostInfoStatFromMsgp(v interface{}) (s host.InfoStat, err error) {
+	err = msgpToJSON(v, &s)
+	return s, err
+}
+
+func hostTemperatureStatFromMsgp(v interface{}) (s host.TemperatureStat, err error) {
+	err = msgpToJSON(v, &s)
+	return s, err
+}
+
+func netIOCountersStatFromMsgp(v interface{}) (s net.IOCountersStat, err error) {
+	err = msgpToJSON(v, &s)
+	return s, err
+}
+
+func procIOCountersStatFromMsgp(v interface{}) (s process.IOCountersStat, err error) {
+	err = msgpToJSON(v, &s)
+	return s, err
+}
+
+func procMemoryInfoStatFromMsgp(v interface{}) (s process.MemoryInfoStat, err error) {
+	err = msgpToJSON(v, &s)
+	return s, err
+}
+
+func procMemoryMapsStatFromMsgp(v interface{}) (s process.MemoryMapsStat, err error) {
+	err = msgpToJSON(v, &s)
+	return s, err
+}
+
+func procNumCtxSwitchesStatFromMsgp(v interface{}) (s process.NumCtxSwitchesStat, err error) {
+	err = msgpToJSON(v, &s)
+	return s, err
+}
+
+func procPageFaultsStatFromMsgp(v interface{}) (s process.PageFaultsStat, err error) {
+	err = msgpToJSON(v, &s)
+	return s, err
+}
+
+func procRlimitStatFromMsgp(v interface{}) (s process.RlimitStat, err error) {
+	err = msgpToJSON(v, &s)
+	return s, err
+}
+
+func memStatsFromMsgp(v interface{}) (s runtime.MemStats, err error) {
+	err = msgpToJSON(v, &s)
+	return s, err
+}
+
+// msgpJSONBody - a response body of MessagePack objects read as a
+// stream of JSON objects.
+type msgpJSONBody struct {
+	*io.PipeReader
+	body io.ReadCloser
+	done chan struct{}
+}
+
+// newMsgpJSONBody - transcodes body in the background so readers of
+// health info streams are unaware of the wire encoding.
+func newMsgpJSONBody(body io.ReadCloser) io.ReadCloser {
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r := msgp.NewReader(body)
+		enc := json.NewEncoder(pw)
+		// Transcode one object per line, like the JSON stream.
+		for {
+			obj, err := r.ReadIntf()
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				pw.CloseWithError(err)
+				return
+			}
+			if err = enc.Encode(obj); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+	}()
+	return msgpJSONBody{PipeReader: pr, body: body, done: done}
+}
+
+// Close - stops the transcoding and closes the response body.
+func (b msgpJSONBody) Close() error {
+	b.PipeReader.Close()
+	// The transcoder stops at its next write to the closed pipe, wait
+	// for it before touching the body it reads from.
+	<-b.done
+	// Drain like closeResponse so the connection can be reused.
+	io.Copy(ioutil.Discard, b.body)
+	return b.body.Close()
+}
@@ -0,0 +1,157 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/process"
+	"github.com/tinylib/msgp/msgp"
+)
+
+func TestHealthInfoMsgp(t *testing.T) {
+	info := HealthInfo{
+		Version:   HealthInfoVersion,
+		TimeStamp: time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC),
+	}
+	info.Sys.MemInfo = []MemInfo{{Addr: "node1:9000", Total: 64 * GiB, SwapSpaceFree: 1<<63 + 1}}
+	info.Sys.ProcInfo = []ProcInfo{{
+		Addr:       "node1:9000",
+		PID:        42,
+		CreateTime: UnixMillis(1619827200000),
+		Times:      cpu.TimesStat{CPU: "cpu-total", User: 12.5, System: 3},
+		Rlimit:     []process.RlimitStat{{Resource: 7, Soft: 1 << 20, Hard: 1 << 20}},
+	}}
+	info.Minio.Info.Backend = FSBackend{Type: FsType}
+	info.Minio.Info.Services.Logger = []Logger{{"console": {Status: "online"}}}
+
+	data, err := info.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jsonLen := len(info.JSON()); len(data) >= jsonLen {
+		t.Errorf("expected MessagePack to be smaller than JSON, got %d >= %d bytes", len(data), jsonLen)
+	}
+	var decoded HealthInfo
+	rest, err := decoded.UnmarshalMsg(append(data, 0xc0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest) != 1 {
+		t.Errorf("expected 1 remaining byte, got %d", len(rest))
+	}
+	if !decoded.TimeStamp.Equal(info.TimeStamp) || decoded.Sys.MemInfo[0] != info.Sys.MemInfo[0] {
+		t.Errorf("expected %+v, got %+v", info.Sys.MemInfo, decoded.Sys.MemInfo)
+	}
+	checkProcInfo(t, decoded.Sys.ProcInfo, info.Sys.ProcInfo)
+	if !reflect.DeepEqual(decoded.Minio.Info.Backend, map[string]interface{}{"backendType": "FS"}) ||
+		!reflect.DeepEqual(decoded.Minio.Info.Services.Logger, info.Minio.Info.Services.Logger) {
+		t.Errorf("expected %+v, got %+v", info.Minio.Info, decoded.Minio.Info)
+	}
+
+	// Transcoded streams decode like JSON responses.
+	var transcoded HealthInfo
+	body := newMsgpJSONBody(ioutil.NopCloser(bytes.NewReader(data)))
+	if err = json.NewDecoder(body).Decode(&transcoded); err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+	if transcoded.Sys.MemInfo[0] != info.Sys.MemInfo[0] {
+		t.Errorf("expected %+v, got %+v", info.Sys.MemInfo, transcoded.Sys.MemInfo)
+	}
+	checkProcInfo(t, transcoded.Sys.ProcInfo, info.Sys.ProcInfo)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != "application/msgpack, application/json" {
+			w.Write([]byte(`{"version":"` + HealthInfoVersion + `"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/msgpack")
+		version, _ := msgp.AppendIntf(nil, map[string]interface{}{"version": HealthInfoVersion})
+		w.Write(version)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, version, err := adm.ServerHealthInfoWithOpts(context.Background(), nil, HealthInfoOpts{MsgPack: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeResponse(resp)
+	if version != HealthInfoVersion {
+		t.Errorf("unexpected version %q", version)
+	}
+}
+
+func checkProcInfo(t *testing.T, got, want []ProcInfo) {
+	t.Helper()
+	if len(got) != 1 || got[0].PID != want[0].PID || got[0].CreateTime != want[0].CreateTime ||
+		got[0].Times != want[0].Times || !reflect.DeepEqual(got[0].Rlimit, want[0].Rlimit) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestMsgpJSONBodyClose(t *testing.T) {
+	var data []byte
+	for i := 0; i < 1000; i++ {
+		data, _ = msgp.AppendIntf(data, map[string]interface{}{"version": HealthInfoVersion})
+	}
+	src := bytes.NewReader(data)
+	body := &closeRecorder{Reader: src}
+	r := newMsgpJSONBody(body)
+
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if line != `{"version":"`+HealthInfoVersion+`"}`+"\n" {
+		t.Errorf("unexpected line %q", line)
+	}
+	if err = r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !body.closed || src.Len() != 0 {
+		t.Errorf("expected the body to be drained and closed, %d bytes left", src.Len())
+	}
+}
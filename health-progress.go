@@ -0,0 +1,100 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"time"
+)
+
+// HealthProgressState - state of a section of a health report.
+type HealthProgressState string
+
+// HealthProgressState values.
+const (
+	HealthProgressStarted   HealthProgressState = "started"
+	HealthProgressCompleted HealthProgressState = "completed"
+)
+
+// HealthProgress - a progress event sent by the server while it
+// collects a health report, once a node starts or completes a section.
+type HealthProgress struct {
+	Node    string              `json:"node"`
+	Section HealthDataType      `json:"section"`
+	State   HealthProgressState `json:"state"`
+	Time    time.Time           `json:"time"`
+	// Error is set if the section completed with an error.
+	Error string `json:"error,omitempty"`
+}
+
+type healthProgressEvent struct {
+	Progress *HealthProgress `json:"progress"`
+}
+
+// healthProgressBody - a health info stream with the progress events
+// removed.
+type healthProgressBody struct {
+	*io.PipeReader
+	body io.ReadCloser
+	done chan struct{}
+}
+
+// newHealthProgressBody - passes progress events in body to
+// onProgress in the background, other objects are read as before.
+func newHealthProgressBody(body io.ReadCloser, onProgress func(HealthProgress)) io.ReadCloser {
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		decoder := json.NewDecoder(body)
+		for {
+			var raw json.RawMessage
+			if err := decoder.Decode(&raw); err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				pw.CloseWithError(err)
+				return
+			}
+			if bytes.HasPrefix(bytes.TrimSpace(raw), []byte(`{"progress"`)) {
+				var event healthProgressEvent
+				if err := json.Unmarshal(raw, &event); err == nil && event.Progress != nil {
+					onProgress(*event.Progress)
+					continue
+				}
+			}
+			if _, err := pw.Write(append(raw, '\n')); err != nil {
+				return
+			}
+		}
+	}()
+	return healthProgressBody{PipeReader: pr, body: body, done: done}
+}
+
+// Close - stops reading progress events and closes the response body.
+func (b healthProgressBody) Close() error {
+	b.PipeReader.Close()
+	// The decoder stops at its next write to the closed pipe, wait
+	// for it before touching the body it reads from.
+	<-b.done
+	// Drain like closeResponse so the connection can be reused.
+	io.Copy(ioutil.Discard, b.body)
+	return b.body.Close()
+}
@@ -0,0 +1,100 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestHealthInfoProgress(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("progress") != "true" {
+			t.Errorf("progress not requested")
+		}
+		io.WriteString(w, `{"version":"`+HealthInfoVersion+`"}`+"\n")
+		io.WriteString(w, `{"progress":{"node":"node1:9000","section":"syscpu","state":"started","time":"2021-10-14T08:00:00Z"}}`+"\n")
+		io.WriteString(w, `{"version":"`+HealthInfoVersion+`","timestamp":"2021-10-14T08:00:01Z"}`+"\n")
+		io.WriteString(w, `{"progress":{"node":"node1:9000","section":"syscpu","state":"completed","time":"2021-10-14T08:00:02Z"}}`+"\n")
+		io.WriteString(w, `{"version":"`+HealthInfoVersion+`","timestamp":"2021-10-14T08:00:03Z"}`+"\n")
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []HealthProgress
+	resp, _, err := adm.ServerHealthInfoWithOpts(context.Background(), []HealthDataType{HealthDataTypeSysCPU}, HealthInfoOpts{
+		OnProgress: func(p HealthProgress) { events = append(events, p) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeResponse(resp)
+
+	var infos int
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var info HealthInfo
+		if err = decoder.Decode(&info); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		infos++
+	}
+	if infos != 2 {
+		t.Errorf("expected 2 health infos, got %d", infos)
+	}
+	if len(events) != 2 || events[0].State != HealthProgressStarted || events[1].State != HealthProgressCompleted ||
+		events[1].Node != "node1:9000" || events[1].Section != HealthDataTypeSysCPU {
+		t.Errorf("unexpected progress events %+v", events)
+	}
+}
+
+func TestHealthProgressBodyClose(t *testing.T) {
+	progress := `{"progress":{"node":"node1:9000","section":"syscpu","state":"started"}}` + "\n"
+	src := strings.NewReader(`{"version":"` + HealthInfoVersion + `"}` + "\n" + strings.Repeat(progress, 1000))
+	// Progress events keep the decoder reading the slow body while it
+	// is closed.
+	body := &closeRecorder{Reader: iotest.OneByteReader(src)}
+	r := newHealthProgressBody(body, func(HealthProgress) {})
+
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if line != `{"version":"`+HealthInfoVersion+`"}`+"\n" {
+		t.Errorf("unexpected line %q", line)
+	}
+	if err = r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !body.closed || src.Len() != 0 {
+		t.Errorf("expected the body to be drained and closed, %d bytes left", src.Len())
+	}
+}
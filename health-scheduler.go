@@ -0,0 +1,208 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrHealthCollectionInProgress is returned when a collection is
+// requested while the previous one has not finished yet.
+var ErrHealthCollectionInProgress = errors.New("health collection already in progress")
+
+// HealthSink receives every HealthInfo collected by a HealthScheduler.
+type HealthSink interface {
+	WriteHealth(ctx context.Context, info HealthInfo) error
+}
+
+// HealthSinkFunc adapts a function to the HealthSink interface.
+type HealthSinkFunc func(ctx context.Context, info HealthInfo) error
+
+// WriteHealth calls f(ctx, info).
+func (f HealthSinkFunc) WriteHealth(ctx context.Context, info HealthInfo) error {
+	return f(ctx, info)
+}
+
+// WriteHealth - records info, implements HealthSink.
+func (s *DiagnosticsStore) WriteHealth(ctx context.Context, info HealthInfo) error {
+	return s.RecordHealth(info)
+}
+
+// WriteHealth - indexes info, implements HealthSink.
+func (h *HealthIndexer) WriteHealth(ctx context.Context, info HealthInfo) error {
+	return h.Index(ctx, info)
+}
+
+// HealthSchedulerOptions - options for NewHealthScheduler.
+type HealthSchedulerOptions struct {
+	// Types of health data collected, defaults to all but perf tests
+	// and capability probes which are too disruptive to be run
+	// periodically.
+	Types []HealthDataType
+	// Deadline for a single collection, defaults to 1 minute.
+	Deadline time.Duration
+	// Interval between collections.
+	Interval time.Duration
+	// Align collections to wall clock multiples of Interval, e.g. an
+	// hourly schedule runs at the top of every hour, similar to cron.
+	Align bool
+	// Jitter delays every collection by a random duration up to Jitter
+	// so a fleet of agents does not hit the cluster at the same time.
+	Jitter time.Duration
+	// Sinks receive each collected HealthInfo.
+	Sinks []HealthSink
+	// OnError is called with collection and sink errors, if set.
+	OnError func(err error)
+}
+
+// HealthScheduler runs ServerHealthInfo periodically and hands the
+// results to the configured sinks. A collection is never started while
+// the previous one is still running.
+type HealthScheduler struct {
+	adm     *AdminClient
+	opts    HealthSchedulerOptions
+	random  *rand.Rand
+	running int32
+}
+
+// NewHealthScheduler - returns a new health scheduler.
+func NewHealthScheduler(adm *AdminClient, opts HealthSchedulerOptions) (*HealthScheduler, error) {
+	if opts.Interval <= 0 {
+		return nil, ErrInvalidArgument("Health collection interval must be positive.")
+	}
+	if len(opts.Types) == 0 {
+		for _, t := range HealthDataTypesList {
+			// Performance tests load the cluster and capability probes
+			// create buckets, both are opt-in.
+			if t != HealthDataTypePerfDrive && t != HealthDataTypePerfNet && t != HealthDataTypeCapabilities {
+				opts.Types = append(opts.Types, t)
+			}
+		}
+	}
+	if opts.Deadline == 0 {
+		opts.Deadline = time.Minute
+	}
+	return &HealthScheduler{
+		adm:    adm,
+		opts:   opts,
+		random: adm.random,
+	}, nil
+}
+
+// Run - collects health data on schedule until ctx is canceled, and
+// returns once the running collection finished.
+func (s *HealthScheduler) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		timer := time.NewTimer(s.nextDelay(s.adm.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.RunOnce(ctx); err != nil && s.opts.OnError != nil {
+				s.opts.OnError(err)
+			}
+		}()
+	}
+}
+
+// RunOnce - collects health data once and writes it to all sinks,
+// returns ErrHealthCollectionInProgress if a collection is running.
+func (s *HealthScheduler) RunOnce(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return ErrHealthCollectionInProgress
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	info, err := s.adm.collectHealthInfo(ctx, s.opts.Types, s.opts.Deadline)
+	if err != nil {
+		return err
+	}
+
+	var sinkErr error
+	for _, sink := range s.opts.Sinks {
+		if err = sink.WriteHealth(ctx, info); err != nil {
+			if s.opts.OnError != nil {
+				s.opts.OnError(err)
+			}
+			sinkErr = err
+		}
+	}
+	return sinkErr
+}
+
+// nextDelay - returns the delay until the next collection.
+func (s *HealthScheduler) nextDelay(now time.Time) time.Duration {
+	delay := s.opts.Interval
+	if s.opts.Align {
+		delay = now.Truncate(s.opts.Interval).Add(s.opts.Interval).Sub(now)
+	}
+	if s.opts.Jitter > 0 {
+		delay += time.Duration(s.random.Int63n(int64(s.opts.Jitter)))
+	}
+	return delay
+}
+
+// collectHealthInfo - requests health info and reads the streamed
+// response until the final HealthInfo is received.
+func (adm *AdminClient) collectHealthInfo(ctx context.Context, types []HealthDataType, deadline time.Duration) (HealthInfo, error) {
+	resp, first, version, err := adm.healthInfoStream(ctx, types, HealthInfoOpts{Deadline: deadline}, "")
+	if err != nil {
+		return HealthInfo{}, err
+	}
+	defer closeResponse(resp)
+
+	if version != HealthInfoVersion {
+		return HealthInfo{}, errors.New("unsupported health info version " + version)
+	}
+
+	var info HealthInfo
+	if err = adm.unmarshalResponse(resp, first, &info); err != nil {
+		return HealthInfo{}, err
+	}
+	decoder := adm.newResponseDecoder(resp)
+	for {
+		var next HealthInfo
+		if err = decoder.Decode(&next); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return HealthInfo{}, err
+		}
+		info = next
+	}
+	if info.TimeStamp.IsZero() {
+		info.TimeStamp = adm.now().UTC()
+	}
+	info.Canonicalize()
+	if info.Error != "" {
+		return info, errors.New(info.Error)
+	}
+	return info, nil
+}
@@ -0,0 +1,160 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHealthSchedulerNextDelay(t *testing.T) {
+	adm, err := New("localhost:9000", "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewHealthScheduler(adm, HealthSchedulerOptions{}); err == nil {
+		t.Error("expected an error without interval")
+	}
+
+	s, err := NewHealthScheduler(adm, HealthSchedulerOptions{Interval: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, typ := range s.opts.Types {
+		if typ == HealthDataTypePerfDrive || typ == HealthDataTypePerfNet || typ == HealthDataTypeCapabilities {
+			t.Errorf("expected %s not to be collected by default", typ)
+		}
+	}
+	now := time.Date(2021, 5, 1, 10, 20, 0, 0, time.UTC)
+	if d := s.nextDelay(now); d != time.Hour {
+		t.Errorf("expected the interval, got %v", d)
+	}
+	s.opts.Align = true
+	if d := s.nextDelay(now); d != 40*time.Minute {
+		t.Errorf("expected the delay until the top of the hour, got %v", d)
+	}
+
+	jitter := func() []time.Duration {
+		adm.SetJitterSource(rand.NewSource(1))
+		s, _ := NewHealthScheduler(adm, HealthSchedulerOptions{Interval: time.Hour, Align: true, Jitter: time.Minute})
+		return []time.Duration{s.nextDelay(now), s.nextDelay(now)}
+	}
+	first, second := jitter(), jitter()
+	for i := range first {
+		if first[i] != second[i] || first[i] < 40*time.Minute || first[i] >= 41*time.Minute {
+			t.Errorf("expected equal delays within the jitter, got %v and %v", first, second)
+		}
+	}
+}
+
+func TestHealthSchedulerRun(t *testing.T) {
+	reports := int32(2)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&reports) > 1 {
+			w.Write([]byte(`{"version":"` + HealthInfoVersion + `"}` + "\n"))
+		}
+		w.Write([]byte(`{"version":"` + HealthInfoVersion + `","sys":{"meminfo":[{"addr":"node1:9000"}]}}` + "\n"))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Aligned to minutes, the clock is always 10ms before the next one.
+	fixed := time.Date(2021, 5, 1, 10, 20, 59, 990000000, time.UTC)
+	adm.SetClock(ClockFunc(func() time.Time { return fixed }))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	collected := make(chan HealthInfo, 10)
+	var active int32
+	sinkErr := errors.New("sink full")
+	var errs []error
+	s, err := NewHealthScheduler(adm, HealthSchedulerOptions{
+		Types:    []HealthDataType{HealthDataTypeSysMem},
+		Interval: time.Minute,
+		Align:    true,
+		Sinks: []HealthSink{
+			HealthSinkFunc(func(ctx context.Context, info HealthInfo) error {
+				atomic.AddInt32(&active, 1)
+				defer atomic.AddInt32(&active, -1)
+				select {
+				case collected <- info:
+				default:
+				}
+				// Keep collections running while Run is canceled.
+				time.Sleep(20 * time.Millisecond)
+				return nil
+			}),
+			HealthSinkFunc(func(ctx context.Context, info HealthInfo) error { return sinkErr }),
+		},
+		OnError: func(err error) { errs = append(errs, err) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The collected report is timestamped by the client clock.
+	if err = s.RunOnce(ctx); !errors.Is(err, sinkErr) || len(errs) != 1 {
+		t.Fatalf("expected the sink error to be returned and reported, got %v and %v", err, errs)
+	}
+	info := <-collected
+	if !info.TimeStamp.Equal(fixed) || len(info.Sys.MemInfo) != 1 || info.Sys.MemInfo[0].Addr != "node1:9000" {
+		t.Errorf("unexpected report %+v", info)
+	}
+
+	// A stream of a single report is collected as well.
+	atomic.StoreInt32(&reports, 1)
+	s.RunOnce(ctx)
+	if info = <-collected; len(info.Sys.MemInfo) != 1 {
+		t.Errorf("expected the only report, got %+v", info)
+	}
+
+	s.running = 1
+	if err = s.RunOnce(ctx); err != ErrHealthCollectionInProgress {
+		t.Errorf("expected a collection in progress, got %v", err)
+	}
+	s.running = 0
+
+	// Run with OnError unset, the sink errors are only returned.
+	s.opts.OnError = nil
+	done := make(chan error)
+	go func() { done <- s.Run(ctx) }()
+	for i := 0; i < 2; i++ {
+		select {
+		case <-collected:
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected scheduled collections")
+		}
+	}
+	cancel()
+	if err = <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context error, got %v", err)
+	}
+	if n := atomic.LoadInt32(&active); n != 0 {
+		t.Errorf("expected Run to wait for running collections, %d still running", n)
+	}
+}
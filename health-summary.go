@@ -0,0 +1,171 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// HealthStatus - overall status of a cluster.
+type HealthStatus string
+
+// Cluster health statuses.
+const (
+	HealthStatusGreen  HealthStatus = "green"
+	HealthStatusYellow HealthStatus = "yellow"
+	HealthStatusRed    HealthStatus = "red"
+)
+
+// Free space shares of the fullest drive below which the status is
+// yellow or red.
+const (
+	healthFreeSpaceYellowPercent = 20
+	healthFreeSpaceRedPercent    = 5
+)
+
+// ClusterHealthSummary - at-a-glance summary of a health report.
+type ClusterHealthSummary struct {
+	Status HealthStatus `json:"status"`
+	// Reasons why the status is not green.
+	Reasons []string `json:"reasons,omitempty"`
+
+	TotalNodes   int `json:"totalNodes"`
+	OnlineNodes  int `json:"onlineNodes"`
+	TotalDrives  int `json:"totalDrives"`
+	FailedDrives int `json:"failedDrives"`
+	// MinFreeSpacePercent is the free share of the fullest drive, only
+	// meaningful if CapacityKnown.
+	MinFreeSpacePercent float64 `json:"minFreeSpacePercent"`
+	// CapacityKnown is set if at least one drive reports its capacity.
+	CapacityKnown bool `json:"capacityKnown"`
+	// ConfigWarnings are deployment issues like mixed server versions
+	// or hardware sizing recommendations.
+	ConfigWarnings []string `json:"configWarnings,omitempty"`
+}
+
+// Summarize - computes the summary of a health report. The status is
+// red if the report failed, a node is offline, a drive is almost full,
+// clocks are skewed beyond MaxClockSkew or nodes do not trust each
+// other's TLS certificates, and yellow for failed
+// drives, low free space, config warnings or collection errors.
+func (info HealthInfo) Summarize() ClusterHealthSummary {
+	var s ClusterHealthSummary
+	var red, yellow []string
+
+	if info.Error != "" {
+		red = append(red, "health collection failed: "+info.Error)
+	}
+
+	versions := make(map[string]struct{})
+	minFree := -1.0
+	for _, srv := range info.Minio.Info.Servers {
+		s.TotalNodes++
+		if srv.State == string(ItemOnline) {
+			s.OnlineNodes++
+		}
+		if srv.Version != "" {
+			versions[srv.Version] = struct{}{}
+		}
+		for _, d := range srv.Disks {
+			s.TotalDrives++
+			if d.State != "" && d.State != DriveStateOk {
+				s.FailedDrives++
+			}
+			if d.TotalSpace > 0 {
+				if free := float64(d.AvailableSpace) * 100 / float64(d.TotalSpace); minFree < 0 || free < minFree {
+					minFree = free
+				}
+			}
+		}
+	}
+	if minFree >= 0 {
+		s.MinFreeSpacePercent = minFree
+		s.CapacityKnown = true
+	}
+
+	if offline := s.TotalNodes - s.OnlineNodes; offline > 0 {
+		red = append(red, fmt.Sprintf("%d of %d nodes offline", offline, s.TotalNodes))
+	}
+	if s.FailedDrives > 0 {
+		yellow = append(yellow, fmt.Sprintf("%d of %d drives failed", s.FailedDrives, s.TotalDrives))
+	}
+	switch {
+	case minFree < 0:
+	case minFree < healthFreeSpaceRedPercent:
+		red = append(red, fmt.Sprintf("a drive has only %.1f%% free space", minFree))
+	case minFree < healthFreeSpaceYellowPercent:
+		yellow = append(yellow, fmt.Sprintf("a drive has only %.1f%% free space", minFree))
+	}
+
+	for _, t := range info.Sys.TimeInfo {
+		if skew := t.MaxSkew(); skew > MaxClockSkew {
+			red = append(red, fmt.Sprintf("clock of %s is %s off a peer, signed requests are rejected", t.Addr, skew.Round(time.Second)))
+		}
+		if t.Error == "" && !t.NTPSynchronized {
+			s.ConfigWarnings = append(s.ConfigWarnings, t.Addr+": clock is not synchronized by NTP")
+		}
+	}
+	for _, d := range info.Sys.DualStack {
+		s.ConfigWarnings = append(s.ConfigWarnings, d.Problems()...)
+	}
+	for _, t := range info.Sys.TLSTrust {
+		red = append(red, t.Problems()...)
+	}
+	if mismatch := TLSBundleMismatches(info.Sys.TLSTrust); mismatch != "" {
+		s.ConfigWarnings = append(s.ConfigWarnings, mismatch)
+	}
+
+	if info.Minio.Config.Error != "" {
+		s.ConfigWarnings = append(s.ConfigWarnings, "server configuration could not be read: "+info.Minio.Config.Error)
+	}
+	if len(versions) > 1 {
+		list := make([]string, 0, len(versions))
+		for v := range versions {
+			list = append(list, v)
+		}
+		sort.Strings(list)
+		s.ConfigWarnings = append(s.ConfigWarnings, "servers run different versions: "+strings.Join(list, ", "))
+	}
+	for _, rec := range RecommendHardware(info, HardwareAdvisorThresholds{}) {
+		s.ConfigWarnings = append(s.ConfigWarnings, rec.Node+": "+rec.Message)
+	}
+	if len(s.ConfigWarnings) > 0 {
+		yellow = append(yellow, fmt.Sprintf("%d config warnings", len(s.ConfigWarnings)))
+	}
+
+	errs := 0
+	for _, nodeErrs := range info.ToV2().Errors() {
+		errs += len(nodeErrs)
+	}
+	if errs > 0 {
+		yellow = append(yellow, fmt.Sprintf("%d collection errors", errs))
+	}
+
+	switch {
+	case len(red) > 0:
+		s.Status = HealthStatusRed
+	case len(yellow) > 0:
+		s.Status = HealthStatusYellow
+	default:
+		s.Status = HealthStatusGreen
+	}
+	s.Reasons = append(red, yellow...)
+	return s
+}
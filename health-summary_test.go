@@ -0,0 +1,58 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import "testing"
+
+func TestHealthInfoSummarize(t *testing.T) {
+	var info HealthInfo
+	info.Minio.Info.Servers = []ServerProperties{
+		{State: "online", Version: "2021-05-01", Disks: []Disk{
+			{State: DriveStateOk, TotalSpace: 100, AvailableSpace: 50},
+			{State: DriveStateOk, TotalSpace: 100, AvailableSpace: 30},
+		}},
+		{State: "online", Version: "2021-05-01", Disks: []Disk{
+			{State: DriveStateOk, TotalSpace: 100, AvailableSpace: 60},
+		}},
+	}
+	s := info.Summarize()
+	if s.Status != HealthStatusGreen || len(s.Reasons) != 0 {
+		t.Fatalf("expected a green summary, got %+v", s)
+	}
+	if s.TotalNodes != 2 || s.OnlineNodes != 2 || s.TotalDrives != 3 || s.MinFreeSpacePercent != 30 || !s.CapacityKnown {
+		t.Errorf("unexpected summary %+v", s)
+	}
+
+	info.Minio.Info.Servers[1].Version = "2021-06-01"
+	info.Minio.Info.Servers[1].Disks[0].State = DriveStateFaulty
+	s = info.Summarize()
+	if s.Status != HealthStatusYellow || s.FailedDrives != 1 || len(s.ConfigWarnings) != 1 || len(s.Reasons) != 2 {
+		t.Errorf("expected a yellow summary, got %+v", s)
+	}
+
+	info.Minio.Info.Servers[1].State = "offline"
+	info.Minio.Info.Servers[0].Disks[0].AvailableSpace = 2
+	s = info.Summarize()
+	if s.Status != HealthStatusRed || s.OnlineNodes != 1 || s.MinFreeSpacePercent != 2 || len(s.Reasons) != 4 {
+		t.Errorf("expected a red summary, got %+v", s)
+	}
+
+	info.Minio.Info.Servers = []ServerProperties{{State: "online", Disks: []Disk{{State: DriveStateOk}}}}
+	if s = info.Summarize(); s.CapacityKnown {
+		t.Errorf("expected an unknown capacity without drive sizes, got %+v", s)
+	}
+}
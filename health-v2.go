@@ -0,0 +1,481 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// HealthInfoVersion2 is version 2, with structured per-node errors.
+const HealthInfoVersion2 = "2"
+
+// HealthNodeError - an error of a collector on a node.
+type HealthNodeError struct {
+	Addr    string          `json:"addr" yaml:"addr"`
+	Code    HealthErrorCode `json:"code" yaml:"code"`
+	Message string          `json:"message" yaml:"message"`
+}
+
+// healthErrorCode - classifies the error message of a collector.
+func healthErrorCode(msg string) HealthErrorCode {
+	lower := strings.ToLower(msg)
+	switch {
+	case strings.Contains(lower, "unsupported operating system"), strings.Contains(lower, "not supported on this operating system"):
+		return ErrUnsupportedOS
+	case strings.Contains(lower, "permission denied"), strings.Contains(lower, "operation not permitted"):
+		return ErrPermissionDenied
+	case strings.Contains(lower, "deadline exceeded"), strings.Contains(lower, "timeout"), strings.Contains(lower, "timed out"):
+		return ErrTimeout
+	case strings.HasPrefix(lower, "collector panic"):
+		return ErrCollectorPanic
+	}
+	return ErrHealthUnknown
+}
+
+// newHealthNodeError - returns the error of a node, code is derived
+// from the message if empty, i.e. for reports of older servers.
+func newHealthNodeError(addr, msg string, code HealthErrorCode) HealthNodeError {
+	if code == "" {
+		code = healthErrorCode(msg)
+	}
+	return HealthNodeError{Addr: addr, Code: code, Message: msg}
+}
+
+// CPUHealthV2 - CPU information of the nodes and their errors.
+type CPUHealthV2 struct {
+	Nodes  []CPUs            `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	Errors []HealthNodeError `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// MemHealthV2 - memory information of the nodes and their errors.
+type MemHealthV2 struct {
+	Nodes  []MemInfo         `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	Errors []HealthNodeError `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// PartitionsHealthV2 - partitions of the nodes and their errors.
+type PartitionsHealthV2 struct {
+	Nodes  []Partitions      `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	Errors []HealthNodeError `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// OSHealthV2 - operating system information of the nodes and their
+// errors.
+type OSHealthV2 struct {
+	Nodes  []OSInfo          `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	Errors []HealthNodeError `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// ProcHealthV2 - server process information of the nodes and their
+// errors.
+type ProcHealthV2 struct {
+	Nodes  []ProcInfo        `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	Errors []HealthNodeError `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// DrivesHealthV2 - drive performance of the nodes and their errors.
+type DrivesHealthV2 struct {
+	Nodes  []DrivePerfInfos  `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	Errors []HealthNodeError `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// NetHealthV2 - network performance of the nodes and their errors.
+type NetHealthV2 struct {
+	Nodes    []NetPerfInfo     `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	Parallel NetPerfInfo       `json:"parallel,omitempty" yaml:"parallel,omitempty"`
+	Errors   []HealthNodeError `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// HealthInfoV2 - MinIO cluster's health info where every subsystem
+// lists the nodes it was collected from and the structured errors of
+// the nodes it failed on, instead of per-node error strings.
+type HealthInfoV2 struct {
+	Version   string    `json:"version" yaml:"version"`
+	Error     string    `json:"error,omitempty" yaml:"error,omitempty"`
+	TimeStamp time.Time `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+
+	CPU        CPUHealthV2        `json:"cpu,omitempty" yaml:"cpu,omitempty"`
+	Mem        MemHealthV2        `json:"mem,omitempty" yaml:"mem,omitempty"`
+	Partitions PartitionsHealthV2 `json:"partitions,omitempty" yaml:"partitions,omitempty"`
+	OS         OSHealthV2         `json:"os,omitempty" yaml:"os,omitempty"`
+	Proc       ProcHealthV2       `json:"proc,omitempty" yaml:"proc,omitempty"`
+	Drives     DrivesHealthV2     `json:"drives,omitempty" yaml:"drives,omitempty"`
+	Net        NetHealthV2        `json:"net,omitempty" yaml:"net,omitempty"`
+	Minio      MinioHealthInfo    `json:"minio,omitempty" yaml:"minio,omitempty"`
+}
+
+// Errors - returns the errors of all subsystems by subsystem name.
+func (info HealthInfoV2) Errors() map[string][]HealthNodeError {
+	errs := make(map[string][]HealthNodeError)
+	for name, e := range map[string][]HealthNodeError{
+		"cpu":        info.CPU.Errors,
+		"mem":        info.Mem.Errors,
+		"partitions": info.Partitions.Errors,
+		"os":         info.OS.Errors,
+		"proc":       info.Proc.Errors,
+		"drives":     info.Drives.Errors,
+		"net":        info.Net.Errors,
+	} {
+		if len(e) > 0 {
+			errs[name] = e
+		}
+	}
+	return errs
+}
+
+// ToV2 - converts a version 1 report, per-node error strings become
+// structured errors and the failed nodes are removed from the data.
+func (info HealthInfo) ToV2() HealthInfoV2 {
+	v2 := HealthInfoV2{
+		Version:   HealthInfoVersion2,
+		Error:     info.Error,
+		TimeStamp: info.TimeStamp,
+		Minio:     info.Minio,
+	}
+	for _, n := range info.Sys.CPUInfo {
+		if n.Error != "" {
+			v2.CPU.Errors = append(v2.CPU.Errors, newHealthNodeError(n.Addr, n.Error, n.ErrorCode))
+			continue
+		}
+		v2.CPU.Nodes = append(v2.CPU.Nodes, n)
+	}
+	for _, n := range info.Sys.MemInfo {
+		if n.Error != "" {
+			v2.Mem.Errors = append(v2.Mem.Errors, newHealthNodeError(n.Addr, n.Error, n.ErrorCode))
+			continue
+		}
+		v2.Mem.Nodes = append(v2.Mem.Nodes, n)
+	}
+	for _, n := range info.Sys.Partitions {
+		if n.Error != "" {
+			v2.Partitions.Errors = append(v2.Partitions.Errors, newHealthNodeError(n.Addr, n.Error, n.ErrorCode))
+			continue
+		}
+		v2.Partitions.Nodes = append(v2.Partitions.Nodes, n)
+	}
+	for _, n := range info.Sys.OSInfo {
+		if n.Error != "" {
+			v2.OS.Errors = append(v2.OS.Errors, newHealthNodeError(n.Addr, n.Error, n.ErrorCode))
+			continue
+		}
+		v2.OS.Nodes = append(v2.OS.Nodes, n)
+	}
+	for _, n := range info.Sys.ProcInfo {
+		if n.Error != "" {
+			v2.Proc.Errors = append(v2.Proc.Errors, newHealthNodeError(n.Addr, n.Error, n.ErrorCode))
+			continue
+		}
+		v2.Proc.Nodes = append(v2.Proc.Nodes, n)
+	}
+	for _, n := range info.Perf.Drives {
+		if n.Error != "" {
+			v2.Drives.Errors = append(v2.Drives.Errors, newHealthNodeError(n.Addr, n.Error, ""))
+			continue
+		}
+		v2.Drives.Nodes = append(v2.Drives.Nodes, n)
+	}
+	for _, n := range info.Perf.Net {
+		if n.Error != "" {
+			v2.Net.Errors = append(v2.Net.Errors, newHealthNodeError(n.Addr, n.Error, ""))
+			continue
+		}
+		v2.Net.Nodes = append(v2.Net.Nodes, n)
+	}
+	v2.Net.Parallel = info.Perf.NetParallel
+	if p := info.Perf.NetParallel; p.Error != "" {
+		v2.Net.Errors = append(v2.Net.Errors, newHealthNodeError(p.Addr, p.Error, ""))
+		v2.Net.Parallel.Error = ""
+	}
+	return v2
+}
+
+// DecodeHealthInfoV2 - decodes a health report of any version into the
+// version 2 structure. Version 0 data without an equivalent in later
+// versions, like the process list and IO counters, is dropped.
+func DecodeHealthInfoV2(data []byte) (HealthInfoV2, error) {
+	var version healthInfoVersion
+	if err := json.Unmarshal(data, &version); err != nil {
+		return HealthInfoV2{}, err
+	}
+	switch version.Version {
+	case HealthInfoVersion2:
+		var v2 HealthInfoV2
+		err := json.Unmarshal(data, &v2)
+		return v2, err
+	case HealthInfoVersion1:
+		var v1 HealthInfo
+		if err := json.Unmarshal(data, &v1); err != nil {
+			return HealthInfoV2{}, err
+		}
+		if err := v1.Upgrade(); err != nil {
+			return HealthInfoV2{}, err
+		}
+		return v1.ToV2(), nil
+	case HealthInfoVersion0:
+		var v0 healthInfoV0
+		if err := json.Unmarshal(data, &v0); err != nil {
+			return HealthInfoV2{}, err
+		}
+		return v0.toV1().ToV2(), nil
+	}
+	return HealthInfoV2{}, fmt.Errorf("unsupported health info version %q", version.Version)
+}
+
+// healthInfoV0 - the parts of HealthInfoV0 with an equivalent in later
+// versions, decoded without the types of the MinIO server it uses.
+type healthInfoV0 struct {
+	TimeStamp time.Time `json:"timestamp"`
+	Error     string    `json:"error"`
+	Perf      struct {
+		Drives []struct {
+			Addr     string        `json:"addr"`
+			Serial   []drivePerfV0 `json:"serial"`
+			Parallel []drivePerfV0 `json:"parallel"`
+			Error    string        `json:"error"`
+		} `json:"drives"`
+		Net         []netPerfV0 `json:"net"`
+		NetParallel netPerfV0   `json:"net_parallel"`
+		Error       string      `json:"error"`
+	} `json:"perf"`
+	Minio struct {
+		Info   InfoMessage `json:"info"`
+		Config interface{} `json:"config"`
+		Error  string      `json:"error"`
+	} `json:"minio"`
+	Sys struct {
+		CPUInfo []struct {
+			Addr    string `json:"addr"`
+			CPUStat []struct {
+				VendorID   string   `json:"vendorId"`
+				Family     string   `json:"family"`
+				Model      string   `json:"model"`
+				Stepping   int32    `json:"stepping"`
+				PhysicalID string   `json:"physicalId"`
+				ModelName  string   `json:"modelName"`
+				Mhz        float64  `json:"mhz"`
+				CacheSize  int32    `json:"cacheSize"`
+				Flags      []string `json:"flags"`
+				Microcode  string   `json:"microcode"`
+			} `json:"cpu"`
+			Error string `json:"error"`
+		} `json:"cpus"`
+		DiskHwInfo []struct {
+			Addr  string `json:"addr"`
+			Usage []struct {
+				Path        string `json:"path"`
+				Fstype      string `json:"fstype"`
+				Total       uint64 `json:"total"`
+				Free        uint64 `json:"free"`
+				InodesTotal uint64 `json:"inodesTotal"`
+				InodesFree  uint64 `json:"inodesFree"`
+			} `json:"usages"`
+			Partitions []struct {
+				Device     string `json:"device"`
+				Mountpoint string `json:"mountpoint"`
+				Fstype     string `json:"fstype"`
+				Opts       string `json:"opts"`
+			} `json:"partitions"`
+			Error string `json:"error"`
+		} `json:"drives"`
+		OsInfo  []OSInfo `json:"osinfos"`
+		MemInfo []struct {
+			Addr    string `json:"addr"`
+			SwapMem *struct {
+				Total uint64 `json:"total"`
+				Free  uint64 `json:"free"`
+			} `json:"swap"`
+			VirtualMem *struct {
+				Total     uint64 `json:"total"`
+				Available uint64 `json:"available"`
+			} `json:"virtualmem"`
+			Error string `json:"error"`
+		} `json:"meminfos"`
+		ProcInfo []struct {
+			Addr  string `json:"addr"`
+			Error string `json:"error"`
+		} `json:"procinfos"`
+		Error string `json:"error"`
+	} `json:"sys"`
+}
+
+type latencyV0 struct {
+	Avg          float64 `json:"avg_secs"`
+	Percentile50 float64 `json:"percentile50_secs"`
+	Percentile90 float64 `json:"percentile90_secs"`
+	Percentile99 float64 `json:"percentile99_secs"`
+	Min          float64 `json:"min_secs"`
+	Max          float64 `json:"max_secs"`
+}
+
+func (l latencyV0) toV1() Latency {
+	return Latency{Avg: l.Avg, Max: l.Max, Min: l.Min, Percentile50: l.Percentile50, Percentile90: l.Percentile90, Percentile99: l.Percentile99}
+}
+
+type throughputV0 struct {
+	Avg          float64 `json:"avg_bytes_per_sec"`
+	Percentile50 float64 `json:"percentile50_bytes_per_sec"`
+	Percentile90 float64 `json:"percentile90_bytes_per_sec"`
+	Percentile99 float64 `json:"percentile99_bytes_per_sec"`
+	Min          float64 `json:"min_bytes_per_sec"`
+	Max          float64 `json:"max_bytes_per_sec"`
+}
+
+func (t throughputV0) toV1() Throughput {
+	return Throughput{
+		Avg:          ByteSize(t.Avg),
+		Max:          ByteSize(t.Max),
+		Min:          ByteSize(t.Min),
+		Percentile50: ByteSize(t.Percentile50),
+		Percentile90: ByteSize(t.Percentile90),
+		Percentile99: ByteSize(t.Percentile99),
+	}
+}
+
+type drivePerfV0 struct {
+	Path       string       `json:"endpoint"`
+	Latency    latencyV0    `json:"latency"`
+	Throughput throughputV0 `json:"throughput"`
+	Error      string       `json:"error"`
+}
+
+func drivePerfsToV1(perfs []drivePerfV0) []DrivePerfInfo {
+	var v1 []DrivePerfInfo
+	for _, p := range perfs {
+		v1 = append(v1, DrivePerfInfo{Error: p.Error, Path: p.Path, Latency: p.Latency.toV1(), Throughput: p.Throughput.toV1()})
+	}
+	return v1
+}
+
+type netPerfV0 struct {
+	Addr string `json:"addr"`
+	Net  []struct {
+		Addr       string       `json:"remote"`
+		Latency    latencyV0    `json:"latency"`
+		Throughput throughputV0 `json:"throughput"`
+		Error      string       `json:"error"`
+	} `json:"net"`
+	Error string `json:"error"`
+}
+
+func (n netPerfV0) toV1() NetPerfInfo {
+	v1 := NetPerfInfo{Addr: n.Addr, Error: n.Error}
+	for _, p := range n.Net {
+		v1.RemotePeers = append(v1.RemotePeers, PeerNetPerfInfo{Addr: p.Addr, Error: p.Error, Latency: p.Latency.toV1(), Throughput: p.Throughput.toV1()})
+	}
+	return v1
+}
+
+// toV1 - converts to the version 1 structure, errors of the whole
+// system or performance section are reported as the report error.
+func (v0 healthInfoV0) toV1() HealthInfo {
+	info := HealthInfo{
+		Version:   HealthInfoVersion1,
+		TimeStamp: v0.TimeStamp,
+		Error:     v0.Error,
+		Minio: MinioHealthInfo{
+			Error:  v0.Minio.Error,
+			Config: MinioConfig{Config: v0.Minio.Config},
+			Info:   v0.Minio.Info,
+		},
+	}
+	for _, e := range []string{v0.Sys.Error, v0.Perf.Error} {
+		if info.Error == "" {
+			info.Error = e
+		}
+	}
+
+	for _, n := range v0.Sys.CPUInfo {
+		cpus := CPUs{Addr: n.Addr, Error: n.Error}
+		// Version 0 lists every core, later versions every socket.
+		byID := make(map[string]int)
+		for _, c := range n.CPUStat {
+			if i, ok := byID[c.PhysicalID]; ok {
+				cpus.CPUs[i].Cores++
+				continue
+			}
+			byID[c.PhysicalID] = len(cpus.CPUs)
+			cpus.CPUs = append(cpus.CPUs, CPU{
+				VendorID:   c.VendorID,
+				Family:     c.Family,
+				Model:      c.Model,
+				Stepping:   c.Stepping,
+				PhysicalID: c.PhysicalID,
+				ModelName:  c.ModelName,
+				Mhz:        c.Mhz,
+				CacheSize:  c.CacheSize,
+				Flags:      c.Flags,
+				Microcode:  c.Microcode,
+				Cores:      1,
+			})
+		}
+		info.Sys.CPUInfo = append(info.Sys.CPUInfo, cpus)
+	}
+
+	for _, n := range v0.Sys.DiskHwInfo {
+		parts := Partitions{Addr: n.Addr, Error: n.Error}
+		for _, p := range n.Partitions {
+			part := Partition{
+				Device:           p.Device,
+				Mountpoint:       p.Mountpoint,
+				FSType:           p.Fstype,
+				MountOptions:     p.Opts,
+				MountOptionsList: splitMountOptions(p.Opts),
+			}
+			for _, u := range n.Usage {
+				if u.Path == p.Mountpoint {
+					part.MountFSType = u.Fstype
+					part.SpaceTotal, part.SpaceFree = ByteSize(u.Total), ByteSize(u.Free)
+					part.InodeTotal, part.InodeFree = u.InodesTotal, u.InodesFree
+				}
+			}
+			parts.Partitions = append(parts.Partitions, part)
+		}
+		info.Sys.Partitions = append(info.Sys.Partitions, parts)
+	}
+
+	info.Sys.OSInfo = v0.Sys.OsInfo
+	for _, n := range v0.Sys.MemInfo {
+		m := MemInfo{Addr: n.Addr, Error: n.Error}
+		if n.VirtualMem != nil {
+			m.Total, m.Available = ByteSize(n.VirtualMem.Total), ByteSize(n.VirtualMem.Available)
+		}
+		if n.SwapMem != nil {
+			m.SwapSpaceTotal, m.SwapSpaceFree = ByteSize(n.SwapMem.Total), ByteSize(n.SwapMem.Free)
+		}
+		info.Sys.MemInfo = append(info.Sys.MemInfo, m)
+	}
+	for _, n := range v0.Sys.ProcInfo {
+		info.Sys.ProcInfo = append(info.Sys.ProcInfo, ProcInfo{Addr: n.Addr, Error: n.Error})
+	}
+
+	for _, n := range v0.Perf.Drives {
+		info.Perf.Drives = append(info.Perf.Drives, DrivePerfInfos{
+			Addr:         n.Addr,
+			Error:        n.Error,
+			SerialPerf:   drivePerfsToV1(n.Serial),
+			ParallelPerf: drivePerfsToV1(n.Parallel),
+		})
+	}
+	for _, n := range v0.Perf.Net {
+		info.Perf.Net = append(info.Perf.Net, n.toV1())
+	}
+	info.Perf.NetParallel = v0.Perf.NetParallel.toV1()
+	return info
+}
@@ -0,0 +1,111 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDecodeHealthInfoV2FromV0(t *testing.T) {
+	data := []byte(`{
+		"timestamp": "2021-01-02T03:04:05Z",
+		"sys": {
+			"cpus": [
+				{"addr": "node1", "cpu": [{"physicalId": "0", "modelName": "Xeon"}, {"physicalId": "0", "modelName": "Xeon"}]},
+				{"addr": "node2", "error": "open /proc/cpuinfo: permission denied"}
+			],
+			"drives": [{"addr": "node1", "partitions": [{"device": "/dev/sda1", "mountpoint": "/data", "fstype": "xfs", "opts": "rw,noatime"}], "usages": [{"path": "/data", "fstype": "xfs", "total": 100, "free": 40}]}],
+			"meminfos": [{"addr": "node1", "virtualmem": {"total": 64, "available": 32}, "swap": {"total": 8, "free": 8}}]
+		},
+		"perf": {
+			"drives": [{"addr": "node1", "serial": [{"endpoint": "/data", "latency": {"avg_secs": 0.5}, "throughput": {"avg_bytes_per_sec": 1024}}]}],
+			"net": [{"addr": "node1", "error": "context deadline exceeded"}]
+		}
+	}`)
+	info, err := DecodeHealthInfoV2(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Version != HealthInfoVersion2 {
+		t.Errorf("expected version %q, got %q", HealthInfoVersion2, info.Version)
+	}
+	if len(info.CPU.Nodes) != 1 || len(info.CPU.Nodes[0].CPUs) != 1 || info.CPU.Nodes[0].CPUs[0].Cores != 2 {
+		t.Errorf("unexpected CPUs %+v", info.CPU.Nodes)
+	}
+	if len(info.CPU.Errors) != 1 || info.CPU.Errors[0].Addr != "node2" || info.CPU.Errors[0].Code != ErrPermissionDenied {
+		t.Errorf("unexpected CPU errors %+v", info.CPU.Errors)
+	}
+	if len(info.Partitions.Nodes) != 1 {
+		t.Fatalf("unexpected partitions %+v", info.Partitions.Nodes)
+	}
+	part := info.Partitions.Nodes[0].Partitions[0]
+	if part.Mountpoint != "/data" || part.SpaceTotal != 100 || part.SpaceFree != 40 || len(part.MountOptionsList) != 2 {
+		t.Errorf("unexpected partition %+v", part)
+	}
+	if len(info.Mem.Nodes) != 1 || info.Mem.Nodes[0].Total != 64 || info.Mem.Nodes[0].SwapSpaceTotal != 8 {
+		t.Errorf("unexpected memory %+v", info.Mem.Nodes)
+	}
+	if len(info.Drives.Nodes) != 1 || info.Drives.Nodes[0].SerialPerf[0].Latency.Avg != 0.5 || info.Drives.Nodes[0].SerialPerf[0].Throughput.Avg != 1024 {
+		t.Errorf("unexpected drive perf %+v", info.Drives.Nodes)
+	}
+	if len(info.Net.Errors) != 1 || info.Net.Errors[0].Code != ErrTimeout {
+		t.Errorf("unexpected net errors %+v", info.Net.Errors)
+	}
+	if errs := info.Errors(); len(errs) != 2 {
+		t.Errorf("expected errors of 2 subsystems, got %v", errs)
+	}
+}
+
+func TestDecodeHealthInfoV2FromV1(t *testing.T) {
+	v1 := HealthInfo{Version: HealthInfoVersion1}
+	v1.Sys.MemInfo = []MemInfo{
+		{Addr: "node1", Total: 64},
+		{Addr: "node2", Error: "unsupported operating system"},
+	}
+	data, err := json.Marshal(v1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := DecodeHealthInfoV2(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Mem.Nodes) != 1 || info.Mem.Nodes[0].Addr != "node1" {
+		t.Errorf("unexpected memory %+v", info.Mem.Nodes)
+	}
+	if len(info.Mem.Errors) != 1 || info.Mem.Errors[0].Code != ErrUnsupportedOS {
+		t.Errorf("unexpected memory errors %+v", info.Mem.Errors)
+	}
+
+	// Version 2 reports decode as is.
+	data, err = json.Marshal(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := DecodeHealthInfoV2(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Mem.Errors) != 1 || again.Mem.Errors[0] != info.Mem.Errors[0] {
+		t.Errorf("unexpected memory errors after round trip %+v", again.Mem.Errors)
+	}
+
+	if _, err = DecodeHealthInfoV2([]byte(`{"version": "99"}`)); err == nil {
+		t.Error("expected an error for an unknown version")
+	}
+}
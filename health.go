@@ -20,9 +20,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/url"
 	"runtime"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -45,37 +48,48 @@ const (
 
 // CPU contains system's CPU information.
 type CPU struct {
-	VendorID   string   `json:"vendor_id"`
-	Family     string   `json:"family"`
-	Model      string   `json:"model"`
-	Stepping   int32    `json:"stepping"`
-	PhysicalID string   `json:"physical_id"`
-	ModelName  string   `json:"model_name"`
-	Mhz        float64  `json:"mhz"`
-	CacheSize  int32    `json:"cache_size"`
-	Flags      []string `json:"flags"`
-	Microcode  string   `json:"microcode"`
-	Cores      int      `json:"cores"` // computed
+	VendorID   string   `json:"vendor_id" yaml:"vendor_id"`
+	Family     string   `json:"family" yaml:"family"`
+	Model      string   `json:"model" yaml:"model"`
+	Stepping   int32    `json:"stepping" yaml:"stepping"`
+	PhysicalID string   `json:"physical_id" yaml:"physical_id"`
+	ModelName  string   `json:"model_name" yaml:"model_name"`
+	Mhz        float64  `json:"mhz" yaml:"mhz"`
+	CacheSize  int32    `json:"cache_size" yaml:"cache_size"`
+	Flags      []string `json:"flags" yaml:"flags"`
+	Microcode  string   `json:"microcode" yaml:"microcode"`
+	Cores      int      `json:"cores" yaml:"cores"` // computed
+	// CoreIDs are the physical cores of the socket.
+	CoreIDs []string `json:"core_ids,omitempty" yaml:"core_ids,omitempty"`
 }
 
 // CPUs contains all CPU information of a node.
 type CPUs struct {
-	Addr  string `json:"addr"`
-	Error string `json:"error,omitempty"`
-
-	CPUs []CPU `json:"cpus,omitempty"`
+	Addr      string          `json:"addr" yaml:"addr"`
+	Error     string          `json:"error,omitempty" yaml:"error,omitempty"`
+	ErrorCode HealthErrorCode `json:"error_code,omitempty" yaml:"error_code,omitempty"`
+
+	CPUs []CPU `json:"cpus,omitempty" yaml:"cpus,omitempty"`
+	// LogicalCPUs and NUMANodes are only reported on linux.
+	LogicalCPUs []LogicalCPU `json:"logical_cpus,omitempty" yaml:"logical_cpus,omitempty"`
+	NUMANodes   []NUMANode   `json:"numa_nodes,omitempty" yaml:"numa_nodes,omitempty"`
 }
 
 // GetCPUs returns system's all CPU information.
-func GetCPUs(ctx context.Context, addr string) CPUs {
+func GetCPUs(ctx context.Context, addr string) (result CPUs) {
+	defer finishHealthCollector(addr, &result.Addr, &result.Error, &result.ErrorCode)
+
 	infos, err := cpu.InfoWithContext(ctx)
 	if err != nil {
 		return CPUs{
-			Addr:  addr,
-			Error: err.Error(),
+			Addr:      addr,
+			Error:     err.Error(),
+			ErrorCode: healthErrorCodeOf(err),
 		}
 	}
 
+	enrichCPUInfos(infos)
+
 	cpuMap := map[string][]cpu.InfoStat{}
 	for _, info := range infos {
 		infoStats, found := cpuMap[info.PhysicalID]
@@ -87,6 +101,14 @@ func GetCPUs(ctx context.Context, addr string) CPUs {
 
 	cpus := []CPU{}
 	for _, infoStats := range cpuMap {
+		coreIDs := []string{}
+		seen := map[string]bool{}
+		for _, info := range infoStats {
+			if info.CoreID != "" && !seen[info.CoreID] {
+				seen[info.CoreID] = true
+				coreIDs = append(coreIDs, info.CoreID)
+			}
+		}
 		cpus = append(cpus, CPU{
 			VendorID:   infoStats[0].VendorID,
 			Family:     infoStats[0].Family,
@@ -99,52 +121,78 @@ func GetCPUs(ctx context.Context, addr string) CPUs {
 			Flags:      infoStats[0].Flags,
 			Microcode:  infoStats[0].Microcode,
 			Cores:      len(infoStats),
+			CoreIDs:    coreIDs,
 		})
 	}
 
+	logicalCPUs, numaNodes := cpuTopology(infos)
 	return CPUs{
-		Addr: addr,
-		CPUs: cpus,
+		Addr:        addr,
+		CPUs:        cpus,
+		LogicalCPUs: logicalCPUs,
+		NUMANodes:   numaNodes,
 	}
 }
 
 // Partition contains disk partition's information.
 type Partition struct {
-	Error string `json:"error,omitempty"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Device           string   `json:"device,omitempty" yaml:"device,omitempty"`
+	Mountpoint       string   `json:"mountpoint,omitempty" yaml:"mountpoint,omitempty"`
+	FSType           string   `json:"fs_type,omitempty" yaml:"fs_type,omitempty"`
+	MountOptions     string   `json:"mount_options,omitempty" yaml:"mount_options,omitempty" deprecated:"since=1,use=mount_options_list"`
+	MountOptionsList []string `json:"mount_options_list,omitempty" yaml:"mount_options_list,omitempty"`
+	MountFSType      string   `json:"mount_fs_type,omitempty" yaml:"mount_fs_type,omitempty"`
+	SpaceTotal       ByteSize `json:"space_total,omitempty" yaml:"space_total,omitempty"`
+	SpaceFree        ByteSize `json:"space_free,omitempty" yaml:"space_free,omitempty"`
+	InodeTotal       uint64   `json:"inode_total,omitempty" yaml:"inode_total,omitempty"`
+	InodeFree        uint64   `json:"inode_free,omitempty" yaml:"inode_free,omitempty"`
+
+	// Block device properties, only collected on linux.
+	IsRotational bool   `json:"is_rotational,omitempty" yaml:"is_rotational,omitempty"`
+	Scheduler    string `json:"scheduler,omitempty" yaml:"scheduler,omitempty"`
+	Model        string `json:"model,omitempty" yaml:"model,omitempty"`
+	Serial       string `json:"serial,omitempty" yaml:"serial,omitempty"`
+	Discard      bool   `json:"discard,omitempty" yaml:"discard,omitempty"`
+}
 
-	Device       string `json:"device,omitempty"`
-	Mountpoint   string `json:"mountpoint,omitempty"`
-	FSType       string `json:"fs_type,omitempty"`
-	MountOptions string `json:"mount_options,omitempty"`
-	MountFSType  string `json:"mount_fs_type,omitempty"`
-	SpaceTotal   uint64 `json:"space_total,omitempty"`
-	SpaceFree    uint64 `json:"space_free,omitempty"`
-	InodeTotal   uint64 `json:"inode_total,omitempty"`
-	InodeFree    uint64 `json:"inode_free,omitempty"`
+// splitMountOptions - splits comma separated mount options, returns nil
+// if there are none.
+func splitMountOptions(opts string) []string {
+	if opts == "" {
+		return nil
+	}
+	return strings.Split(opts, ",")
 }
 
 // Partitions contains all disk partitions information of a node.
 type Partitions struct {
-	Addr  string `json:"addr"`
-	Error string `json:"error,omitempty"`
+	Addr      string          `json:"addr" yaml:"addr"`
+	Error     string          `json:"error,omitempty" yaml:"error,omitempty"`
+	ErrorCode HealthErrorCode `json:"error_code,omitempty" yaml:"error_code,omitempty"`
 
-	Partitions []Partition `json:"partitions,omitempty"`
+	Partitions []Partition `json:"partitions,omitempty" yaml:"partitions,omitempty"`
 }
 
-// GetPartitions returns all disk partitions information of a node running linux only operating system.
-func GetPartitions(ctx context.Context, addr string) Partitions {
-	if runtime.GOOS != "linux" {
+// GetPartitions returns all disk partitions information of a node running linux, windows or macOS.
+func GetPartitions(ctx context.Context, addr string) (result Partitions) {
+	defer finishHealthCollector(addr, &result.Addr, &result.Error, &result.ErrorCode)
+
+	if !healthOSSupported() {
 		return Partitions{
-			Addr:  addr,
-			Error: "unsupported operating system " + runtime.GOOS,
+			Addr:      addr,
+			Error:     "unsupported operating system " + runtime.GOOS,
+			ErrorCode: ErrUnsupportedOS,
 		}
 	}
 
 	parts, err := disk.PartitionsWithContext(ctx, false)
 	if err != nil {
 		return Partitions{
-			Addr:  addr,
-			Error: err.Error(),
+			Addr:      addr,
+			Error:     err.Error(),
+			ErrorCode: healthErrorCodeOf(err),
 		}
 	}
 
@@ -158,17 +206,22 @@ func GetPartitions(ctx context.Context, addr string) Partitions {
 				Error:  err.Error(),
 			})
 		} else {
-			partitions = append(partitions, Partition{
-				Device:       parts[i].Device,
-				Mountpoint:   parts[i].Mountpoint,
-				FSType:       parts[i].Fstype,
-				MountOptions: parts[i].Opts,
-				MountFSType:  usage.Fstype,
-				SpaceTotal:   usage.Total,
-				SpaceFree:    usage.Free,
-				InodeTotal:   usage.InodesTotal,
-				InodeFree:    usage.InodesFree,
-			})
+			partition := Partition{
+				Device:           parts[i].Device,
+				Mountpoint:       parts[i].Mountpoint,
+				FSType:           parts[i].Fstype,
+				MountOptions:     parts[i].Opts,
+				MountOptionsList: splitMountOptions(parts[i].Opts),
+				MountFSType:      usage.Fstype,
+				SpaceTotal:       ByteSize(usage.Total),
+				SpaceFree:        ByteSize(usage.Free),
+				InodeTotal:       usage.InodesTotal,
+				InodeFree:        usage.InodesFree,
+			}
+			if runtime.GOOS == "linux" {
+				blockDeviceInfo(&partition)
+			}
+			partitions = append(partitions, partition)
 		}
 	}
 
@@ -178,38 +231,59 @@ func GetPartitions(ctx context.Context, addr string) Partitions {
 	}
 }
 
+// healthOSSupported - returns true if gopsutil collects partitions and
+// OS information on this operating system.
+func healthOSSupported() bool {
+	switch runtime.GOOS {
+	case "linux", "windows", "darwin":
+		return true
+	}
+	return false
+}
+
 // OSInfo contains operating system's information.
 type OSInfo struct {
-	Addr  string `json:"addr"`
-	Error string `json:"error,omitempty"`
-
-	Info    host.InfoStat          `json:"info,omitempty"`
-	Sensors []host.TemperatureStat `json:"sensors,omitempty"`
+	Addr      string          `json:"addr" yaml:"addr"`
+	Error     string          `json:"error,omitempty" yaml:"error,omitempty"`
+	ErrorCode HealthErrorCode `json:"error_code,omitempty" yaml:"error_code,omitempty"`
+
+	Info    host.InfoStat          `json:"info,omitempty" yaml:"info,omitempty"`
+	Sensors []host.TemperatureStat `json:"sensors,omitempty" yaml:"sensors,omitempty"`
+	// Power and ThermalThrottles are only reported with
+	// HealthDataTypeSysPower, see AddPowerThermal.
+	Power            []PowerReading `json:"power,omitempty" yaml:"power,omitempty"`
+	ThermalThrottles uint64         `json:"thermal_throttles,omitempty" yaml:"thermal_throttles,omitempty"`
 }
 
-// GetOSInfo returns linux only operating system's information.
-func GetOSInfo(ctx context.Context, addr string) OSInfo {
-	if runtime.GOOS != "linux" {
+// GetOSInfo returns operating system's information, sensors are only
+// reported where gopsutil supports them.
+func GetOSInfo(ctx context.Context, addr string) (result OSInfo) {
+	defer finishHealthCollector(addr, &result.Addr, &result.Error, &result.ErrorCode)
+
+	if !healthOSSupported() {
 		return OSInfo{
-			Addr:  addr,
-			Error: "unsupported operating system " + runtime.GOOS,
+			Addr:      addr,
+			Error:     "unsupported operating system " + runtime.GOOS,
+			ErrorCode: ErrUnsupportedOS,
 		}
 	}
 
 	info, err := host.InfoWithContext(ctx)
 	if err != nil {
 		return OSInfo{
-			Addr:  addr,
-			Error: err.Error(),
+			Addr:      addr,
+			Error:     err.Error(),
+			ErrorCode: healthErrorCodeOf(err),
 		}
 	}
 
 	sensors, err := host.SensorsTemperaturesWithContext(ctx)
-	if err != nil {
+	if err != nil && runtime.GOOS == "linux" {
 		if _, isWarningErr := err.(*host.Warnings); !isWarningErr {
 			return OSInfo{
-				Addr:  addr,
-				Error: err.Error(),
+				Addr:      addr,
+				Error:     err.Error(),
+				ErrorCode: healthErrorCodeOf(err),
 			}
 		}
 	}
@@ -223,435 +297,402 @@ func GetOSInfo(ctx context.Context, addr string) OSInfo {
 
 // MemInfo contains system's RAM and swap information.
 type MemInfo struct {
-	Addr  string `json:"addr"`
-	Error string `json:"error,omitempty"`
-
-	Total          uint64 `json:"total,omitempty"`
-	Available      uint64 `json:"available,omitempty"`
-	SwapSpaceTotal uint64 `json:"swap_space_total,omitempty"`
-	SwapSpaceFree  uint64 `json:"swap_space_free,omitempty"`
+	Addr      string          `json:"addr" yaml:"addr"`
+	Error     string          `json:"error,omitempty" yaml:"error,omitempty"`
+	ErrorCode HealthErrorCode `json:"error_code,omitempty" yaml:"error_code,omitempty"`
+
+	Total          ByteSize `json:"total,omitempty" yaml:"total,omitempty"`
+	Available      ByteSize `json:"available,omitempty" yaml:"available,omitempty"`
+	SwapSpaceTotal ByteSize `json:"swap_space_total,omitempty" yaml:"swap_space_total,omitempty"`
+	SwapSpaceFree  ByteSize `json:"swap_space_free,omitempty" yaml:"swap_space_free,omitempty"`
 }
 
 // GetMemInfo returns system's RAM and swap information.
-func GetMemInfo(ctx context.Context, addr string) MemInfo {
+func GetMemInfo(ctx context.Context, addr string) (result MemInfo) {
+	defer finishHealthCollector(addr, &result.Addr, &result.Error, &result.ErrorCode)
+
 	meminfo, err := mem.VirtualMemoryWithContext(ctx)
 	if err != nil {
 		return MemInfo{
-			Addr:  addr,
-			Error: err.Error(),
+			Addr:      addr,
+			Error:     err.Error(),
+			ErrorCode: healthErrorCodeOf(err),
 		}
 	}
 
 	swapinfo, err := mem.SwapMemoryWithContext(ctx)
 	if err != nil {
 		return MemInfo{
-			Addr:  addr,
-			Error: err.Error(),
+			Addr:      addr,
+			Error:     err.Error(),
+			ErrorCode: healthErrorCodeOf(err),
 		}
 	}
 
 	return MemInfo{
 		Addr:           addr,
-		Total:          meminfo.Total,
-		Available:      meminfo.Available,
-		SwapSpaceTotal: swapinfo.Total,
-		SwapSpaceFree:  swapinfo.Free,
+		Total:          ByteSize(meminfo.Total),
+		Available:      ByteSize(meminfo.Available),
+		SwapSpaceTotal: ByteSize(swapinfo.Total),
+		SwapSpaceFree:  ByteSize(swapinfo.Free),
 	}
 }
 
 // ProcInfo contains current process's information.
 type ProcInfo struct {
-	Addr  string `json:"addr"`
-	Error string `json:"error,omitempty"`
-
-	PID            int32                      `json:"pid,omitempty"`
-	IsBackground   bool                       `json:"is_background,omitempty"`
-	CPUPercent     float64                    `json:"cpu_percent,omitempty"`
-	ChildrenPIDs   []int32                    `json:"children_pids,omitempty"`
-	CmdLine        string                     `json:"cmd_line,omitempty"`
-	NumConnections int                        `json:"num_connections,omitempty"`
-	CreateTime     int64                      `json:"create_time,omitempty"`
-	CWD            string                     `json:"cwd,omitempty"`
-	ExecPath       string                     `json:"exec_path,omitempty"`
-	GIDs           []int32                    `json:"gids,omitempty"`
-	IOCounters     process.IOCountersStat     `json:"iocounters,omitempty"`
-	NetIOCounters  []net.IOCountersStat       `json:"net_iocounters,omitempty"`
-	IsRunning      bool                       `json:"is_running,omitempty"`
-	MemInfo        process.MemoryInfoStat     `json:"mem_info,omitempty"`
-	MemMaps        []process.MemoryMapsStat   `json:"mem_maps,omitempty"`
-	MemPercent     float32                    `json:"mem_percent,omitempty"`
-	Name           string                     `json:"name,omitempty"`
-	Nice           int32                      `json:"nice,omitempty"`
-	NumCtxSwitches process.NumCtxSwitchesStat `json:"num_ctx_switches,omitempty"`
-	NumFDs         int32                      `json:"num_fds,omitempty"`
-	NumThreads     int32                      `json:"num_threads,omitempty"`
-	PageFaults     process.PageFaultsStat     `json:"page_faults,omitempty"`
-	PPID           int32                      `json:"ppid,omitempty"`
-	Status         string                     `json:"status,omitempty"`
-	TGID           int32                      `json:"tgid,omitempty"`
-	Times          cpu.TimesStat              `json:"times,omitempty"`
-	UIDs           []int32                    `json:"uids,omitempty"`
-	Username       string                     `json:"username,omitempty"`
-	Rlimit         []process.RlimitStat       `json:"rlimit,omitempty"`
+	Addr      string          `json:"addr" yaml:"addr"`
+	Error     string          `json:"error,omitempty" yaml:"error,omitempty"`
+	ErrorCode HealthErrorCode `json:"error_code,omitempty" yaml:"error_code,omitempty"`
+	// Errors of single fields, keyed by their JSON name, only set by
+	// GetProcInfoBestEffort.
+	Errors map[string]string `json:"errors,omitempty" yaml:"errors,omitempty"`
+
+	PID            int32                      `json:"pid,omitempty" yaml:"pid,omitempty"`
+	IsBackground   bool                       `json:"is_background,omitempty" yaml:"is_background,omitempty"`
+	CPUPercent     float64                    `json:"cpu_percent,omitempty" yaml:"cpu_percent,omitempty"`
+	ChildrenPIDs   []int32                    `json:"children_pids,omitempty" yaml:"children_pids,omitempty"`
+	CmdLine        string                     `json:"cmd_line,omitempty" yaml:"cmd_line,omitempty"`
+	NumConnections int                        `json:"num_connections,omitempty" yaml:"num_connections,omitempty"`
+	CreateTime     UnixMillis                 `json:"create_time,omitempty" yaml:"create_time,omitempty"`
+	CWD            string                     `json:"cwd,omitempty" yaml:"cwd,omitempty"`
+	ExecPath       string                     `json:"exec_path,omitempty" yaml:"exec_path,omitempty"`
+	GIDs           []int32                    `json:"gids,omitempty" yaml:"gids,omitempty"`
+	IOCounters     process.IOCountersStat     `json:"iocounters,omitempty" yaml:"iocounters,omitempty"`
+	NetIOCounters  []net.IOCountersStat       `json:"net_iocounters,omitempty" yaml:"net_iocounters,omitempty"`
+	IsRunning      bool                       `json:"is_running,omitempty" yaml:"is_running,omitempty"`
+	MemInfo        process.MemoryInfoStat     `json:"mem_info,omitempty" yaml:"mem_info,omitempty"`
+	MemMaps        []process.MemoryMapsStat   `json:"mem_maps,omitempty" yaml:"mem_maps,omitempty"`
+	MemPercent     float32                    `json:"mem_percent,omitempty" yaml:"mem_percent,omitempty"`
+	Name           string                     `json:"name,omitempty" yaml:"name,omitempty"`
+	Nice           int32                      `json:"nice,omitempty" yaml:"nice,omitempty"`
+	NumCtxSwitches process.NumCtxSwitchesStat `json:"num_ctx_switches,omitempty" yaml:"num_ctx_switches,omitempty"`
+	NumFDs         int32                      `json:"num_fds,omitempty" yaml:"num_fds,omitempty"`
+	NumThreads     int32                      `json:"num_threads,omitempty" yaml:"num_threads,omitempty"`
+	PageFaults     process.PageFaultsStat     `json:"page_faults,omitempty" yaml:"page_faults,omitempty"`
+	PPID           int32                      `json:"ppid,omitempty" yaml:"ppid,omitempty"`
+	Status         string                     `json:"status,omitempty" yaml:"status,omitempty"`
+	TGID           int32                      `json:"tgid,omitempty" yaml:"tgid,omitempty"`
+	Times          cpu.TimesStat              `json:"times,omitempty" yaml:"times,omitempty"`
+	UIDs           []int32                    `json:"uids,omitempty" yaml:"uids,omitempty"`
+	Username       string                     `json:"username,omitempty" yaml:"username,omitempty"`
+	Rlimit         []process.RlimitStat       `json:"rlimit,omitempty" yaml:"rlimit,omitempty"`
 }
 
 // GetProcInfo returns current MinIO process information.
 func GetProcInfo(ctx context.Context, addr string) ProcInfo {
+	return getProcInfo(ctx, addr, false)
+}
+
+// GetProcInfoBestEffort - like GetProcInfo, but keeps collecting when a
+// field fails, i.e. mem_maps without extra privileges in containers.
+// The error of every such field is recorded in Errors.
+func GetProcInfoBestEffort(ctx context.Context, addr string) ProcInfo {
+	return getProcInfo(ctx, addr, true)
+}
+
+func getProcInfo(ctx context.Context, addr string, bestEffort bool) (info ProcInfo) {
+	defer finishHealthCollector(addr, &info.Addr, &info.Error, &info.ErrorCode)
+
 	pid := int32(syscall.Getpid())
 	proc, err := process.NewProcess(pid)
 	if err != nil {
 		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
+			Addr:      addr,
+			Error:     err.Error(),
+			ErrorCode: healthErrorCodeOf(err),
 		}
 	}
 
-	isBackground, err := proc.BackgroundWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
+	info = ProcInfo{Addr: addr, PID: pid}
+	// failed - records the error of a field, returns true if the
+	// collection must stop.
+	failed := func(field string, err error) bool {
+		if err == nil {
+			return false
+		}
+		if !bestEffort {
+			info = ProcInfo{
+				Addr:      addr,
+				Error:     err.Error(),
+				ErrorCode: healthErrorCodeOf(err),
+			}
+			return true
 		}
+		if info.Errors == nil {
+			info.Errors = make(map[string]string)
+		}
+		info.Errors[field] = err.Error()
+		return false
 	}
 
-	cpuPercent, err := proc.CPUPercentWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.IsBackground, err = proc.BackgroundWithContext(ctx); failed("is_background", err) {
+		return info
+	}
+	if info.CPUPercent, err = proc.CPUPercentWithContext(ctx); failed("cpu_percent", err) {
+		return info
 	}
 
 	children, _ := proc.ChildrenWithContext(ctx)
-	childrenPIDs := []int32{}
+	info.ChildrenPIDs = []int32{}
 	for i := range children {
-		childrenPIDs = append(childrenPIDs, children[i].Pid)
+		info.ChildrenPIDs = append(info.ChildrenPIDs, children[i].Pid)
 	}
 
-	cmdLine, err := proc.CmdlineWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.CmdLine, err = proc.CmdlineWithContext(ctx); failed("cmd_line", err) {
+		return info
 	}
 
 	connections, err := proc.ConnectionsWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if failed("num_connections", err) {
+		return info
 	}
-	numConnections := len(connections)
+	info.NumConnections = len(connections)
 
 	createTime, err := proc.CreateTimeWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if failed("create_time", err) {
+		return info
 	}
+	info.CreateTime = UnixMillis(createTime)
 
-	cwd, err := proc.CwdWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.CWD, err = proc.CwdWithContext(ctx); failed("cwd", err) {
+		return info
 	}
-
-	execPath, err := proc.ExeWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.ExecPath, err = proc.ExeWithContext(ctx); failed("exec_path", err) {
+		return info
 	}
-
-	gids, err := proc.GidsWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.GIDs, err = proc.GidsWithContext(ctx); failed("gids", err) {
+		return info
 	}
 
 	ioCounters, err := proc.IOCountersWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if failed("iocounters", err) {
+		return info
 	}
-
-	netIOCounters, err := proc.NetIOCountersWithContext(ctx, true)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if ioCounters != nil {
+		info.IOCounters = *ioCounters
 	}
 
-	isRunning, err := proc.IsRunningWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.NetIOCounters, err = proc.NetIOCountersWithContext(ctx, true); failed("net_iocounters", err) {
+		return info
+	}
+	if info.IsRunning, err = proc.IsRunningWithContext(ctx); failed("is_running", err) {
+		return info
 	}
 
 	memInfo, err := proc.MemoryInfoWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if failed("mem_info", err) {
+		return info
+	}
+	if memInfo != nil {
+		info.MemInfo = *memInfo
 	}
 
 	memMaps, err := proc.MemoryMapsWithContext(ctx, true)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if failed("mem_maps", err) {
+		return info
 	}
-
-	memPercent, err := proc.MemoryPercentWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if memMaps != nil {
+		info.MemMaps = *memMaps
 	}
 
-	name, err := proc.NameWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.MemPercent, err = proc.MemoryPercentWithContext(ctx); failed("mem_percent", err) {
+		return info
 	}
-
-	nice, err := proc.NiceWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.Name, err = proc.NameWithContext(ctx); failed("name", err) {
+		return info
+	}
+	if info.Nice, err = proc.NiceWithContext(ctx); failed("nice", err) {
+		return info
 	}
 
 	numCtxSwitches, err := proc.NumCtxSwitchesWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if failed("num_ctx_switches", err) {
+		return info
 	}
-
-	numFDs, err := proc.NumFDsWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if numCtxSwitches != nil {
+		info.NumCtxSwitches = *numCtxSwitches
 	}
 
-	numThreads, err := proc.NumThreadsWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.NumFDs, err = proc.NumFDsWithContext(ctx); failed("num_fds", err) {
+		return info
+	}
+	if info.NumThreads, err = proc.NumThreadsWithContext(ctx); failed("num_threads", err) {
+		return info
 	}
 
 	pageFaults, err := proc.PageFaultsWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if failed("page_faults", err) {
+		return info
+	}
+	if pageFaults != nil {
+		info.PageFaults = *pageFaults
 	}
 
-	ppid, _ := proc.PpidWithContext(ctx)
+	info.PPID, _ = proc.PpidWithContext(ctx)
 
-	status, err := proc.StatusWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.Status, err = proc.StatusWithContext(ctx); failed("status", err) {
+		return info
 	}
-
-	tgid, err := proc.Tgid()
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.TGID, err = proc.Tgid(); failed("tgid", err) {
+		return info
 	}
 
 	times, err := proc.TimesWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if failed("times", err) {
+		return info
 	}
-
-	uids, err := proc.UidsWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if times != nil {
+		info.Times = *times
 	}
 
-	username, err := proc.UsernameWithContext(ctx)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.UIDs, err = proc.UidsWithContext(ctx); failed("uids", err) {
+		return info
 	}
-
-	rlimit, err := proc.RlimitUsageWithContext(ctx, true)
-	if err != nil {
-		return ProcInfo{
-			Addr:  addr,
-			Error: err.Error(),
-		}
+	if info.Username, err = proc.UsernameWithContext(ctx); failed("username", err) {
+		return info
 	}
-
-	return ProcInfo{
-		Addr:           addr,
-		PID:            pid,
-		IsBackground:   isBackground,
-		CPUPercent:     cpuPercent,
-		ChildrenPIDs:   childrenPIDs,
-		CmdLine:        cmdLine,
-		NumConnections: numConnections,
-		CreateTime:     createTime,
-		CWD:            cwd,
-		ExecPath:       execPath,
-		GIDs:           gids,
-		IOCounters:     *ioCounters,
-		NetIOCounters:  netIOCounters,
-		IsRunning:      isRunning,
-		MemInfo:        *memInfo,
-		MemMaps:        *memMaps,
-		MemPercent:     memPercent,
-		Name:           name,
-		Nice:           nice,
-		NumCtxSwitches: *numCtxSwitches,
-		NumFDs:         numFDs,
-		NumThreads:     numThreads,
-		PageFaults:     *pageFaults,
-		PPID:           ppid,
-		Status:         status,
-		TGID:           tgid,
-		Times:          *times,
-		UIDs:           uids,
-		Username:       username,
-		Rlimit:         rlimit,
+	if info.Rlimit, err = proc.RlimitUsageWithContext(ctx, true); failed("rlimit", err) {
+		return info
 	}
+	return info
 }
 
 // SysInfo - Includes hardware and system information of the MinIO cluster
 type SysInfo struct {
-	CPUInfo    []CPUs       `json:"cpus,omitempty"`
-	Partitions []Partitions `json:"partitions,omitempty"`
-	OSInfo     []OSInfo     `json:"osinfo,omitempty"`
-	MemInfo    []MemInfo    `json:"meminfo,omitempty"`
-	ProcInfo   []ProcInfo   `json:"procinfo,omitempty"`
+	CPUInfo    []CPUs       `json:"cpus,omitempty" yaml:"cpus,omitempty"`
+	Partitions []Partitions `json:"partitions,omitempty" yaml:"partitions,omitempty"`
+	OSInfo     []OSInfo     `json:"osinfo,omitempty" yaml:"osinfo,omitempty"`
+	MemInfo    []MemInfo    `json:"meminfo,omitempty" yaml:"meminfo,omitempty"`
+	ProcInfo   []ProcInfo   `json:"procinfo,omitempty" yaml:"procinfo,omitempty"`
+	// ParallelFS is only collected for HealthDataTypeSysParallelFS.
+	ParallelFS []ParallelFSInfo `json:"parallelfs,omitempty" yaml:"parallelfs,omitempty"`
+	// NASMounts is only collected for HealthDataTypeSysNASMounts.
+	NASMounts []NASMounts `json:"nasmounts,omitempty" yaml:"nasmounts,omitempty"`
+	// NetHw is collected for HealthDataTypeSysNet.
+	NetHw []SysNetHw `json:"nethw,omitempty" yaml:"nethw,omitempty"`
+	// DrivesSMART is collected along with Partitions for
+	// HealthDataTypeSysDriveHw.
+	DrivesSMART []DrivesSMART `json:"drives_smart,omitempty" yaml:"drives_smart,omitempty"`
+	// Services is only collected for HealthDataTypeSysServices.
+	Services []SysServices `json:"services,omitempty" yaml:"services,omitempty"`
+	// Kubernetes is only collected for HealthDataTypeSysKubernetes.
+	Kubernetes []SysKubernetes `json:"kubernetes,omitempty" yaml:"kubernetes,omitempty"`
+	// TimeInfo is only collected for HealthDataTypeSysTime.
+	TimeInfo []TimeInfo `json:"timeinfo,omitempty" yaml:"timeinfo,omitempty"`
+	// DualStack is only collected for HealthDataTypeSysDualStack.
+	DualStack []DualStackInfo `json:"dualstack,omitempty" yaml:"dualstack,omitempty"`
+	// TLSTrust is only collected for HealthDataTypeSysTLSTrust.
+	TLSTrust []TLSTrustInfo `json:"tlstrust,omitempty" yaml:"tlstrust,omitempty"`
 }
 
 // Latency contains write operation latency in seconds of a disk drive.
 type Latency struct {
-	Avg          float64 `json:"avg"`
-	Max          float64 `json:"max"`
-	Min          float64 `json:"min"`
-	Percentile50 float64 `json:"percentile_50"`
-	Percentile90 float64 `json:"percentile_90"`
-	Percentile99 float64 `json:"percentile_99"`
+	Avg          float64 `json:"avg" yaml:"avg"`
+	Max          float64 `json:"max" yaml:"max"`
+	Min          float64 `json:"min" yaml:"min"`
+	Percentile50 float64 `json:"percentile_50" yaml:"percentile_50"`
+	Percentile90 float64 `json:"percentile_90" yaml:"percentile_90"`
+	Percentile99 float64 `json:"percentile_99" yaml:"percentile_99"`
 }
 
 // Throughput contains write performance in bytes per second of a disk drive.
 type Throughput struct {
-	Avg          uint64 `json:"avg"`
-	Max          uint64 `json:"max"`
-	Min          uint64 `json:"min"`
-	Percentile50 uint64 `json:"percentile_50"`
-	Percentile90 uint64 `json:"percentile_90"`
-	Percentile99 uint64 `json:"percentile_99"`
+	Avg          ByteSize `json:"avg" yaml:"avg"`
+	Max          ByteSize `json:"max" yaml:"max"`
+	Min          ByteSize `json:"min" yaml:"min"`
+	Percentile50 ByteSize `json:"percentile_50" yaml:"percentile_50"`
+	Percentile90 ByteSize `json:"percentile_90" yaml:"percentile_90"`
+	Percentile99 ByteSize `json:"percentile_99" yaml:"percentile_99"`
 }
 
 // DrivePerfInfo contains disk drive's performance information.
 type DrivePerfInfo struct {
-	Error string `json:"error,omitempty"`
-
-	Path       string     `json:"path"`
-	Latency    Latency    `json:"latency,omitempty"`
-	Throughput Throughput `json:"throughput,omitempty"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Path       string     `json:"path" yaml:"path"`
+	Latency    Latency    `json:"latency,omitempty" yaml:"latency,omitempty"`
+	Throughput Throughput `json:"throughput,omitempty" yaml:"throughput,omitempty"`
+	// LatencySeries is optionally reported in addition to the summary
+	// of Latency.
+	LatencySeries *LatencySeries `json:"latency_series,omitempty" yaml:"latency_series,omitempty"`
+	// Mode is the workload measured, empty for DrivePerfSeqWrite as
+	// reported by servers without support for other modes.
+	Mode DrivePerfMode `json:"mode,omitempty" yaml:"mode,omitempty"`
+	// IOPS is reported for the random and mixed modes.
+	IOPS float64 `json:"iops,omitempty" yaml:"iops,omitempty"`
+	// Percentiles and Histogram are only reported if requested with
+	// HealthInfoOpts.Percentiles and HealthInfoOpts.Histogram.
+	Percentiles []PerfPercentile `json:"percentiles,omitempty" yaml:"percentiles,omitempty"`
+	Histogram   *Histogram       `json:"histogram,omitempty" yaml:"histogram,omitempty"`
 }
 
 // DrivePerfInfos contains all disk drive's performance information of a node.
 type DrivePerfInfos struct {
-	Addr  string `json:"addr"`
-	Error string `json:"error,omitempty"`
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
 
-	SerialPerf   []DrivePerfInfo `json:"serial_perf,omitempty"`
-	ParallelPerf []DrivePerfInfo `json:"parallel_perf,omitempty"`
+	SerialPerf   []DrivePerfInfo `json:"serial_perf,omitempty" yaml:"serial_perf,omitempty"`
+	ParallelPerf []DrivePerfInfo `json:"parallel_perf,omitempty" yaml:"parallel_perf,omitempty"`
 }
 
 // PeerNetPerfInfo contains network performance information of a node.
 type PeerNetPerfInfo struct {
-	Addr  string `json:"addr"`
-	Error string `json:"error,omitempty"`
-
-	Latency    Latency    `json:"latency,omitempty"`
-	Throughput Throughput `json:"throughput,omitempty"`
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Latency    Latency    `json:"latency,omitempty" yaml:"latency,omitempty"`
+	Throughput Throughput `json:"throughput,omitempty" yaml:"throughput,omitempty"`
+	// Percentiles and Histogram are only reported if requested with
+	// HealthInfoOpts.Percentiles and HealthInfoOpts.Histogram.
+	Percentiles []PerfPercentile `json:"percentiles,omitempty" yaml:"percentiles,omitempty"`
+	Histogram   *Histogram       `json:"histogram,omitempty" yaml:"histogram,omitempty"`
 }
 
 // NetPerfInfo contains network performance information of a node to other nodes.
 type NetPerfInfo struct {
-	Addr  string `json:"addr"`
-	Error string `json:"error,omitempty"`
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
 
-	RemotePeers []PeerNetPerfInfo `json:"remote_peers,omitempty"`
+	RemotePeers []PeerNetPerfInfo `json:"remote_peers,omitempty" yaml:"remote_peers,omitempty"`
 }
 
 // PerfInfo - Includes Drive and Net perf info for the entire MinIO cluster
 type PerfInfo struct {
-	Drives      []DrivePerfInfos `json:"drives,omitempty"`
-	Net         []NetPerfInfo    `json:"net,omitempty"`
-	NetParallel NetPerfInfo      `json:"net_parallel,omitempty"`
+	Drives      []DrivePerfInfos `json:"drives,omitempty" yaml:"drives,omitempty"`
+	Net         []NetPerfInfo    `json:"net,omitempty" yaml:"net,omitempty"`
+	NetParallel NetPerfInfo      `json:"net_parallel,omitempty" yaml:"net_parallel,omitempty"`
 }
 
 // MinioConfig contains minio configuration of a node.
 type MinioConfig struct {
-	Error string `json:"error,omitempty"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
 
-	Config interface{} `json:"config,omitempty"`
+	Config interface{} `json:"config,omitempty" yaml:"config,omitempty"`
 }
 
 // MinioHealthInfo - Includes MinIO confifuration information
 type MinioHealthInfo struct {
-	Error string `json:"error,omitempty"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Config MinioConfig `json:"config,omitempty" yaml:"config,omitempty"`
+	Info   InfoMessage `json:"info,omitempty" yaml:"info,omitempty"`
 
-	Config MinioConfig `json:"config,omitempty"`
-	Info   InfoMessage `json:"info,omitempty"`
+	// Backend is set instead of Config and Info for storage backends
+	// other than MinIO.
+	Backend *BackendHealth `json:"backend,omitempty" yaml:"backend,omitempty"`
 }
 
 // HealthInfo - MinIO cluster's health Info
 type HealthInfo struct {
-	Version string `json:"version"`
-	Error   string `json:"error,omitempty"`
-
-	TimeStamp time.Time       `json:"timestamp,omitempty"`
-	Sys       SysInfo         `json:"sys,omitempty"`
-	Perf      PerfInfo        `json:"perf,omitempty"`
-	Minio     MinioHealthInfo `json:"minio,omitempty"`
+	Version string `json:"version" yaml:"version"`
+	Error   string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	TimeStamp time.Time       `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+	Sys       SysInfo         `json:"sys,omitempty" yaml:"sys,omitempty"`
+	Perf      PerfInfo        `json:"perf,omitempty" yaml:"perf,omitempty"`
+	Minio     MinioHealthInfo `json:"minio,omitempty" yaml:"minio,omitempty"`
+	// Capabilities is only collected for HealthDataTypeCapabilities.
+	Capabilities *BackendCapabilities `json:"capabilities,omitempty" yaml:"capabilities,omitempty"`
 }
 
 func (info HealthInfo) String() string {
@@ -662,9 +703,21 @@ func (info HealthInfo) String() string {
 	return string(data)
 }
 
-// JSON returns this structure as JSON formatted string.
+// Upgrade - fills fields added in newer versions from the deprecated
+// fields they replace, call it after decoding a report.
+func (info *HealthInfo) Upgrade() error {
+	return UpgradeDeprecatedFields(info)
+}
+
+// Validate - checks that deprecated fields agree with their replacements.
+func (info HealthInfo) Validate() error {
+	return ValidateDeprecatedFields(info)
+}
+
+// JSON returns this structure as JSON formatted string, with slices in
+// canonical order.
 func (info HealthInfo) JSON() string {
-	data, err := json.MarshalIndent(info, " ", "    ")
+	data, err := canonicalJSON(info)
 	if err != nil {
 		panic(err) // This never happens.
 	}
@@ -676,34 +729,54 @@ type HealthDataType string
 
 // HealthDataTypes
 const (
-	HealthDataTypePerfDrive   HealthDataType = "perfdrive"
-	HealthDataTypePerfNet     HealthDataType = "perfnet"
-	HealthDataTypeMinioInfo   HealthDataType = "minioinfo"
-	HealthDataTypeMinioConfig HealthDataType = "minioconfig"
-	HealthDataTypeSysCPU      HealthDataType = "syscpu"
-	HealthDataTypeSysDriveHw  HealthDataType = "sysdrivehw"
-	HealthDataTypeSysDocker   HealthDataType = "sysdocker" // is this really needed?
-	HealthDataTypeSysOsInfo   HealthDataType = "sysosinfo"
-	HealthDataTypeSysLoad     HealthDataType = "sysload" // provides very little info. Making it TBD
-	HealthDataTypeSysMem      HealthDataType = "sysmem"
-	HealthDataTypeSysNet      HealthDataType = "sysnet"
-	HealthDataTypeSysProcess  HealthDataType = "sysprocess"
+	HealthDataTypePerfDrive     HealthDataType = "perfdrive"
+	HealthDataTypePerfNet       HealthDataType = "perfnet"
+	HealthDataTypeMinioInfo     HealthDataType = "minioinfo"
+	HealthDataTypeMinioConfig   HealthDataType = "minioconfig"
+	HealthDataTypeSysCPU        HealthDataType = "syscpu"
+	HealthDataTypeSysDriveHw    HealthDataType = "sysdrivehw"
+	HealthDataTypeSysDocker     HealthDataType = "sysdocker" // is this really needed?
+	HealthDataTypeSysOsInfo     HealthDataType = "sysosinfo"
+	HealthDataTypeSysLoad       HealthDataType = "sysload" // provides very little info. Making it TBD
+	HealthDataTypeSysMem        HealthDataType = "sysmem"
+	HealthDataTypeSysNet        HealthDataType = "sysnet"
+	HealthDataTypeSysProcess    HealthDataType = "sysprocess"
+	HealthDataTypeBackendInfo   HealthDataType = "backendinfo"
+	HealthDataTypeSysParallelFS HealthDataType = "sysparallelfs"
+	HealthDataTypeSysNASMounts  HealthDataType = "sysnasmounts"
+	HealthDataTypeCapabilities  HealthDataType = "capabilities"
+	HealthDataTypeSysServices   HealthDataType = "sysservices"
+	HealthDataTypeSysKubernetes HealthDataType = "syskubernetes"
+	HealthDataTypeSysTime       HealthDataType = "systime"
+	HealthDataTypeSysPower      HealthDataType = "syspower"
+	HealthDataTypeSysDualStack  HealthDataType = "sysdualstack"
+	HealthDataTypeSysTLSTrust   HealthDataType = "systlstrust"
 )
 
 // HealthDataTypesMap - Map of Health datatypes
 var HealthDataTypesMap = map[string]HealthDataType{
-	"perfdrive":   HealthDataTypePerfDrive,
-	"perfnet":     HealthDataTypePerfNet,
-	"minioinfo":   HealthDataTypeMinioInfo,
-	"minioconfig": HealthDataTypeMinioConfig,
-	"syscpu":      HealthDataTypeSysCPU,
-	"sysdrivehw":  HealthDataTypeSysDriveHw,
-	"sysdocker":   HealthDataTypeSysDocker,
-	"sysosinfo":   HealthDataTypeSysOsInfo,
-	"sysload":     HealthDataTypeSysLoad,
-	"sysmem":      HealthDataTypeSysMem,
-	"sysnet":      HealthDataTypeSysNet,
-	"sysprocess":  HealthDataTypeSysProcess,
+	"perfdrive":     HealthDataTypePerfDrive,
+	"perfnet":       HealthDataTypePerfNet,
+	"minioinfo":     HealthDataTypeMinioInfo,
+	"minioconfig":   HealthDataTypeMinioConfig,
+	"syscpu":        HealthDataTypeSysCPU,
+	"sysdrivehw":    HealthDataTypeSysDriveHw,
+	"sysdocker":     HealthDataTypeSysDocker,
+	"sysosinfo":     HealthDataTypeSysOsInfo,
+	"sysload":       HealthDataTypeSysLoad,
+	"sysmem":        HealthDataTypeSysMem,
+	"sysnet":        HealthDataTypeSysNet,
+	"sysprocess":    HealthDataTypeSysProcess,
+	"backendinfo":   HealthDataTypeBackendInfo,
+	"sysparallelfs": HealthDataTypeSysParallelFS,
+	"sysnasmounts":  HealthDataTypeSysNASMounts,
+	"capabilities":  HealthDataTypeCapabilities,
+	"sysservices":   HealthDataTypeSysServices,
+	"syskubernetes": HealthDataTypeSysKubernetes,
+	"systime":       HealthDataTypeSysTime,
+	"syspower":      HealthDataTypeSysPower,
+	"sysdualstack":  HealthDataTypeSysDualStack,
+	"systlstrust":   HealthDataTypeSysTLSTrust,
 }
 
 // HealthDataTypesList - List of Health datatypes
@@ -720,18 +793,119 @@ var HealthDataTypesList = []HealthDataType{
 	HealthDataTypeSysMem,
 	HealthDataTypeSysNet,
 	HealthDataTypeSysProcess,
+	HealthDataTypeBackendInfo,
+	HealthDataTypeSysParallelFS,
+	HealthDataTypeSysNASMounts,
+	HealthDataTypeCapabilities,
+	HealthDataTypeSysServices,
+	HealthDataTypeSysKubernetes,
+	HealthDataTypeSysTime,
+	HealthDataTypeSysPower,
+	HealthDataTypeSysDualStack,
+	HealthDataTypeSysTLSTrust,
+}
+
+// HealthInfoOpts - options of ServerHealthInfoWithOpts.
+type HealthInfoOpts struct {
+	// Deadline of the collection on the server.
+	Deadline time.Duration
+	// Deadlines of single data types, i.e. long ones for perfdrive
+	// and perfnet and short ones for syscpu. Sent as the query
+	// parameter <type>-deadline in whole seconds, so they must be at
+	// least 1s. Deadline is raised to the longest.
+	Deadlines map[HealthDataType]time.Duration
+	// DrivePerfModes are the workloads of HealthDataTypePerfDrive,
+	// the server default is DrivePerfSeqWrite only.
+	DrivePerfModes []DrivePerfMode
+	// Percentiles, between 0 and 1, are reported in addition to the
+	// fixed percentiles of drive and network perf results, e.g. 0.999
+	// for p99.9.
+	Percentiles []float64
+	// Histogram asks for the latency histograms of drive and network
+	// perf results.
+	Histogram bool
+	// MsgPack asks the server to send MessagePack, which is much
+	// smaller for large clusters. The response body is transcoded to
+	// JSON, so it is read the same way. Servers without MessagePack
+	// support send JSON.
+	MsgPack bool
+	// OnProgress is called when a node starts or completes a section
+	// of the report, if set. It is called from a background goroutine
+	// while the response body is read. Servers without progress
+	// support send no events.
+	OnProgress func(HealthProgress)
 }
 
 type healthInfoVersion struct {
-	Version string `json:"version,omitempty"`
-	Error   string `json:"error,omitempty"`
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+	Error   string `json:"error,omitempty" yaml:"error,omitempty"`
 }
 
 // ServerHealthInfo - Connect to a minio server and call Health Info Management API
 // to fetch server's information represented by HealthInfo structure
 func (adm *AdminClient) ServerHealthInfo(ctx context.Context, types []HealthDataType, deadline time.Duration) (*http.Response, string, error) {
+	return adm.serverHealthInfo(ctx, types, HealthInfoOpts{Deadline: deadline}, "")
+}
+
+// ServerHealthInfoWithOpts - like ServerHealthInfo, with options
+// controlling how the data is collected.
+func (adm *AdminClient) ServerHealthInfoWithOpts(ctx context.Context, types []HealthDataType, opts HealthInfoOpts) (*http.Response, string, error) {
+	return adm.serverHealthInfo(ctx, types, opts, "")
+}
+
+func (adm *AdminClient) serverHealthInfo(ctx context.Context, types []HealthDataType, opts HealthInfoOpts, node string) (*http.Response, string, error) {
+	resp, _, version, err := adm.healthInfoStream(ctx, types, opts, node)
+	return resp, version, err
+}
+
+// healthInfoStream - requests health info and reads the first report,
+// which is returned along with the response streaming the others.
+func (adm *AdminClient) healthInfoStream(ctx context.Context, types []HealthDataType, opts HealthInfoOpts, node string) (*http.Response, json.RawMessage, string, error) {
 	v := url.Values{}
+	deadline := opts.Deadline
+	for d, typeDeadline := range opts.Deadlines {
+		if _, ok := HealthDataTypesMap[string(d)]; !ok {
+			return nil, nil, "", ErrInvalidArgument("Invalid health data type " + string(d) + ".")
+		}
+		typeDeadline = typeDeadline.Truncate(1 * time.Second)
+		if typeDeadline <= 0 {
+			return nil, nil, "", ErrInvalidArgument("Deadline of " + string(d) + " must be at least 1s.")
+		}
+		v.Set(string(d)+"-deadline", typeDeadline.String())
+		if typeDeadline > deadline {
+			deadline = typeDeadline
+		}
+	}
 	v.Set("deadline", deadline.Truncate(1*time.Second).String())
+	if node != "" {
+		v.Set("node", node)
+	}
+	if len(opts.DrivePerfModes) > 0 {
+		modes := make([]string, 0, len(opts.DrivePerfModes))
+		for _, mode := range opts.DrivePerfModes {
+			if !mode.IsValid() {
+				return nil, nil, "", ErrInvalidArgument("Invalid drive perf mode " + string(mode) + ".")
+			}
+			modes = append(modes, string(mode))
+		}
+		v.Set("perfdrivemodes", strings.Join(modes, ","))
+	}
+	if len(opts.Percentiles) > 0 {
+		pcts := make([]string, 0, len(opts.Percentiles))
+		for _, p := range opts.Percentiles {
+			if p <= 0 || p > 1 {
+				return nil, nil, "", ErrInvalidArgument("Percentiles must be between 0 and 1.")
+			}
+			pcts = append(pcts, strconv.FormatFloat(p, 'f', -1, 64))
+		}
+		v.Set("perfpercentiles", strings.Join(pcts, ","))
+	}
+	if opts.Histogram {
+		v.Set("perfhistogram", "true")
+	}
+	if opts.OnProgress != nil {
+		v.Set("progress", "true")
+	}
 	for _, d := range HealthDataTypesList { // Init all parameters to false.
 		v.Set(string(d), "false")
 	}
@@ -739,41 +913,79 @@ func (adm *AdminClient) ServerHealthInfo(ctx context.Context, types []HealthData
 		v.Set(string(d), "true")
 	}
 
+	headers := make(http.Header)
+	if opts.MsgPack {
+		headers.Set("Accept", healthMsgpContentType+", application/json")
+	}
+
 	resp, err := adm.executeMethod(
 		ctx, "GET", requestData{
-			relPath:     adminAPIPrefix + "/healthinfo",
-			queryValues: v,
+			relPath:           adminAPIPrefix + "/healthinfo",
+			queryValues:       v,
+			customHeaders:     headers,
+			unboundedResponse: true,
 		},
 	)
 
 	if err != nil {
 		closeResponse(resp)
-		return nil, "", err
+		return nil, nil, "", err
 	}
 
 	if resp.StatusCode != http.StatusOK {
 		closeResponse(resp)
-		return nil, "", httpRespToErrorResponse(resp)
+		return nil, nil, "", httpRespToErrorResponse(resp)
+	}
+
+	if resp.Header.Get("Content-Type") == healthMsgpContentType {
+		resp.Body = newMsgpJSONBody(resp.Body)
+	}
+	if opts.OnProgress != nil {
+		resp.Body = newHealthProgressBody(resp.Body, opts.OnProgress)
 	}
 
 	decoder := json.NewDecoder(resp.Body)
+	var raw json.RawMessage
+	if err = decoder.Decode(&raw); err != nil {
+		closeResponse(resp)
+		return nil, nil, "", err
+	}
 	var version healthInfoVersion
-	if err = decoder.Decode(&version); err != nil {
+	if err = json.Unmarshal(raw, &version); err != nil {
 		closeResponse(resp)
-		return nil, "", err
+		return nil, nil, "", err
 	}
 
 	if version.Error != "" {
 		closeResponse(resp)
-		return nil, "", errors.New(version.Error)
+		return nil, nil, "", errors.New(version.Error)
 	}
 
 	switch version.Version {
 	case "", HealthInfoVersion:
 	default:
 		closeResponse(resp)
-		return nil, "", errors.New("Upgrade Minio Client to support health info version " + version.Version)
+		return nil, nil, "", errors.New("Upgrade Minio Client to support health info version " + version.Version)
 	}
 
-	return resp, version.Version, nil
+	// In strict mode only reports of a supported version are checked
+	// against the schema of HealthInfo, decoding them is otherwise
+	// left to the caller.
+	if adm.strictDecoding {
+		if err = adm.unmarshalResponse(resp, raw, new(HealthInfo)); err != nil {
+			closeResponse(resp)
+			return nil, nil, "", err
+		}
+	}
+
+	// Hand the reports the decoder read ahead back to the caller.
+	resp.Body = healthInfoBody{io.MultiReader(decoder.Buffered(), resp.Body), resp.Body}
+	return resp, raw, version.Version, nil
+}
+
+// healthInfoBody - response body which is read from Reader and closed
+// with Closer.
+type healthInfoBody struct {
+	io.Reader
+	io.Closer
 }
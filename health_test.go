@@ -0,0 +1,98 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestHealthInfoDeadlines(t *testing.T) {
+	var query url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"version":"` + HealthInfoVersion + `"}`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := []HealthDataType{HealthDataTypeSysCPU, HealthDataTypePerfDrive}
+	resp, _, err := adm.ServerHealthInfoWithOpts(context.Background(), types, HealthInfoOpts{
+		Deadline: time.Minute,
+		Deadlines: map[HealthDataType]time.Duration{
+			HealthDataTypeSysCPU:    5 * time.Second,
+			HealthDataTypePerfDrive: 10 * time.Minute,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	closeResponse(resp)
+	if got := query.Get("syscpu-deadline"); got != "5s" {
+		t.Errorf("expected syscpu deadline 5s, got %q", got)
+	}
+	if got := query.Get("perfdrive-deadline"); got != "10m0s" {
+		t.Errorf("expected perfdrive deadline 10m0s, got %q", got)
+	}
+	if got := query.Get("deadline"); got != "10m0s" {
+		t.Errorf("expected the deadline raised to 10m0s, got %q", got)
+	}
+
+	for _, deadlines := range []map[HealthDataType]time.Duration{
+		{"perfgpu": time.Minute},
+		{HealthDataTypeSysMem: 0},
+		{HealthDataTypeSysMem: 500 * time.Millisecond},
+	} {
+		if _, _, err = adm.ServerHealthInfoWithOpts(context.Background(), types, HealthInfoOpts{Deadlines: deadlines}); err == nil {
+			t.Errorf("expected an error for deadlines %v", deadlines)
+		}
+	}
+}
+
+func TestGetProcInfoBestEffort(t *testing.T) {
+	strict := GetProcInfo(context.Background(), "node1")
+	info := GetProcInfoBestEffort(context.Background(), "node1")
+	if info.Error != "" {
+		t.Fatal(info.Error)
+	}
+	if info.Addr != "node1" || info.PID == 0 {
+		t.Errorf("unexpected proc info %+v", info)
+	}
+	if strict.Error == "" && len(info.Errors) > 0 {
+		t.Errorf("unexpected errors %v", info.Errors)
+	}
+	if strict.Error != "" && len(info.Errors) == 0 {
+		t.Errorf("expected the errors of %q to be recorded", strict.Error)
+	}
+}
+
+func TestSplitMountOptions(t *testing.T) {
+	if opts := splitMountOptions(""); opts != nil {
+		t.Errorf("expected no mount options, got %q", opts)
+	}
+	if opts := splitMountOptions("rw,noatime"); len(opts) != 2 || opts[0] != "rw" || opts[1] != "noatime" {
+		t.Errorf("unexpected mount options %q", opts)
+	}
+}
@@ -0,0 +1,113 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"net/http"
+	"time"
+)
+
+// HTTPHooks - observes the HTTP requests sent by the client, e.g. to log
+// them or to measure the latency of admin calls. Every attempt of a
+// retried call is reported separately. The hooks are called
+// synchronously and must not modify the request or read the body of
+// the response.
+type HTTPHooks interface {
+	// OnRequest is called before a signed request is sent. Its
+	// Authorization and X-Amz-Security-Token headers are redacted.
+	OnRequest(req *http.Request)
+	// OnResponse is called once the response headers of a request are
+	// received, or the request failed.
+	OnResponse(call HTTPCall)
+}
+
+// HTTPCall - a request sent by the client and its outcome.
+type HTTPCall struct {
+	// Request is the signed request, its Authorization and
+	// X-Amz-Security-Token headers redacted.
+	Request *http.Request
+	// Response is nil if Err is set.
+	Response *http.Response
+	Err      error
+	// Start is when the request was sent, Duration the time until its
+	// response headers were received or it failed.
+	Start    time.Time
+	Duration time.Duration
+}
+
+// HTTPHookFuncs - adapts functions to the HTTPHooks interface, nil
+// functions are skipped.
+type HTTPHookFuncs struct {
+	Request  func(req *http.Request)
+	Response func(call HTTPCall)
+}
+
+// OnRequest - calls f.Request, if set.
+func (f HTTPHookFuncs) OnRequest(req *http.Request) {
+	if f.Request != nil {
+		f.Request(req)
+	}
+}
+
+// OnResponse - calls f.Response, if set.
+func (f HTTPHookFuncs) OnResponse(call HTTPCall) {
+	if f.Response != nil {
+		f.Response(call)
+	}
+}
+
+// SetHTTPHooks - sets the hooks observing the requests of the client,
+// nil removes them. Unlike TraceOn, hooks receive the requests and
+// their timing as values instead of a text dump.
+func (adm *AdminClient) SetHTTPHooks(hooks HTTPHooks) {
+	adm.httpHooks = hooks
+}
+
+// redactedRequest - returns a copy of req to pass to hooks, without
+// the credentials and signature of its Authorization header, or the
+// whole header for other schemes, and without its session token.
+func (adm AdminClient) redactedRequest(req *http.Request) *http.Request {
+	redacted := req.Clone(req.Context())
+	adm.filterSignature(redacted)
+	if redacted.Header.Get("X-Amz-Security-Token") != "" {
+		redacted.Header.Set("X-Amz-Security-Token", "**REDACTED**")
+	}
+	return redacted
+}
+
+// doWithHooks - sends req with the hooks of the client.
+func (adm AdminClient) doWithHooks(req *http.Request) (*http.Response, error) {
+	if adm.httpHooks == nil {
+		return adm.httpClient.Do(req)
+	}
+	redacted := adm.redactedRequest(req)
+	adm.httpHooks.OnRequest(redacted)
+	start := time.Now()
+	resp, err := adm.httpClient.Do(req)
+	call := HTTPCall{
+		Request:  redacted,
+		Response: resp,
+		Err:      err,
+		Start:    start,
+		Duration: time.Since(start),
+	}
+	if err != nil {
+		call.Response = nil
+	}
+	adm.httpHooks.OnResponse(call)
+	return resp, err
+}
@@ -0,0 +1,147 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestHTTPHooks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Authorization"), "Credential=minioadmin/") {
+			t.Errorf("expected a signed request, got %q", r.Header.Get("Authorization"))
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var requests []*http.Request
+	var calls []HTTPCall
+	adm.SetHTTPHooks(HTTPHookFuncs{
+		Request:  func(req *http.Request) { requests = append(requests, req) },
+		Response: func(call HTTPCall) { calls = append(calls, call) },
+	})
+	resp, err := adm.executeMethod(context.Background(), http.MethodGet, requestData{relPath: adminAPIPrefix + "/hooks"})
+	closeResponse(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 1 || len(calls) != 1 {
+		t.Fatalf("expected one request and response, got %d and %d", len(requests), len(calls))
+	}
+	if auth := requests[0].Header.Get("Authorization"); !strings.Contains(auth, "Credential=**REDACTED**/") || strings.Contains(auth, "minioadmin") {
+		t.Errorf("expected the credentials to be redacted, got %q", auth)
+	}
+	call := calls[0]
+	if call.Request != requests[0] || call.Err != nil || call.Response == nil || call.Response.StatusCode != http.StatusOK {
+		t.Errorf("unexpected call %+v", call)
+	}
+	if call.Start.IsZero() || call.Duration <= 0 {
+		t.Errorf("expected the call to be timed, got %v from %v", call.Duration, call.Start)
+	}
+
+	ts.Close()
+	adm.SetRetryPolicy(RetryPolicy{MaxRetry: 1})
+	calls = nil
+	if _, err = adm.executeMethod(context.Background(), http.MethodGet, requestData{relPath: adminAPIPrefix + "/hooks"}); err == nil {
+		t.Fatal("expected the request to fail")
+	}
+	if len(calls) != 1 || calls[0].Err == nil || calls[0].Response != nil {
+		t.Errorf("expected the failure to be reported, got %+v", calls)
+	}
+
+	adm.SetHTTPHooks(nil)
+	calls = nil
+	adm.executeMethod(context.Background(), http.MethodGet, requestData{relPath: adminAPIPrefix + "/hooks"})
+	if len(calls) != 0 {
+		t.Errorf("expected no calls after removing the hooks, got %d", len(calls))
+	}
+}
+
+func TestHTTPHooksRedactSessionToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Amz-Security-Token") != "session-token" {
+			t.Errorf("expected the session token to be sent, got %q", r.Header.Get("X-Amz-Security-Token"))
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	// Sign like SignV4 does with temporary credentials.
+	sign := WithCustomSigner(func(req http.Request, accessKeyID, secretAccessKey, sessionToken, location string) *http.Request {
+		req.Header.Set("X-Amz-Security-Token", sessionToken)
+		req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+accessKeyID+"/20210501/"+location+"/s3/aws4_request")
+		return &req
+	})
+	u, _ := url.Parse(ts.URL)
+	adm, err := NewWithOptions(u.Host, &Options{Creds: credentials.NewStaticV4("minioadmin", "minioadmin", "session-token")}, sign)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var requests []*http.Request
+	adm.SetHTTPHooks(HTTPHookFuncs{Request: func(req *http.Request) { requests = append(requests, req) }})
+	resp, err := adm.executeMethod(context.Background(), http.MethodGet, requestData{relPath: adminAPIPrefix + "/hooks"})
+	closeResponse(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 1 {
+		t.Fatalf("expected one request, got %d", len(requests))
+	}
+	if token := requests[0].Header.Get("X-Amz-Security-Token"); token != "**REDACTED**" {
+		t.Errorf("expected the session token to be redacted, got %q", token)
+	}
+}
+
+func TestHTTPHooksRedactBearerToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			t.Errorf("expected a bearer token, got %q", r.Header.Get("Authorization"))
+		}
+		w.Write([]byte("minio_node_process_uptime_seconds 42\n"))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var requests []*http.Request
+	adm.SetHTTPHooks(HTTPHookFuncs{Request: func(req *http.Request) { requests = append(requests, req) }})
+	if _, err = adm.NodeMetrics(context.Background(), u.Host); err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 1 {
+		t.Fatalf("expected one request, got %d", len(requests))
+	}
+	if auth := requests[0].Header.Get("Authorization"); auth != "**REDACTED**" {
+		t.Errorf("expected the bearer token to be redacted, got %q", auth)
+	}
+}
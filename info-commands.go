@@ -18,7 +18,6 @@ package madmin
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 	"runtime"
 	"time"
@@ -55,7 +54,7 @@ const (
 
 // StorageInfo - represents total capacity of underlying storage.
 type StorageInfo struct {
-	Disks []Disk
+	Disks []Disk `table:",rows"`
 
 	// Backend type.
 	Backend BackendInfo
@@ -78,6 +77,12 @@ type BackendInfo struct {
 	StandardSCParity int   // Parity disks for currently configured Standard storage class.
 	RRSCData         []int // Data disks for currently configured Reduced Redundancy storage class.
 	RRSCParity       int   // Parity disks for currently configured Reduced Redundancy storage class.
+
+	// Parity disks per pool, only reported by servers supporting pools
+	// with distinct parity. StandardSCParity and RRSCParity apply to
+	// all pools if empty.
+	StandardSCParities []int `json:",omitempty"`
+	RRSCParities       []int `json:",omitempty"`
 }
 
 // BackendDisks - represents the map of endpoint-disks.
@@ -123,7 +128,7 @@ func (adm *AdminClient) StorageInfo(ctx context.Context) (StorageInfo, error) {
 
 	// Unmarshal the server's json response
 	var storageInfo StorageInfo
-	if err = json.NewDecoder(resp.Body).Decode(&storageInfo); err != nil {
+	if err = adm.decodeResponse(resp, &storageInfo); err != nil {
 		return StorageInfo{}, err
 	}
 
@@ -145,6 +150,13 @@ type BucketUsageInfo struct {
 
 	ObjectsCount         uint64            `json:"objectsCount"`
 	ObjectSizesHistogram map[string]uint64 `json:"objectsSizesHistogram"`
+
+	// Version statistics, only reported by servers scanning versions.
+	// VersionsCount includes current and noncurrent versions but not
+	// delete markers.
+	VersionsCount          uint64 `json:"versionsCount,omitempty"`
+	DeleteMarkersCount     uint64 `json:"deleteMarkersCount,omitempty"`
+	NoncurrentVersionsSize uint64 `json:"noncurrentVersionsSize,omitempty"`
 }
 
 // DataUsageInfo represents data usage stats of the underlying Object API
@@ -205,7 +217,7 @@ func (adm *AdminClient) DataUsageInfo(ctx context.Context) (DataUsageInfo, error
 
 	// Unmarshal the server's json response
 	var dataUsageInfo DataUsageInfo
-	if err = json.NewDecoder(resp.Body).Decode(&dataUsageInfo); err != nil {
+	if err = adm.decodeResponse(resp, &dataUsageInfo); err != nil {
 		return DataUsageInfo{}, err
 	}
 
@@ -224,7 +236,7 @@ type InfoMessage struct {
 	Usage        Usage              `json:"usage,omitempty"`
 	Services     Services           `json:"services,omitempty"`
 	Backend      interface{}        `json:"backend,omitempty"`
-	Servers      []ServerProperties `json:"servers,omitempty"`
+	Servers      []ServerProperties `json:"servers,omitempty" table:",rows"`
 }
 
 // Services contains different services information
@@ -308,10 +320,10 @@ type ErasureBackend struct {
 
 // ServerProperties holds server information
 type ServerProperties struct {
-	State      string            `json:"state,omitempty"`
-	Endpoint   string            `json:"endpoint,omitempty"`
-	Uptime     int64             `json:"uptime,omitempty"`
-	Version    string            `json:"version,omitempty"`
+	State      string            `json:"state,omitempty" table:"STATE"`
+	Endpoint   string            `json:"endpoint,omitempty" table:"ENDPOINT"`
+	Uptime     int64             `json:"uptime,omitempty" table:"UPTIME"`
+	Version    string            `json:"version,omitempty" table:"VERSION"`
 	CommitID   string            `json:"commitID,omitempty"`
 	Network    map[string]string `json:"network,omitempty"`
 	Disks      []Disk            `json:"drives,omitempty"`
@@ -329,16 +341,16 @@ type DiskMetrics struct {
 
 // Disk holds Disk information
 type Disk struct {
-	Endpoint        string       `json:"endpoint,omitempty"`
+	Endpoint        string       `json:"endpoint,omitempty" table:"ENDPOINT"`
 	RootDisk        bool         `json:"rootDisk,omitempty"`
-	DrivePath       string       `json:"path,omitempty"`
-	Healing         bool         `json:"healing,omitempty"`
-	State           string       `json:"state,omitempty"`
+	DrivePath       string       `json:"path,omitempty" table:"PATH"`
+	Healing         bool         `json:"healing,omitempty" table:"HEALING"`
+	State           string       `json:"state,omitempty" table:"STATE"`
 	UUID            string       `json:"uuid,omitempty"`
 	Model           string       `json:"model,omitempty"`
-	TotalSpace      uint64       `json:"totalspace,omitempty"`
-	UsedSpace       uint64       `json:"usedspace,omitempty"`
-	AvailableSpace  uint64       `json:"availspace,omitempty"`
+	TotalSpace      uint64       `json:"totalspace,omitempty" table:"TOTAL,bytes"`
+	UsedSpace       uint64       `json:"usedspace,omitempty" table:"USED,bytes"`
+	AvailableSpace  uint64       `json:"availspace,omitempty" table:"AVAILABLE,bytes"`
 	ReadThroughput  float64      `json:"readthroughput,omitempty"`
 	WriteThroughPut float64      `json:"writethroughput,omitempty"`
 	ReadLatency     float64      `json:"readlatency,omitempty"`
@@ -373,7 +385,7 @@ func (adm *AdminClient) ServerInfo(ctx context.Context) (InfoMessage, error) {
 
 	// Unmarshal the server's json response
 	var message InfoMessage
-	if err = json.NewDecoder(resp.Body).Decode(&message); err != nil {
+	if err = adm.decodeResponse(resp, &message); err != nil {
 		return InfoMessage{}, err
 	}
 
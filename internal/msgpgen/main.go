@@ -0,0 +1,845 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Command msgpgen generates the tinylib/msgp codecs of the health info
+// types. msgp -file only sees the types of a single file while the health
+// info graph spans the whole package. Pointed at the package directory
+// msgp generates codecs for every type of the package instead, including
+// clients and options with func, channel and mutex fields it cannot
+// encode, each of which would need a //msgp:ignore. msgpgen follows the
+// graph from the given root types across all files and honors the
+// //msgp:shim directives.
+//
+// Usage, from the package directory:
+//
+//	go run ./internal/msgpgen -o health_gen.go HealthInfo ErasureBackend FSBackend
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// genMarker precedes the package clause, where go tooling and linters
+// look for it.
+const genMarker = "// Code generated by internal/msgpgen DO NOT EDIT.\n\n"
+
+var specs = map[string]*ast.TypeSpec{}
+
+type shim struct{ to, from string }
+
+// external types encoded through shims.
+var shims = map[string]shim{}
+
+func load(dir string) {
+	fset := token.NewFileSet()
+	ms, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	for _, m := range ms {
+		if strings.HasSuffix(m, "_test.go") || strings.HasSuffix(m, "_gen.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, m, nil, parser.ParseComments)
+		if err != nil || f.Name.Name != "madmin" {
+			continue
+		}
+		for _, c := range f.Comments {
+			for _, l := range c.List {
+				if strings.HasPrefix(l.Text, "//msgp:shim ") {
+					// //msgp:shim T as:interface{} using:to/from mode:convert
+					fs := strings.Fields(l.Text)
+					u := strings.Split(strings.TrimPrefix(fs[3], "using:"), "/")
+					shims[fs[1]] = shim{u[0], u[1]}
+				}
+			}
+		}
+		for _, d := range f.Decls {
+			g, ok := d.(*ast.GenDecl)
+			if !ok || g.Tok != token.TYPE {
+				continue
+			}
+			for _, s := range g.Specs {
+				ts := s.(*ast.TypeSpec)
+				specs[ts.Name.Name] = ts
+			}
+		}
+	}
+}
+
+// ---- element model ----
+
+type kind int
+
+const (
+	kBase kind = iota
+	kIdent
+	kPtr
+	kSlice
+	kMap
+)
+
+type elem struct {
+	k      kind
+	gotype string // Go type
+	base   string // msgp base name (String, Uint64, Intf...)
+	conv   string // underlying Go type when cast from a named type
+	shim   *shim
+	elt    *elem
+}
+
+var baseNames = map[string]string{
+	"string": "String", "bool": "Bool",
+	"int": "Int", "int8": "Int8", "int16": "Int16", "int32": "Int32", "int64": "Int64",
+	"uint": "Uint", "uint8": "Uint8", "byte": "Byte", "uint16": "Uint16", "uint32": "Uint32", "uint64": "Uint64",
+	"float32": "Float32", "float64": "Float64",
+	"time.Time": "Time", "time.Duration": "Duration",
+}
+
+var sizeNames = map[string]string{
+	"Bool": "msgp.BoolSize", "Int": "msgp.IntSize", "Int8": "msgp.Int8Size", "Int16": "msgp.Int16Size",
+	"Int32": "msgp.Int32Size", "Int64": "msgp.Int64Size", "Uint": "msgp.UintSize", "Uint8": "msgp.Uint8Size",
+	"Byte": "msgp.ByteSize", "Uint16": "msgp.Uint16Size", "Uint32": "msgp.Uint32Size", "Uint64": "msgp.Uint64Size",
+	"Float32": "msgp.Float32Size", "Float64": "msgp.Float64Size", "Time": "msgp.TimeSize", "Duration": "msgp.DurationSize",
+}
+
+var graph = map[string]bool{}
+
+func exprString(e ast.Expr) string {
+	var b bytes.Buffer
+	format.Node(&b, token.NewFileSet(), e)
+	return b.String()
+}
+
+func resolve(e ast.Expr) *elem {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if b, ok := baseNames[t.Name]; ok {
+			return &elem{k: kBase, gotype: t.Name, base: b}
+		}
+		ts, ok := specs[t.Name]
+		if !ok {
+			fail("unknown type %s", t.Name)
+		}
+		if id, ok := ts.Type.(*ast.Ident); ok {
+			if b, ok := baseNames[id.Name]; ok {
+				return &elem{k: kBase, gotype: t.Name, base: b, conv: id.Name}
+			}
+		}
+		graph[t.Name] = true
+		return &elem{k: kIdent, gotype: t.Name}
+	case *ast.SelectorExpr:
+		name := exprString(t)
+		if b, ok := baseNames[name]; ok {
+			return &elem{k: kBase, gotype: name, base: b}
+		}
+		s, ok := shims[name]
+		if !ok {
+			fail("no shim for %s", name)
+		}
+		return &elem{k: kBase, gotype: name, base: "Intf", shim: &s}
+	case *ast.StarExpr:
+		el := resolve(t.X)
+		return &elem{k: kPtr, gotype: "*" + el.gotype, elt: el}
+	case *ast.ArrayType:
+		if t.Len != nil {
+			fail("fixed arrays unsupported")
+		}
+		if id, ok := t.Elt.(*ast.Ident); ok && (id.Name == "byte" || id.Name == "uint8") {
+			return &elem{k: kBase, gotype: "[]byte", base: "Bytes"}
+		}
+		el := resolve(t.Elt)
+		return &elem{k: kSlice, gotype: "[]" + el.gotype, elt: el}
+	case *ast.MapType:
+		if id, ok := t.Key.(*ast.Ident); !ok || id.Name != "string" {
+			fail("non string map key")
+		}
+		el := resolve(t.Value)
+		return &elem{k: kMap, gotype: "map[string]" + el.gotype, elt: el}
+	case *ast.InterfaceType:
+		return &elem{k: kBase, gotype: "interface{}", base: "Intf"}
+	}
+	fail("unsupported %T", e)
+	return nil
+}
+
+func fail(f string, a ...interface{}) {
+	fmt.Fprintf(os.Stderr, f+"\n", a...)
+	os.Exit(1)
+}
+
+type field struct {
+	name, key string
+	e         *elem
+}
+
+func structFields(st *ast.StructType) []field {
+	var fs []field
+	for _, f := range st.Fields.List {
+		if len(f.Names) != 1 {
+			fail("embedded or multi-name field")
+		}
+		n := f.Names[0].Name
+		if !ast.IsExported(n) {
+			continue
+		}
+		key := n
+		if f.Tag != nil {
+			tag, _ := strconv.Unquote(f.Tag.Value)
+			j := strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+			if j == "-" {
+				continue
+			}
+			if j != "" {
+				key = j
+			}
+		}
+		fs = append(fs, field{n, key, resolve(f.Type)})
+	}
+	return fs
+}
+
+// ---- printer ----
+
+type printer struct {
+	b      bytes.Buffer
+	za, zb int
+}
+
+func (p *printer) f(format string, a ...interface{}) { fmt.Fprintf(&p.b, format, a...) }
+
+func (p *printer) reset() { p.za, p.zb = 0, 0 }
+
+func (p *printer) newA() string { p.za++; return fmt.Sprintf("za%04d", p.za) }
+func (p *printer) newB() string { p.zb++; return fmt.Sprintf("zb%04d", p.zb) }
+
+func wrapArgs(ctx []string) string {
+	if len(ctx) == 0 {
+		return "err"
+	}
+	return "err, " + strings.Join(ctx, ", ")
+}
+
+func (p *printer) check(ctx []string) {
+	p.f("if err != nil {\nerr = msgp.WrapError(%s)\nreturn\n}\n", wrapArgs(ctx))
+}
+
+func add(ctx []string, s string) []string {
+	return append(append([]string{}, ctx...), s)
+}
+
+// ---- encode ----
+
+func (p *printer) encode(e *elem, v string, ctx []string) {
+	switch e.k {
+	case kBase:
+		val := v
+		if e.conv != "" {
+			val = e.conv + "(" + v + ")"
+		}
+		if e.shim != nil {
+			t := p.newB()
+			p.f("var %s interface{}\n%s, err = %s(%s)\n", t, t, e.shim.to, v)
+			p.check(ctx)
+			val = t
+		}
+		p.f("err = en.Write%s(%s)\n", e.base, val)
+		p.check(ctx)
+	case kIdent:
+		p.f("err = %s.EncodeMsg(en)\n", v)
+		p.check(ctx)
+	case kPtr:
+		p.f("if %s == nil {\nerr = en.WriteNil()\nif err != nil {\nreturn\n}\n} else {\n", v)
+		p.encode(e.elt, deref(e.elt, v), ctx)
+		p.f("}\n")
+	case kSlice:
+		p.f("err = en.WriteArrayHeader(uint32(len(%s)))\n", v)
+		p.check(ctx)
+		i := p.newA()
+		p.f("for %s := range %s {\n", i, v)
+		p.encode(e.elt, v+"["+i+"]", add(ctx, i))
+		p.f("}\n")
+	case kMap:
+		p.f("err = en.WriteMapHeader(uint32(len(%s)))\n", v)
+		p.check(ctx)
+		k, val := p.newA(), p.newA()
+		p.f("for %s, %s := range %s {\n", k, val, v)
+		p.f("err = en.WriteString(%s)\n", k)
+		p.check(ctx)
+		p.encode(e.elt, val, add(ctx, k))
+		p.f("}\n")
+	}
+}
+
+func deref(e *elem, v string) string {
+	if e.k == kIdent {
+		return v
+	}
+	return "*" + v
+}
+
+// ---- marshal ----
+
+func (p *printer) marshal(e *elem, v string, ctx []string) {
+	switch e.k {
+	case kBase:
+		val := v
+		if e.conv != "" {
+			val = e.conv + "(" + v + ")"
+		}
+		if e.shim != nil {
+			t := p.newB()
+			p.f("var %s interface{}\n%s, err = %s(%s)\n", t, t, e.shim.to, v)
+			p.check(ctx)
+			val = t
+		}
+		if e.base == "Intf" {
+			p.f("o, err = msgp.AppendIntf(o, %s)\n", val)
+			p.check(ctx)
+			return
+		}
+		p.f("o = msgp.Append%s(o, %s)\n", e.base, val)
+	case kIdent:
+		p.f("o, err = %s.MarshalMsg(o)\n", v)
+		p.check(ctx)
+	case kPtr:
+		p.f("if %s == nil {\no = msgp.AppendNil(o)\n} else {\n", v)
+		p.marshal(e.elt, deref(e.elt, v), ctx)
+		p.f("}\n")
+	case kSlice:
+		p.f("o = msgp.AppendArrayHeader(o, uint32(len(%s)))\n", v)
+		i := p.newA()
+		p.f("for %s := range %s {\n", i, v)
+		p.marshal(e.elt, v+"["+i+"]", add(ctx, i))
+		p.f("}\n")
+	case kMap:
+		p.f("o = msgp.AppendMapHeader(o, uint32(len(%s)))\n", v)
+		k, val := p.newA(), p.newA()
+		p.f("for %s, %s := range %s {\n", k, val, v)
+		p.f("o = msgp.AppendString(o, %s)\n", k)
+		p.marshal(e.elt, val, add(ctx, k))
+		p.f("}\n")
+	}
+}
+
+// ---- decode (streaming and bytes) ----
+
+type rd struct {
+	bytes bool
+}
+
+func (r rd) read(name string) string {
+	if r.bytes {
+		return "msgp.Read" + name + "Bytes(bts)"
+	}
+	return "dc.Read" + name + "()"
+}
+
+func (r rd) assign(v string) string {
+	if r.bytes {
+		return v + ", bts, err"
+	}
+	return v + ", err"
+}
+
+func (p *printer) decode(r rd, e *elem, v string, ctx []string) {
+	switch e.k {
+	case kBase:
+		rdName := e.base
+		call := r.read(rdName)
+		if e.base == "Bytes" {
+			if r.bytes {
+				call = "msgp.ReadBytesBytes(bts, " + v + ")"
+			} else {
+				call = "dc.ReadBytes(" + v + ")"
+			}
+		}
+		switch {
+		case e.shim != nil:
+			t := p.newB()
+			p.f("{\nvar %s interface{}\n%s = %s\n", t, r.assign(t), call)
+			p.check(ctx)
+			p.f("%s, err = %s(%s)\n", v, e.shim.from, t)
+			p.check(ctx)
+			p.f("}\n")
+		case e.conv != "":
+			t := p.newB()
+			p.f("{\nvar %s %s\n%s = %s\n", t, e.conv, r.assign(t), call)
+			p.check(ctx)
+			p.f("%s = %s(%s)\n}\n", v, e.gotype, t)
+		default:
+			p.f("%s = %s\n", r.assign(v), call)
+			p.check(ctx)
+		}
+	case kIdent:
+		if r.bytes {
+			p.f("bts, err = %s.UnmarshalMsg(bts)\n", v)
+		} else {
+			p.f("err = %s.DecodeMsg(dc)\n", v)
+		}
+		p.check(ctx)
+	case kPtr:
+		if r.bytes {
+			p.f("if msgp.IsNil(bts) {\nbts, err = msgp.ReadNilBytes(bts)\nif err != nil {\nreturn\n}\n%s = nil\n} else {\n", v)
+		} else {
+			p.f("if dc.IsNil() {\nerr = dc.ReadNil()\n")
+			p.check(ctx)
+			p.f("%s = nil\n} else {\n", v)
+		}
+		p.f("if %s == nil {\n%s = new(%s)\n}\n", v, v, e.elt.gotype)
+		p.decode(r, e.elt, deref(e.elt, v), ctx)
+		p.f("}\n")
+	case kSlice:
+		n := p.newB()
+		p.f("var %s uint32\n%s = %s\n", n, r.assign(n), r.read("ArrayHeader"))
+		p.check(ctx)
+		p.f("if cap(%s) >= int(%s) {\n%s = (%s)[:%s]\n} else {\n%s = make(%s, %s)\n}\n", v, n, v, v, n, v, e.gotype, n)
+		i := p.newA()
+		p.f("for %s := range %s {\n", i, v)
+		p.decode(r, e.elt, v+"["+i+"]", add(ctx, i))
+		p.f("}\n")
+	case kMap:
+		n := p.newB()
+		p.f("var %s uint32\n%s = %s\n", n, r.assign(n), r.read("MapHeader"))
+		p.check(ctx)
+		p.f("if %s == nil {\n%s = make(%s, %s)\n} else if len(%s) > 0 {\nfor key := range %s {\ndelete(%s, key)\n}\n}\n", v, v, e.gotype, n, v, v, v)
+		k, val := p.newA(), p.newA()
+		p.f("for %s > 0 {\n", n)
+		if r.bytes {
+			p.f("var %s string\nvar %s %s\n%s--\n", k, val, e.elt.gotype, n)
+		} else {
+			p.f("%s--\nvar %s string\nvar %s %s\n", n, k, val, e.elt.gotype)
+		}
+		p.f("%s = %s\n", r.assign(k), r.read("String"))
+		p.check(ctx)
+		p.decode(r, e.elt, val, add(ctx, k))
+		p.f("%s[%s] = %s\n}\n", v, k, val)
+	}
+}
+
+// ---- size ----
+
+// sizer accumulates constant terms between statements.
+type sizer struct {
+	p     *printer
+	terms []string
+	first bool
+}
+
+func (s *sizer) add(t string) { s.terms = append(s.terms, t) }
+
+func (s *sizer) flush() {
+	if len(s.terms) == 0 {
+		return
+	}
+	if s.first {
+		s.p.f("s = %s\n", strings.Join(s.terms, " + "))
+		s.first = false
+	} else {
+		s.p.f("s += %s\n", strings.Join(s.terms, " + "))
+	}
+	s.terms = nil
+}
+
+func baseSize(e *elem, v string) string {
+	switch e.base {
+	case "String":
+		if e.conv != "" {
+			v = "string(" + v + ")"
+		}
+		return "msgp.StringPrefixSize + len(" + v + ")"
+	case "Bytes":
+		return "msgp.BytesPrefixSize + len(" + v + ")"
+	case "Intf":
+		return "msgp.GuessSize(" + v + ")"
+	}
+	return sizeNames[e.base]
+}
+
+// fixed - size of e when it does not depend on the value.
+func fixed(e *elem) string {
+	if e.k == kBase {
+		switch e.base {
+		case "String", "Bytes", "Intf":
+			return ""
+		}
+		return sizeNames[e.base]
+	}
+	return ""
+}
+
+func (s *sizer) size(e *elem, v string) {
+	switch e.k {
+	case kBase:
+		s.add(baseSize(e, v))
+	case kIdent:
+		s.add(v + ".Msgsize()")
+	case kPtr:
+		s.flush()
+		s.p.f("if %s == nil {\ns += msgp.NilSize\n} else {\n", v)
+		s.size(e.elt, deref(e.elt, v))
+		s.flush()
+		s.p.f("}\n")
+	case kSlice:
+		if f := fixed(e.elt); f != "" {
+			s.add("msgp.ArrayHeaderSize + (len(" + v + ") * (" + f + "))")
+			return
+		}
+		s.add("msgp.ArrayHeaderSize")
+		s.flush()
+		i := s.p.newA()
+		s.p.f("for %s := range %s {\n", i, v)
+		s.size(e.elt, v+"["+i+"]")
+		s.flush()
+		s.p.f("}\n")
+	case kMap:
+		s.add("msgp.MapHeaderSize")
+		s.flush()
+		k, val := s.p.newA(), s.p.newA()
+		s.p.f("if %s != nil {\nfor %s, %s := range %s {\n_ = %s\n", v, k, val, v, val)
+		s.add("msgp.StringPrefixSize + len(" + k + ")")
+		s.size(e.elt, val)
+		s.flush()
+		s.p.f("}\n}\n")
+	}
+}
+
+// ---- top level ----
+
+func keyBytes(k string) []string {
+	var bs []string
+	if len(k) <= 31 {
+		bs = append(bs, fmt.Sprintf("0x%x", 0xa0|len(k)))
+	} else {
+		bs = append(bs, "0xd9", fmt.Sprintf("0x%x", len(k)))
+	}
+	for i := 0; i < len(k); i++ {
+		bs = append(bs, fmt.Sprintf("0x%x", k[i]))
+	}
+	return bs
+}
+
+func mapHeader(n int) []string {
+	if n <= 15 {
+		return []string{fmt.Sprintf("0x%x", 0x80|n)}
+	}
+	return []string{"0xde", fmt.Sprintf("0x%x", n>>8), fmt.Sprintf("0x%x", n&0xff)}
+}
+
+func headerSize(n int) int {
+	if n <= 15 {
+		return 1
+	}
+	return 3
+}
+
+// imutReceiver - small structs of base fields use value receivers for
+// the methods that do not modify them, as msgp does.
+func imutReceiver(name string, fs []field) string {
+	if len(fs) <= 3 {
+		for _, f := range fs {
+			if f.e.k != kBase || f.e.base == "Bytes" {
+				return "*" + name
+			}
+		}
+		return name
+	}
+	return "*" + name
+}
+
+func (p *printer) genStruct(name string, fs []field) {
+	recv := imutReceiver(name, fs)
+	// DecodeMsg
+	p.reset()
+	p.f("// DecodeMsg implements msgp.Decodable\nfunc (z *%s) DecodeMsg(dc *msgp.Reader) (err error) {\n", name)
+	p.genStructDecode(rd{false}, fs)
+	p.f("return\n}\n\n")
+
+	// EncodeMsg
+	p.reset()
+	p.f("// EncodeMsg implements msgp.Encodable\nfunc (z %s) EncodeMsg(en *msgp.Writer) (err error) {\n", recv)
+	p.f("// map header, size %d\n", len(fs))
+	for i, f := range fs {
+		bs := keyBytes(f.key)
+		if i == 0 {
+			bs = append(mapHeader(len(fs)), bs...)
+		}
+		p.f("// write %q\nerr = en.Append(%s)\nif err != nil {\nreturn\n}\n", f.key, strings.Join(bs, ", "))
+		p.encode(f.e, "z."+f.name, []string{strconv.Quote(f.name)})
+	}
+	if len(fs) == 0 {
+		p.f("err = en.Append(0x80)\nif err != nil {\nreturn\n}\n")
+	}
+	p.f("return\n}\n\n")
+
+	// MarshalMsg
+	p.reset()
+	p.f("// MarshalMsg implements msgp.Marshaler\nfunc (z %s) MarshalMsg(b []byte) (o []byte, err error) {\n", recv)
+	p.f("o = msgp.Require(b, z.Msgsize())\n// map header, size %d\n", len(fs))
+	for i, f := range fs {
+		bs := keyBytes(f.key)
+		if i == 0 {
+			bs = append(mapHeader(len(fs)), bs...)
+		}
+		p.f("// string %q\no = append(o, %s)\n", f.key, strings.Join(bs, ", "))
+		p.marshal(f.e, "z."+f.name, []string{strconv.Quote(f.name)})
+	}
+	if len(fs) == 0 {
+		p.f("o = append(o, 0x80)\n")
+	}
+	p.f("return\n}\n\n")
+
+	// UnmarshalMsg
+	p.reset()
+	p.f("// UnmarshalMsg implements msgp.Unmarshaler\nfunc (z *%s) UnmarshalMsg(bts []byte) (o []byte, err error) {\n", name)
+	p.genStructDecode(rd{true}, fs)
+	p.f("o = bts\nreturn\n}\n\n")
+
+	// Msgsize
+	p.reset()
+	p.f("// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message\nfunc (z %s) Msgsize() (s int) {\n", recv)
+	s := &sizer{p: p, first: true}
+	s.add(strconv.Itoa(headerSize(len(fs))))
+	for _, f := range fs {
+		s.add(strconv.Itoa(len(keyBytes(f.key))))
+		s.size(f.e, "z."+f.name)
+	}
+	s.flush()
+	p.f("return\n}\n\n")
+}
+
+func (p *printer) genStructDecode(r rd, fs []field) {
+	p.f("var field []byte\n_ = field\nvar zb0001 uint32\n")
+	p.zb = 1
+	if r.bytes {
+		p.f("zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)\n")
+	} else {
+		p.f("zb0001, err = dc.ReadMapHeader()\n")
+	}
+	p.check(nil)
+	p.f("for zb0001 > 0 {\nzb0001--\n")
+	if r.bytes {
+		p.f("field, bts, err = msgp.ReadMapKeyZC(bts)\n")
+	} else {
+		p.f("field, err = dc.ReadMapKeyPtr()\n")
+	}
+	p.check(nil)
+	p.f("switch msgp.UnsafeString(field) {\n")
+	for _, f := range fs {
+		p.f("case %q:\n", f.key)
+		p.decode(r, f.e, "z."+f.name, []string{strconv.Quote(f.name)})
+	}
+	if r.bytes {
+		p.f("default:\nbts, err = msgp.Skip(bts)\n")
+	} else {
+		p.f("default:\nerr = dc.Skip()\n")
+	}
+	p.check(nil)
+	p.f("}\n}\n")
+}
+
+// genNamed - codecs of named maps, slices and base types.
+func (p *printer) genNamed(name string, e *elem) {
+	val := &elem{k: e.k, gotype: name, base: e.base, conv: e.gotype, elt: e.elt}
+	if e.k != kBase {
+		val.conv = ""
+	}
+	p.reset()
+	p.f("// DecodeMsg implements msgp.Decodable\nfunc (z *%s) DecodeMsg(dc *msgp.Reader) (err error) {\n", name)
+	p.decode(rd{false}, val, "(*z)", nil)
+	p.f("return\n}\n\n")
+
+	p.reset()
+	p.f("// EncodeMsg implements msgp.Encodable\nfunc (z %s) EncodeMsg(en *msgp.Writer) (err error) {\n", name)
+	p.encode(val, "z", nil)
+	p.f("return\n}\n\n")
+
+	p.reset()
+	p.f("// MarshalMsg implements msgp.Marshaler\nfunc (z %s) MarshalMsg(b []byte) (o []byte, err error) {\n", name)
+	p.f("o = msgp.Require(b, z.Msgsize())\n")
+	p.marshal(val, "z", nil)
+	p.f("return\n}\n\n")
+
+	p.reset()
+	p.f("// UnmarshalMsg implements msgp.Unmarshaler\nfunc (z *%s) UnmarshalMsg(bts []byte) (o []byte, err error) {\n", name)
+	p.decode(rd{true}, val, "(*z)", nil)
+	p.f("o = bts\nreturn\n}\n\n")
+
+	p.reset()
+	p.f("// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message\nfunc (z %s) Msgsize() (s int) {\n", name)
+	s := &sizer{p: p, first: true}
+	s.size(val, "z")
+	s.flush()
+	p.f("return\n}\n\n")
+}
+
+func main() {
+	out := flag.String("o", "", "output `file`, tests are written next to it")
+	flag.Parse()
+	if *out == "" || flag.NArg() == 0 {
+		fail("usage: msgpgen -o file type...")
+	}
+	dir, roots := filepath.Dir(*out), flag.Args()
+	load(dir)
+	header, _ := ioutil.ReadFile(filepath.Join(dir, "tier-gcs_gen.go"))
+	lic := string(header[:bytes.Index(header, []byte("package madmin"))])
+
+	for _, r := range roots {
+		graph[r] = true
+	}
+	// Resolve the graph to a fixed point.
+	done := map[string]bool{}
+	for {
+		var todo []string
+		for n := range graph {
+			if !done[n] {
+				todo = append(todo, n)
+			}
+		}
+		if len(todo) == 0 {
+			break
+		}
+		for _, n := range todo {
+			done[n] = true
+			ts := specs[n]
+			if st, ok := ts.Type.(*ast.StructType); ok {
+				structFields(st)
+			} else {
+				resolve(ts.Type)
+			}
+		}
+	}
+	// Named base types have their own methods too.
+	for n, ts := range specs {
+		if id, ok := ts.Type.(*ast.Ident); ok && done[n] == false {
+			_ = id
+		}
+	}
+	var names []string
+	for n := range graph {
+		names = append(names, n)
+	}
+	// include named base types referenced by fields
+	for n := range castTypes() {
+		if !graph[n] {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+
+	p := &printer{}
+	var structs []string
+	for _, n := range names {
+		ts := specs[n]
+		if st, ok := ts.Type.(*ast.StructType); ok {
+			p.genStruct(n, structFields(st))
+			structs = append(structs, n)
+		} else {
+			var e *elem
+			if id, ok := ts.Type.(*ast.Ident); ok {
+				e = &elem{k: kBase, gotype: id.Name, base: baseNames[id.Name]}
+			} else {
+				e = resolve(ts.Type)
+			}
+			p.genNamed(n, e)
+		}
+	}
+	body := p.b.String()
+	var std, ext []string
+	for _, pkg := range []string{"runtime", "time"} {
+		if regexp.MustCompile(`[^.\w]` + pkg + `\.`).MatchString(body) {
+			std = append(std, strconv.Quote(pkg))
+		}
+	}
+	for _, pkg := range []string{"cpu", "host", "net", "process"} {
+		if regexp.MustCompile(`[^.\w]` + pkg + `\.`).MatchString(body) {
+			ext = append(ext, strconv.Quote("github.com/shirou/gopsutil/"+pkg))
+		}
+	}
+	ext = append(ext, `"github.com/tinylib/msgp/msgp"`)
+	imps := strings.Join(ext, "\n")
+	if len(std) > 0 {
+		imps = strings.Join(std, "\n") + "\n\n" + imps
+	}
+	full := fmt.Sprintf("%s%spackage madmin\n\nimport (\n%s\n)\n\n%s", lic, genMarker, imps, body)
+	src, err := format.Source([]byte(full))
+	if err != nil {
+		ioutil.WriteFile(*out, []byte(full), 0644)
+		fail("format: %v", err)
+	}
+	ioutil.WriteFile(*out, src, 0644)
+
+	// tests
+	t := &printer{}
+	t.f("%s%spackage madmin\n\nimport (\n\"bytes\"\n\"testing\"\n\n\"github.com/tinylib/msgp/msgp\"\n)\n\n", lic, genMarker)
+	tmpl, _ := ioutil.ReadFile(filepath.Join(dir, "tier-gcs_gen_test.go"))
+	body = string(tmpl[strings.Index(string(tmpl), "func TestMarshalUnmarshalTierGCS"):])
+	for _, n := range structs {
+		t.f("%s\n", strings.TrimSpace(strings.ReplaceAll(body, "TierGCS", n)))
+		t.f("\n")
+	}
+	src, err = format.Source(t.b.Bytes())
+	if err != nil {
+		fail("format test: %v", err)
+	}
+	ioutil.WriteFile(strings.TrimSuffix(*out, ".go")+"_test.go", src, 0644)
+}
+
+// castTypes - named base types reached from the graph.
+func castTypes() map[string]bool {
+	m := map[string]bool{}
+	var walk func(e ast.Expr)
+	walk = func(e ast.Expr) {
+		switch t := e.(type) {
+		case *ast.Ident:
+			if ts, ok := specs[t.Name]; ok {
+				if id, ok := ts.Type.(*ast.Ident); ok {
+					if _, ok := baseNames[id.Name]; ok {
+						m[t.Name] = true
+					}
+				}
+			}
+		case *ast.StarExpr:
+			walk(t.X)
+		case *ast.ArrayType:
+			walk(t.Elt)
+		case *ast.MapType:
+			walk(t.Value)
+		}
+	}
+	for n := range graph {
+		switch t := specs[n].Type.(type) {
+		case *ast.StructType:
+			for _, f := range t.Fields.List {
+				walk(f.Type)
+			}
+		default:
+			walk(t)
+		}
+	}
+	return m
+}
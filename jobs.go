@@ -0,0 +1,244 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Types of JobHandle.
+const (
+	JobTypeHeal  = "heal"
+	JobTypeBatch = "batch"
+)
+
+// ErrJobNotFound is returned by a JobStore for unknown job IDs.
+var ErrJobNotFound = errors.New("job not found")
+
+// JobHandle - what is needed to track a long-running job after a
+// restart of the client.
+type JobHandle struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	// Bucket and Prefix of a heal job.
+	Bucket  string    `json:"bucket,omitempty"`
+	Prefix  string    `json:"prefix,omitempty"`
+	Started time.Time `json:"started"`
+}
+
+// JobStatus - status of a resumed job.
+type JobStatus struct {
+	Handle JobHandle `json:"handle"`
+	// Done is set once the job ended, its handle is then removed from
+	// the store.
+	Done    bool   `json:"done"`
+	Summary string `json:"summary"`
+	// Heal is the status of a heal job.
+	Heal *HealTaskStatus `json:"heal,omitempty"`
+	// Batch is the status of a batch job.
+	Batch *BatchJobStatus `json:"batch,omitempty"`
+}
+
+// JobStore persists job handles, implementations must be safe for
+// concurrent use.
+type JobStore interface {
+	SaveJob(job JobHandle) error
+	// LoadJob returns ErrJobNotFound for unknown IDs.
+	LoadJob(id string) (JobHandle, error)
+	DeleteJob(id string) error
+	ListJobs() ([]JobHandle, error)
+}
+
+// SetJobStore - persists the handles of jobs started by the client in
+// store, so they can be resumed with ResumeJob after a restart.
+func (adm *AdminClient) SetJobStore(store JobStore) {
+	adm.jobStore = store
+}
+
+// StartHealJob - like Heal, but starts a heal sequence and records its
+// handle in the job store.
+func (adm *AdminClient) StartHealJob(ctx context.Context, bucket, prefix string, opts HealOpts, forceStart bool) (JobHandle, error) {
+	start, _, err := adm.Heal(ctx, bucket, prefix, opts, "", forceStart, false)
+	if err != nil {
+		return JobHandle{}, err
+	}
+	job := JobHandle{
+		ID:      start.ClientToken,
+		Type:    JobTypeHeal,
+		Bucket:  bucket,
+		Prefix:  prefix,
+		Started: start.StartTime,
+	}
+	if adm.jobStore != nil {
+		if err = adm.jobStore.SaveJob(job); err != nil {
+			return job, err
+		}
+	}
+	return job, nil
+}
+
+// ResumeJob - returns the status of the job with the given ID from the
+// job store. Jobs which ended are removed from the store.
+func (adm *AdminClient) ResumeJob(ctx context.Context, id string) (JobStatus, error) {
+	if adm.jobStore == nil {
+		return JobStatus{}, ErrInvalidArgument("No job store is set.")
+	}
+	job, err := adm.jobStore.LoadJob(id)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	status := JobStatus{Handle: job}
+	switch job.Type {
+	case JobTypeHeal:
+		_, heal, err := adm.Heal(ctx, job.Bucket, job.Prefix, HealOpts{}, job.ID, false, false)
+		if err != nil {
+			return status, err
+		}
+		status.Heal = &heal
+		status.Summary = heal.Summary
+		status.Done = heal.Summary == healSummaryFinished || heal.Summary == healSummaryStopped
+	case JobTypeBatch:
+		batch, err := adm.GetBatchJobStatus(ctx, job.ID)
+		if err != nil {
+			return status, err
+		}
+		status.Batch = &batch
+		status.Done = batch.Done()
+		switch {
+		case batch.Failed:
+			status.Summary = "failed"
+		case batch.Complete:
+			status.Summary = "complete"
+		default:
+			status.Summary = "running"
+		}
+	default:
+		return status, ErrInvalidArgument("Unsupported job type " + job.Type + ".")
+	}
+
+	if status.Done {
+		if err = adm.jobStore.DeleteJob(job.ID); err != nil {
+			return status, err
+		}
+	}
+	return status, nil
+}
+
+// FileJobStore - a JobStore keeping every job in a JSON file of a
+// directory.
+type FileJobStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewFileJobStore - opens or creates a job store in dir.
+func NewFileJobStore(dir string) (*FileJobStore, error) {
+	if dir == "" {
+		return nil, ErrInvalidArgument("Job store directory cannot be empty.")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileJobStore{dir: dir}, nil
+}
+
+func (s *FileJobStore) path(id string) string {
+	return filepath.Join(s.dir, url.PathEscape(id)+".json")
+}
+
+// SaveJob - writes the job, replacing a job with the same ID.
+func (s *FileJobStore) SaveJob(job JobHandle) error {
+	if job.ID == "" {
+		return ErrInvalidArgument("Job ID cannot be empty.")
+	}
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Write and rename so a crash never leaves a partial file behind.
+	tmp := s.path(job.ID) + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path(job.ID))
+}
+
+// LoadJob - reads the job with the given ID.
+func (s *FileJobStore) LoadJob(id string) (JobHandle, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := ioutil.ReadFile(s.path(id))
+	if os.IsNotExist(err) {
+		return JobHandle{}, ErrJobNotFound
+	}
+	if err != nil {
+		return JobHandle{}, err
+	}
+	var job JobHandle
+	err = json.Unmarshal(data, &job)
+	return job, err
+}
+
+// DeleteJob - removes the job with the given ID, if any.
+func (s *FileJobStore) DeleteJob(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.Remove(s.path(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// ListJobs - returns all jobs, oldest first.
+func (s *FileJobStore) ListJobs() ([]JobHandle, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var jobs []JobHandle
+	for _, f := range files {
+		if !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(s.dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var job JobHandle
+		if err = json.Unmarshal(data, &job); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Started.Before(jobs[j].Started) })
+	return jobs, nil
+}
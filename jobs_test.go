@@ -0,0 +1,98 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+)
+
+func TestResumeHealJob(t *testing.T) {
+	summary := "running"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("clientToken") == "" {
+			w.Write([]byte(`{"clientToken":"token/1","startTime":"2021-05-01T00:00:00Z"}`))
+			return
+		}
+		if r.URL.Query().Get("clientToken") != "token/1" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"summary":"` + summary + `"}`))
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "jobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := NewFileJobStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm.SetJobStore(store)
+	job, err := adm.StartHealJob(context.Background(), "bucket", "prefix", HealOpts{Recursive: true}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A restarted client finds the job in the store.
+	restarted, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err = NewFileJobStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restarted.SetJobStore(store)
+	jobs, err := store.ListJobs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || jobs[0] != job || job.Type != JobTypeHeal || job.Bucket != "bucket" {
+		t.Fatalf("unexpected jobs %+v", jobs)
+	}
+
+	status, err := restarted.ResumeJob(context.Background(), job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Done || status.Summary != "running" || status.Heal == nil {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	summary = "finished"
+	if status, err = restarted.ResumeJob(context.Background(), job.ID); err != nil || !status.Done {
+		t.Errorf("unexpected status %+v, %v", status, err)
+	}
+	if _, err = restarted.ResumeJob(context.Background(), job.ID); err != ErrJobNotFound {
+		t.Errorf("expected %v once the job finished, got %v", ErrJobNotFound, err)
+	}
+}
@@ -0,0 +1,122 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// KafkaProducer is implemented by the caller on top of the Kafka
+// client of its choice. The key is used by the producer's partitioner,
+// records with the same key always land on the same partition.
+type KafkaProducer interface {
+	Produce(ctx context.Context, topic string, key, value []byte) error
+}
+
+// KafkaSerializer encodes a record before it is published, callers
+// needing Avro plug in a serializer bound to their schema registry.
+type KafkaSerializer func(v interface{}) ([]byte, error)
+
+// KafkaJSONSerializer is the default serializer.
+func KafkaJSONSerializer(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// KafkaSinkOptions - options for NewKafkaSink.
+type KafkaSinkOptions struct {
+	Topic      string
+	Producer   KafkaProducer
+	Serializer KafkaSerializer // defaults to KafkaJSONSerializer
+	// Redaction is applied to every record before it is serialized.
+	Redaction *RedactionPolicy
+}
+
+// KafkaSink publishes trace and audit records to a Kafka topic,
+// keyed by the node name that produced them.
+type KafkaSink struct {
+	opts KafkaSinkOptions
+}
+
+// NewKafkaSink - returns a new Kafka sink.
+func NewKafkaSink(opts KafkaSinkOptions) (*KafkaSink, error) {
+	if opts.Topic == "" {
+		return nil, ErrInvalidArgument("Kafka topic cannot be empty.")
+	}
+	if opts.Producer == nil {
+		return nil, ErrInvalidArgument("Kafka producer cannot be nil.")
+	}
+	if opts.Serializer == nil {
+		opts.Serializer = KafkaJSONSerializer
+	}
+	return &KafkaSink{opts: opts}, nil
+}
+
+// Publish - serializes v and publishes it partitioned by node.
+func (s *KafkaSink) Publish(ctx context.Context, node string, v interface{}) error {
+	v, err := s.opts.Redaction.Redact(v)
+	if err != nil {
+		return err
+	}
+	value, err := s.opts.Serializer(v)
+	if err != nil {
+		return err
+	}
+	return s.opts.Producer.Produce(ctx, s.opts.Topic, []byte(node), value)
+}
+
+// ForwardTrace - publishes every trace received from ServiceTrace until
+// traceCh is closed or ctx is canceled.
+func (s *KafkaSink) ForwardTrace(ctx context.Context, traceCh <-chan ServiceTraceInfo) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case info, ok := <-traceCh:
+			if !ok {
+				return nil
+			}
+			if info.Err != nil {
+				return info.Err
+			}
+			if err := s.Publish(ctx, info.Trace.NodeName, info.Trace); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ForwardLogs - publishes every log message received from GetLogs,
+// audit kind messages included, until logCh is closed or ctx is canceled.
+func (s *KafkaSink) ForwardLogs(ctx context.Context, logCh <-chan LogInfo) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case info, ok := <-logCh:
+			if !ok {
+				return nil
+			}
+			if info.Err != nil {
+				return info.Err
+			}
+			if err := s.Publish(ctx, info.NodeName, info); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,139 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type kafkaRecord struct {
+	topic      string
+	key, value []byte
+}
+
+// fakeKafkaProducer - records the produced records, failing from the
+// failAt-th record on if set.
+type fakeKafkaProducer struct {
+	records []kafkaRecord
+	failAt  int
+}
+
+func (p *fakeKafkaProducer) Produce(ctx context.Context, topic string, key, value []byte) error {
+	if p.failAt > 0 && len(p.records)+1 >= p.failAt {
+		return errors.New("broker unavailable")
+	}
+	p.records = append(p.records, kafkaRecord{topic: topic, key: key, value: value})
+	return nil
+}
+
+func TestNewKafkaSink(t *testing.T) {
+	if _, err := NewKafkaSink(KafkaSinkOptions{Producer: &fakeKafkaProducer{}}); err == nil {
+		t.Error("expected an error without topic")
+	}
+	if _, err := NewKafkaSink(KafkaSinkOptions{Topic: "trace"}); err == nil {
+		t.Error("expected an error without producer")
+	}
+}
+
+func TestKafkaSinkPublish(t *testing.T) {
+	policy, err := NewRedactionPolicy([]RedactionRule{{Path: "$.ConsoleMsg"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	producer := &fakeKafkaProducer{}
+	sink, err := NewKafkaSink(KafkaSinkOptions{Topic: "logs", Producer: producer, Redaction: policy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = sink.Publish(context.Background(), "node1", LogInfo{NodeName: "node1", ConsoleMsg: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(producer.records) != 1 || producer.records[0].topic != "logs" || string(producer.records[0].key) != "node1" {
+		t.Fatalf("unexpected records %+v", producer.records)
+	}
+	var info LogInfo
+	if err = json.Unmarshal(producer.records[0].value, &info); err != nil {
+		t.Fatal(err)
+	}
+	if info.ConsoleMsg != DefaultRedactionReplacement {
+		t.Errorf("expected the record to be redacted, got %q", info.ConsoleMsg)
+	}
+
+	serializerErr := errors.New("schema mismatch")
+	sink.opts.Serializer = func(v interface{}) ([]byte, error) { return nil, serializerErr }
+	if err = sink.Publish(context.Background(), "node1", LogInfo{}); !errors.Is(err, serializerErr) {
+		t.Errorf("expected the serializer error, got %v", err)
+	}
+	if len(producer.records) != 1 {
+		t.Errorf("expected nothing to be produced on serializer errors, got %d records", len(producer.records))
+	}
+}
+
+func TestKafkaSinkForward(t *testing.T) {
+	producer := &fakeKafkaProducer{}
+	sink, err := NewKafkaSink(KafkaSinkOptions{Topic: "trace", Producer: producer})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A batch of buffered records is flushed before the closed channel
+	// ends forwarding.
+	traceCh := make(chan ServiceTraceInfo, 3)
+	for _, node := range []string{"node1", "node2", "node1"} {
+		traceCh <- ServiceTraceInfo{Trace: TraceInfo{NodeName: node, FuncName: "s3.GetObject"}}
+	}
+	close(traceCh)
+	if err = sink.ForwardTrace(context.Background(), traceCh); err != nil {
+		t.Fatal(err)
+	}
+	if len(producer.records) != 3 || string(producer.records[1].key) != "node2" {
+		t.Fatalf("expected the 3 traces keyed by node, got %+v", producer.records)
+	}
+
+	logCh := make(chan LogInfo, 2)
+	logCh <- LogInfo{NodeName: "node3", ConsoleMsg: "started"}
+	logCh <- LogInfo{Err: errors.New("stream closed")}
+	if err = sink.ForwardLogs(context.Background(), logCh); err == nil || err.Error() != "stream closed" {
+		t.Errorf("expected the stream error, got %v", err)
+	}
+	if len(producer.records) != 4 || string(producer.records[3].key) != "node3" {
+		t.Errorf("expected the log before the error to be produced, got %+v", producer.records)
+	}
+
+	// Producer errors stop forwarding.
+	producer.failAt = 6
+	logCh = make(chan LogInfo, 3)
+	for i := 0; i < 3; i++ {
+		logCh <- LogInfo{NodeName: "node1"}
+	}
+	close(logCh)
+	if err = sink.ForwardLogs(context.Background(), logCh); err == nil || err.Error() != "broker unavailable" {
+		t.Errorf("expected the producer error, got %v", err)
+	}
+	if len(producer.records) != 5 || len(logCh) != 1 {
+		t.Errorf("expected forwarding to stop at the failed record, got %d records and %d pending", len(producer.records), len(logCh))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err = sink.ForwardTrace(ctx, make(chan ServiceTraceInfo)); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context error, got %v", err)
+	}
+}
@@ -18,7 +18,6 @@ package madmin
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 	"net/url"
 )
@@ -66,7 +65,7 @@ func (adm *AdminClient) GetKeyStatus(ctx context.Context, keyID string) (*KMSKey
 		return nil, httpRespToErrorResponse(resp)
 	}
 	var keyInfo KMSKeyStatus
-	if err = json.NewDecoder(resp.Body).Decode(&keyInfo); err != nil {
+	if err = adm.decodeResponse(resp, &keyInfo); err != nil {
 		return nil, err
 	}
 	return &keyInfo, nil
@@ -0,0 +1,113 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"time"
+)
+
+// DefaultLatencyBounds are the bucket bounds in seconds used for drive
+// latency series, from 100µs to 5s.
+var DefaultLatencyBounds = []float64{
+	0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005,
+	0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5,
+}
+
+// LatencySeries - latency histograms of consecutive intervals of a perf
+// run, compact enough to be rendered as a heatmap or time series.
+type LatencySeries struct {
+	// Interval covered by every row, in seconds.
+	Interval float64 `json:"interval" yaml:"interval"`
+	// Bounds are the inclusive upper bounds of the buckets in seconds,
+	// in ascending order. The last bucket of a row counts the samples
+	// above the last bound.
+	Bounds []float64 `json:"bounds" yaml:"bounds"`
+	// Counts holds one row of len(Bounds)+1 bucket counts per interval.
+	Counts [][]uint32 `json:"counts" yaml:"counts"`
+}
+
+// NewLatencySeries - returns an empty series, DefaultLatencyBounds are
+// used if bounds is empty.
+func NewLatencySeries(interval time.Duration, bounds []float64) *LatencySeries {
+	if len(bounds) == 0 {
+		bounds = DefaultLatencyBounds
+	}
+	return &LatencySeries{
+		Interval: interval.Seconds(),
+		Bounds:   append([]float64(nil), bounds...),
+	}
+}
+
+// maxLatencySeriesRows - limits the memory used by a series.
+const maxLatencySeriesRows = 10000
+
+// Record - adds a sample taken at offset since the start of the run.
+// Samples at negative offsets or more than 10000 intervals into the
+// run are dropped, false is returned for them.
+func (s *LatencySeries) Record(offset, latency time.Duration) bool {
+	if offset < 0 {
+		return false
+	}
+	row := 0
+	if s.Interval > 0 {
+		if rows := offset.Seconds() / s.Interval; rows < maxLatencySeriesRows {
+			row = int(rows)
+		} else {
+			return false
+		}
+	}
+	for len(s.Counts) <= row {
+		s.Counts = append(s.Counts, make([]uint32, len(s.Bounds)+1))
+	}
+	seconds := latency.Seconds()
+	bucket := len(s.Bounds)
+	for i, b := range s.Bounds {
+		if seconds <= b {
+			bucket = i
+			break
+		}
+	}
+	s.Counts[row][bucket]++
+	return true
+}
+
+// Total - returns the histogram of the whole run.
+func (s LatencySeries) Total() []uint32 {
+	total := make([]uint32, len(s.Bounds)+1)
+	for _, row := range s.Counts {
+		for i, c := range row {
+			if i < len(total) {
+				total[i] += c
+			}
+		}
+	}
+	return total
+}
+
+// Percentile - returns the upper bound in seconds of the bucket the
+// p-th percentile, between 0 and 1, of a row falls in. It returns +Inf
+// if it falls in the last bucket and 0 if the row is empty.
+func (s LatencySeries) Percentile(row int, p float64) float64 {
+	if row < 0 || row >= len(s.Counts) {
+		return 0
+	}
+	return s.percentile(s.Counts[row], p)
+}
+
+func (s LatencySeries) percentile(counts []uint32, p float64) float64 {
+	return Histogram{Bounds: s.Bounds, Counts: counts}.Percentile(p)
+}
@@ -0,0 +1,61 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLatencySeries(t *testing.T) {
+	s := NewLatencySeries(time.Second, []float64{0.001, 0.01})
+	for i := 0; i < 9; i++ {
+		s.Record(100*time.Millisecond, 500*time.Microsecond)
+	}
+	s.Record(900*time.Millisecond, 5*time.Millisecond)
+	s.Record(2500*time.Millisecond, time.Second)
+
+	expected := [][]uint32{{9, 1, 0}, {0, 0, 0}, {0, 0, 1}}
+	if !reflect.DeepEqual(s.Counts, expected) {
+		t.Fatalf("expected %v, got %v", expected, s.Counts)
+	}
+	if total := s.Total(); !reflect.DeepEqual(total, []uint32{9, 1, 1}) {
+		t.Errorf("unexpected total %v", total)
+	}
+	if p := s.Percentile(0, 0.5); p != 0.001 {
+		t.Errorf("expected p50 of 0.001, got %v", p)
+	}
+	if p := s.Percentile(0, 0.99); p != 0.01 {
+		t.Errorf("expected p99 of 0.01, got %v", p)
+	}
+	if p := s.Percentile(2, 0.5); !math.IsInf(p, 1) {
+		t.Errorf("expected +Inf, got %v", p)
+	}
+	if p := s.Percentile(1, 0.5); p != 0 {
+		t.Errorf("expected 0 for an empty row, got %v", p)
+	}
+
+	// Out of range offsets are dropped.
+	if s.Record(-time.Second, time.Millisecond) || s.Record(1000*time.Hour, time.Millisecond) {
+		t.Error("expected out of range samples to be dropped")
+	}
+	if !reflect.DeepEqual(s.Counts, expected) {
+		t.Errorf("expected %v, got %v", expected, s.Counts)
+	}
+}
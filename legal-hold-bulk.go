@@ -0,0 +1,68 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// LegalHoldStatus - legal hold of an object.
+type LegalHoldStatus string
+
+// Legal hold statuses.
+const (
+	LegalHoldOn  LegalHoldStatus = "ON"
+	LegalHoldOff LegalHoldStatus = "OFF"
+)
+
+// LegalHoldBulkOpts - options of PutLegalHoldBulk.
+type LegalHoldBulkOpts struct {
+	Bucket string
+	Prefix string
+	// Status is applied to all versions of all objects below Prefix,
+	// LegalHoldOff removes legal holds.
+	Status LegalHoldStatus
+	// PollInterval between status requests, 5 seconds if zero.
+	PollInterval time.Duration
+	// OnProgress is called with every status, if set.
+	OnProgress func(BatchJobStatus)
+}
+
+// legalHoldJob - the batch job YAML applying a legal hold.
+func legalHoldJob(opts LegalHoldBulkOpts) string {
+	return fmt.Sprintf("legalhold:\n  apiVersion: v1\n  bucket: %s\n  prefix: %s\n  status: %s\n",
+		strconv.Quote(opts.Bucket), strconv.Quote(opts.Prefix), opts.Status)
+}
+
+// PutLegalHoldBulk - starts a batch job applying or removing legal
+// holds below a prefix and waits for it to end. The job is recorded in
+// the job store, so that it can be resumed with ResumeJob when the
+// wait is interrupted. Object failures are reported in the returned
+// status, an error is only returned if the job could not be started
+// or tracked.
+func (adm *AdminClient) PutLegalHoldBulk(ctx context.Context, opts LegalHoldBulkOpts) (BatchJobStatus, error) {
+	if opts.Bucket == "" {
+		return BatchJobStatus{}, ErrInvalidArgument("Bucket name cannot be empty.")
+	}
+	if opts.Status != LegalHoldOn && opts.Status != LegalHoldOff {
+		return BatchJobStatus{}, ErrInvalidArgument("Invalid legal hold status " + string(opts.Status) + ".")
+	}
+	return adm.runBatchJob(ctx, legalHoldJob(opts), opts.Bucket, opts.Prefix, opts.PollInterval, opts.OnProgress)
+}
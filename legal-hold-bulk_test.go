@@ -0,0 +1,100 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPutLegalHoldBulk(t *testing.T) {
+	var polls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case libraryAdminURLPrefix + adminAPIPrefix + "/start-job":
+			job, _ := ioutil.ReadAll(r.Body)
+			if !strings.HasPrefix(string(job), "legalhold:\n") || !strings.Contains(string(job), "status: ON") {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"Code":"AccessDenied","Message":"unexpected job"}`))
+				return
+			}
+			w.Write([]byte(`{"id":"job1","type":"legalhold","started":"2021-05-01T00:00:00Z"}`))
+		case libraryAdminURLPrefix + adminAPIPrefix + "/status-job":
+			polls++
+			if polls < 3 {
+				w.Write([]byte(`{"lastMetric":{"jobID":"job1","objects":10}}`))
+				return
+			}
+			w.Write([]byte(`{"lastMetric":{"jobID":"job1","complete":true,"objects":20,"objectsFailed":1,"lastError":"object is in use"}}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "jobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := NewFileJobStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm.SetJobStore(store)
+
+	var progress []BatchJobStatus
+	status, err := adm.PutLegalHoldBulk(context.Background(), LegalHoldBulkOpts{
+		Bucket:       "bucket",
+		Prefix:       "cases/",
+		Status:       LegalHoldOn,
+		PollInterval: time.Millisecond,
+		OnProgress: func(s BatchJobStatus) {
+			// The job is tracked while it runs.
+			if jobs, _ := store.ListJobs(); !s.Done() && (len(jobs) != 1 || jobs[0].Type != JobTypeBatch) {
+				t.Errorf("unexpected jobs %+v", jobs)
+			}
+			progress = append(progress, s)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.Complete || status.ObjectsFailed != 1 || status.LastError == "" || len(progress) != 3 {
+		t.Errorf("unexpected status %+v after %d updates", status, len(progress))
+	}
+	if jobs, _ := store.ListJobs(); len(jobs) != 0 {
+		t.Errorf("expected the finished job removed, got %+v", jobs)
+	}
+
+	if _, err = adm.PutLegalHoldBulk(context.Background(), LegalHoldBulkOpts{Bucket: "bucket", Status: "on"}); err == nil {
+		t.Error("expected an error for an invalid status")
+	}
+}
@@ -0,0 +1,171 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// mcHostEnvPrefix - mc reads aliases from MC_HOST_<alias> environment
+// variables in the form https://ACCESS:SECRET[:TOKEN]@host.
+const mcHostEnvPrefix = "MC_HOST_"
+
+// MCAlias - an alias of the mc configuration file.
+type MCAlias struct {
+	URL          string `json:"url"`
+	AccessKey    string `json:"accessKey"`
+	SecretKey    string `json:"secretKey"`
+	SessionToken string `json:"sessionToken,omitempty"`
+	API          string `json:"api"`
+	Path         string `json:"path"`
+}
+
+// MCConfig - mc configuration file, usually ~/.mc/config.json.
+type MCConfig struct {
+	Version string             `json:"version"`
+	Aliases map[string]MCAlias `json:"aliases"`
+}
+
+// DefaultMCConfigPath - returns the path of the mc configuration file,
+// honoring MC_CONFIG_DIR like mc does.
+func DefaultMCConfigPath() (string, error) {
+	if dir := os.Getenv("MC_CONFIG_DIR"); dir != "" {
+		return filepath.Join(dir, "config.json"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	dir := ".mc"
+	if runtime.GOOS == "windows" {
+		dir = "mc"
+	}
+	return filepath.Join(home, dir, "config.json"), nil
+}
+
+// LoadMCConfig - reads an mc configuration file, the default location
+// is used if path is empty. Configuration files of mc releases before
+// version 10, which named aliases "hosts", are read as well.
+func LoadMCConfig(path string) (*MCConfig, error) {
+	if path == "" {
+		var err error
+		if path, err = DefaultMCConfigPath(); err != nil {
+			return nil, err
+		}
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg struct {
+		MCConfig
+		Hosts map[string]MCAlias `json:"hosts"`
+	}
+	if err = json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("unable to parse mc config %s: %w", path, err)
+	}
+	if cfg.Aliases == nil {
+		cfg.Aliases = cfg.Hosts
+	}
+	if cfg.Aliases == nil {
+		cfg.Aliases = make(map[string]MCAlias)
+	}
+	return &cfg.MCConfig, nil
+}
+
+// Alias - returns the named alias, a MC_HOST_<alias> environment
+// variable takes precedence over the configuration file.
+func (c *MCConfig) Alias(name string) (MCAlias, error) {
+	if env, ok := os.LookupEnv(mcHostEnvPrefix + name); ok {
+		return parseMCHostEnv(env)
+	}
+	alias, ok := c.Aliases[name]
+	if !ok {
+		return MCAlias{}, ErrInvalidArgument("mc alias " + name + " not found.")
+	}
+	return alias, nil
+}
+
+// parseMCHostEnv - parses https://ACCESS:SECRET[:TOKEN]@host.
+func parseMCHostEnv(env string) (MCAlias, error) {
+	u, err := url.Parse(env)
+	if err != nil {
+		return MCAlias{}, err
+	}
+	if u.User == nil {
+		return MCAlias{}, ErrInvalidArgument("mc host environment variable has no credentials.")
+	}
+	alias := MCAlias{API: "s3v4", Path: "auto"}
+	alias.AccessKey = u.User.Username()
+	secret, _ := u.User.Password()
+	if i := strings.Index(secret, ":"); i >= 0 {
+		secret, alias.SessionToken = secret[:i], secret[i+1:]
+	}
+	alias.SecretKey = secret
+	u.User = nil
+	alias.URL = u.String()
+	return alias, nil
+}
+
+// NewAdminClient - returns an admin client for the alias.
+func (a MCAlias) NewAdminClient() (*AdminClient, error) {
+	if a.API != "" && !strings.EqualFold(a.API, "s3v4") {
+		return nil, ErrInvalidArgument("mc alias uses signature " + a.API + ", only S3v4 is supported.")
+	}
+	u, err := url.Parse(a.URL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, ErrInvalidArgument("mc alias URL " + a.URL + " has no host.")
+	}
+	return NewWithOptions(u.Host, &Options{
+		Creds:  credentials.NewStaticV4(a.AccessKey, a.SecretKey, a.SessionToken),
+		Secure: u.Scheme == "https",
+	})
+}
+
+// NewFromMCAlias - returns an admin client for an alias of the default
+// mc configuration file.
+func NewFromMCAlias(alias string) (*AdminClient, error) {
+	if env, ok := os.LookupEnv(mcHostEnvPrefix + alias); ok {
+		a, err := parseMCHostEnv(env)
+		if err != nil {
+			return nil, err
+		}
+		return a.NewAdminClient()
+	}
+	cfg, err := LoadMCConfig("")
+	if err != nil {
+		return nil, err
+	}
+	a, err := cfg.Alias(alias)
+	if err != nil {
+		return nil, err
+	}
+	return a.NewAdminClient()
+}
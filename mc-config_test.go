@@ -0,0 +1,79 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadMCConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mc-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	configs := map[string]string{
+		"v10": `{"version":"10","aliases":{"play":{"url":"https://play.min.io","accessKey":"Q3AM3UQ867SPQQA43P2F","secretKey":"secret","api":"S3v4","path":"auto"}}}`,
+		"v9":  `{"version":"9","hosts":{"play":{"url":"https://play.min.io","accessKey":"Q3AM3UQ867SPQQA43P2F","secretKey":"secret","api":"S3v4","path":"auto"}}}`,
+	}
+	for name, data := range configs {
+		path := filepath.Join(dir, name+".json")
+		if err = ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+		cfg, err := LoadMCConfig(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		alias, err := cfg.Alias("play")
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if alias.URL != "https://play.min.io" || alias.AccessKey != "Q3AM3UQ867SPQQA43P2F" {
+			t.Errorf("%s: unexpected alias %+v", name, alias)
+		}
+		adm, err := alias.NewAdminClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if adm.endpointURL.Host != "play.min.io" || !adm.secure {
+			t.Errorf("%s: unexpected endpoint %s", name, adm.endpointURL)
+		}
+		if _, err = cfg.Alias("missing"); err == nil {
+			t.Errorf("%s: expected missing alias error", name)
+		}
+	}
+
+	os.Setenv("MC_HOST_local", "http://minio:minio123:token@localhost:9000")
+	defer os.Unsetenv("MC_HOST_local")
+	alias, err := (&MCConfig{}).Alias("local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alias.URL != "http://localhost:9000" || alias.AccessKey != "minio" ||
+		alias.SecretKey != "minio123" || alias.SessionToken != "token" {
+		t.Errorf("unexpected alias from environment %+v", alias)
+	}
+
+	if _, err = (MCAlias{URL: "https://play.min.io", API: "S3v2"}).NewAdminClient(); err == nil {
+		t.Error("expected S3v2 alias to be rejected")
+	}
+}
@@ -0,0 +1,193 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/shirou/gopsutil/disk"
+)
+
+// Names of the NAS mount checks.
+const (
+	NASCheckAtomicRename = "atomic-rename"
+	NASCheckDirectIO     = "o-direct"
+	NASCheckLocking      = "fcntl-locking"
+	NASCheckSparseFiles  = "sparse-files"
+)
+
+// nasFSTypes are the network filesystems validated by GetNASMounts.
+var nasFSTypes = map[string]bool{
+	"nfs":   true,
+	"nfs4":  true,
+	"cifs":  true,
+	"smb":   true,
+	"smb3":  true,
+	"smbfs": true,
+}
+
+// NASMountValidation - results of the functional tests run on a drive
+// backed by an NFS or SMB mount.
+type NASMountValidation struct {
+	Path       string           `json:"path" yaml:"path"`
+	Mountpoint string           `json:"mountpoint" yaml:"mountpoint"`
+	FSType     string           `json:"fs_type" yaml:"fs_type"`
+	Checks     []PreflightCheck `json:"checks" yaml:"checks"`
+}
+
+// Passed - returns true if no check of the mount failed.
+func (v NASMountValidation) Passed() bool {
+	for _, c := range v.Checks {
+		if c.Status == PreflightFail {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *NASMountValidation) add(name string, err error) {
+	if err != nil {
+		v.Checks = append(v.Checks, PreflightCheck{Name: name, Status: PreflightFail, Message: err.Error()})
+		return
+	}
+	v.Checks = append(v.Checks, PreflightCheck{Name: name, Status: PreflightPass})
+}
+
+// NASMounts - validation of the NAS backed drives of a node.
+type NASMounts struct {
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Mounts []NASMountValidation `json:"mounts,omitempty" yaml:"mounts,omitempty"`
+}
+
+// GetNASMounts returns the validation of the drives of a node which are
+// on NFS or SMB mounts, other drives are skipped.
+func GetNASMounts(ctx context.Context, addr string, drivePaths []string) NASMounts {
+	parts, err := disk.PartitionsWithContext(ctx, true)
+	if err != nil {
+		return NASMounts{
+			Addr:  addr,
+			Error: err.Error(),
+		}
+	}
+
+	mounts := NASMounts{Addr: addr}
+	for _, path := range drivePaths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			continue
+		}
+		mount := mountOf(absPath, parts)
+		if mount == nil || !nasFSTypes[mount.Fstype] {
+			continue
+		}
+		v := ValidateNASMount(ctx, absPath)
+		v.Mountpoint, v.FSType = mount.Mountpoint, mount.Fstype
+		mounts.Mounts = append(mounts.Mounts, v)
+	}
+	return mounts
+}
+
+// ValidateNASMount - checks that the filesystem of a drive provides the
+// semantics MinIO relies on by running functional tests in it: atomic
+// rename over an existing file, O_DIRECT, fcntl locking and sparse
+// files. The test files are removed afterwards.
+func ValidateNASMount(ctx context.Context, drivePath string) NASMountValidation {
+	v := NASMountValidation{Path: drivePath}
+	dir, err := ioutil.TempDir(drivePath, ".minio-nas-check-")
+	if err != nil {
+		for _, name := range []string{NASCheckAtomicRename, NASCheckDirectIO, NASCheckLocking, NASCheckSparseFiles} {
+			v.add(name, fmt.Errorf("drive is not writable: %w", err))
+		}
+		return v
+	}
+	defer os.RemoveAll(dir)
+
+	for _, check := range []struct {
+		name string
+		fn   func(dir string) error
+	}{
+		{NASCheckAtomicRename, checkAtomicRename},
+		{NASCheckDirectIO, checkDirectIO},
+		{NASCheckLocking, checkFcntlLocking},
+		{NASCheckSparseFiles, checkSparseFile},
+	} {
+		if ctx.Err() != nil {
+			v.add(check.name, ctx.Err())
+			continue
+		}
+		v.add(check.name, check.fn(dir))
+	}
+	return v
+}
+
+// checkAtomicRename - renames a file over an existing one, which must
+// leave the new content in place and no trace of the source.
+func checkAtomicRename(dir string) error {
+	dst, src := filepath.Join(dir, "dst"), filepath.Join(dir, "src")
+	if err := ioutil.WriteFile(dst, []byte("old"), 0600); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(src, []byte("new"), 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(src, dst); err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(dst)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(data, []byte("new")) {
+		return fmt.Errorf("renamed file has content %q", data)
+	}
+	if _, err = os.Stat(src); !os.IsNotExist(err) {
+		return fmt.Errorf("source of the rename still exists")
+	}
+	return nil
+}
+
+// checkSparseFile - writes a byte past a hole, which must not allocate
+// the hole.
+func checkSparseFile(dir string) error {
+	const holeSize = 64 << 20
+	f, err := os.Create(filepath.Join(dir, "sparse"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err = f.WriteAt([]byte{1}, holeSize); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	allocated, err := allocatedSize(f)
+	if err != nil {
+		return err
+	}
+	if allocated >= holeSize {
+		return fmt.Errorf("%s allocated for a file with a %s hole", ByteSize(allocated), ByteSize(holeSize))
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build linux
+
+package madmin
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+)
+
+// directIOAlignment is the buffer and offset alignment O_DIRECT needs.
+const directIOAlignment = 4096
+
+// checkDirectIO - writes and reads back an aligned block with O_DIRECT.
+func checkDirectIO(dir string) error {
+	name := filepath.Join(dir, "direct")
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_RDWR|syscall.O_DIRECT, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	buf := alignedBlock(directIOAlignment)
+	for i := range buf {
+		buf[i] = byte(i)
+	}
+	if _, err = f.WriteAt(buf, 0); err != nil {
+		return err
+	}
+	_, err = f.ReadAt(alignedBlock(directIOAlignment), 0)
+	return err
+}
+
+// alignedBlock - returns a buffer of size bytes aligned to size.
+func alignedBlock(size int) []byte {
+	buf := make([]byte, 2*size)
+	offset := size - int(uintptr(unsafe.Pointer(&buf[0]))&uintptr(size-1))
+	if offset == size {
+		offset = 0
+	}
+	return buf[offset : offset+size]
+}
+
+// checkFcntlLocking - takes and releases an exclusive byte-range lock.
+func checkFcntlLocking(dir string) error {
+	f, err := os.Create(filepath.Join(dir, "lock"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	lock := syscall.Flock_t{Type: syscall.F_WRLCK, Whence: 0, Start: 0, Len: 0}
+	if err = syscall.FcntlFlock(f.Fd(), syscall.F_SETLK, &lock); err != nil {
+		return err
+	}
+	lock.Type = syscall.F_UNLCK
+	return syscall.FcntlFlock(f.Fd(), syscall.F_SETLK, &lock)
+}
+
+// allocatedSize - returns the bytes allocated on disk for f.
+func allocatedSize(f *os.File) (int64, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, errPreflightUnsupported
+	}
+	return st.Blocks * 512, nil
+}
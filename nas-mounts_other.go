@@ -0,0 +1,33 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build !linux
+
+package madmin
+
+import "os"
+
+func checkDirectIO(dir string) error {
+	return errPreflightUnsupported
+}
+
+func checkFcntlLocking(dir string) error {
+	return errPreflightUnsupported
+}
+
+func allocatedSize(f *os.File) (int64, error) {
+	return 0, errPreflightUnsupported
+}
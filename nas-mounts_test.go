@@ -0,0 +1,49 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"testing"
+)
+
+func TestValidateNASMount(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("NAS checks need linux")
+	}
+	dir, err := ioutil.TempDir("", "nas-check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	v := ValidateNASMount(context.Background(), dir)
+	if len(v.Checks) != 4 {
+		t.Fatalf("expected 4 checks, got %+v", v.Checks)
+	}
+	for _, name := range []string{NASCheckAtomicRename, NASCheckLocking} {
+		if status := preflightStatus(DrivePreflight{Checks: v.Checks}, name); status != PreflightPass {
+			t.Errorf("%s: expected pass, got %s: %+v", name, status, v.Checks)
+		}
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+		t.Errorf("test files left behind: %d", len(files))
+	}
+}
@@ -0,0 +1,266 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// procNetBonding is where the kernel reports the bond status, replaced
+// in tests.
+var procNetBonding = "/proc/net/bonding"
+
+// Drivers of NetBond.
+const (
+	NetBondDriverBonding = "bonding"
+	NetBondDriverTeam    = "team"
+)
+
+// NetLACPAggregator - the active 802.3ad aggregator of a bond.
+type NetLACPAggregator struct {
+	ID         int    `json:"id" yaml:"id"`
+	Ports      int    `json:"ports" yaml:"ports"`
+	ActorKey   int    `json:"actor_key" yaml:"actor_key"`
+	PartnerKey int    `json:"partner_key" yaml:"partner_key"`
+	PartnerMAC string `json:"partner_mac" yaml:"partner_mac"`
+}
+
+// NetBondSlave - status of an interface of a bond or team.
+type NetBondSlave struct {
+	Name      string `json:"name" yaml:"name"`
+	MIIStatus string `json:"mii_status" yaml:"mii_status"`
+	Speed     int    `json:"speed,omitempty" yaml:"speed,omitempty"`
+	Duplex    string `json:"duplex,omitempty" yaml:"duplex,omitempty"`
+	// LinkFailures counts the link losses since the slave was added.
+	LinkFailures uint64 `json:"link_failures" yaml:"link_failures"`
+	// AggregatorID differs from the active aggregator for 802.3ad
+	// slaves which failed to join it. The churn states are "churned"
+	// if the LACP negotiation did not converge.
+	AggregatorID      int    `json:"aggregator_id,omitempty" yaml:"aggregator_id,omitempty"`
+	ActorChurnState   string `json:"actor_churn_state,omitempty" yaml:"actor_churn_state,omitempty"`
+	PartnerChurnState string `json:"partner_churn_state,omitempty" yaml:"partner_churn_state,omitempty"`
+}
+
+// Degraded - returns true if a slave of the bond is down, failed to
+// join the active LACP aggregator or churned.
+func (b NetBond) Degraded() bool {
+	for _, s := range b.SlaveStatus {
+		if s.MIIStatus != "up" {
+			return true
+		}
+		if b.Aggregator != nil && s.AggregatorID != 0 && s.AggregatorID != b.Aggregator.ID {
+			return true
+		}
+		if s.ActorChurnState == "churned" || s.PartnerChurnState == "churned" {
+			return true
+		}
+	}
+	return len(b.SlaveStatus) == 0 && b.MIIStatus != "" && b.MIIStatus != "up"
+}
+
+// readProcBonding - adds the status of /proc/net/bonding/<name> to bond,
+// if readable.
+func readProcBonding(bond *NetBond, name string) {
+	f, err := os.Open(filepath.Join(procNetBonding, name))
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	parseProcBonding(f, bond)
+}
+
+// parseProcBonding - parses the bond status of /proc/net/bonding, which
+// lists the bond settings followed by one section per slave, with
+// lines like "MII Status: up".
+func parseProcBonding(r io.Reader, bond *NetBond) {
+	var slave *NetBondSlave
+	atoi := func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n
+	}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		i := strings.IndexByte(line, ':')
+		if i < 0 {
+			continue
+		}
+		key, value := strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:])
+		if key == "Slave Interface" {
+			bond.SlaveStatus = append(bond.SlaveStatus, NetBondSlave{Name: value})
+			slave = &bond.SlaveStatus[len(bond.SlaveStatus)-1]
+			continue
+		}
+		if slave != nil {
+			switch key {
+			case "MII Status":
+				slave.MIIStatus = value
+			case "Speed":
+				slave.Speed = atoi(strings.TrimSuffix(value, " Mbps"))
+			case "Duplex":
+				slave.Duplex = value
+			case "Link Failure Count":
+				slave.LinkFailures, _ = strconv.ParseUint(value, 10, 64)
+			case "Aggregator ID":
+				slave.AggregatorID = atoi(value)
+			case "Actor Churn State":
+				slave.ActorChurnState = value
+			case "Partner Churn State":
+				slave.PartnerChurnState = value
+			}
+			continue
+		}
+		switch key {
+		case "MII Status":
+			bond.MIIStatus = value
+		case "Currently Active Slave":
+			bond.ActiveSlave = value
+		case "LACP rate":
+			bond.LACPRate = value
+		case "Aggregator ID":
+			bond.Aggregator = &NetLACPAggregator{ID: atoi(value)}
+		}
+		if bond.Aggregator != nil {
+			switch key {
+			case "Number of ports":
+				bond.Aggregator.Ports = atoi(value)
+			case "Actor Key":
+				bond.Aggregator.ActorKey = atoi(value)
+			case "Partner Key":
+				bond.Aggregator.PartnerKey = atoi(value)
+			case "Partner Mac Address":
+				bond.Aggregator.PartnerMAC = value
+			}
+		}
+	}
+}
+
+// addTeams - sets the bond of team interfaces, i.e. masters of other
+// interfaces which are neither bonds nor bridges, read with teamdctl if
+// installed.
+func addTeams(ctx context.Context, ifaces []NetInterface) {
+	slaves := make(map[string][]string)
+	for _, iface := range ifaces {
+		if iface.Master != "" {
+			slaves[iface.Master] = append(slaves[iface.Master], iface.Name)
+		}
+	}
+	for i := range ifaces {
+		iface := &ifaces[i]
+		if iface.Bond != nil || len(slaves[iface.Name]) == 0 {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(sysfsRoot, "class/net", iface.Name, "bridge")); err == nil {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(ctx, ethtoolTimeout)
+		out, err := exec.CommandContext(ctx, "teamdctl", iface.Name, "state", "dump").Output()
+		cancel()
+		if err != nil {
+			continue
+		}
+		if bond, err := parseTeamdState(out); err == nil {
+			bond.Slaves = slaves[iface.Name]
+			iface.Bond = &bond
+		}
+	}
+}
+
+// teamdState - the parts of `teamdctl <team> state dump` output used.
+type teamdState struct {
+	Setup struct {
+		RunnerName string `json:"runner_name"`
+	} `json:"setup"`
+	Runner struct {
+		ActivePort string `json:"active_port"`
+		FastRate   *bool  `json:"fast_rate"`
+	} `json:"runner"`
+	Ports map[string]struct {
+		Link struct {
+			Up     bool   `json:"up"`
+			Speed  int    `json:"speed"`
+			Duplex string `json:"duplex"`
+		} `json:"link"`
+		LinkWatches struct {
+			List map[string]struct {
+				DownCount uint64 `json:"down_count"`
+			} `json:"list"`
+		} `json:"link_watches"`
+		Runner struct {
+			Aggregator struct {
+				ID int `json:"id"`
+			} `json:"aggregator"`
+			Selected bool `json:"selected"`
+		} `json:"runner"`
+	} `json:"ports"`
+}
+
+// parseTeamdState - converts teamd state to a bond, the LACP aggregator
+// is the one of the selected ports.
+func parseTeamdState(out []byte) (NetBond, error) {
+	var state teamdState
+	if err := json.Unmarshal(out, &state); err != nil {
+		return NetBond{}, err
+	}
+	bond := NetBond{
+		Mode:        state.Setup.RunnerName,
+		Driver:      NetBondDriverTeam,
+		ActiveSlave: state.Runner.ActivePort,
+		MIIStatus:   "down",
+	}
+	if state.Runner.FastRate != nil {
+		bond.LACPRate = "slow"
+		if *state.Runner.FastRate {
+			bond.LACPRate = "fast"
+		}
+	}
+	for name, port := range state.Ports {
+		slave := NetBondSlave{
+			Name:         name,
+			MIIStatus:    "down",
+			Speed:        port.Link.Speed,
+			Duplex:       port.Link.Duplex,
+			AggregatorID: port.Runner.Aggregator.ID,
+		}
+		if port.Link.Up {
+			slave.MIIStatus = "up"
+			bond.MIIStatus = "up"
+		}
+		for _, w := range port.LinkWatches.List {
+			slave.LinkFailures += w.DownCount
+		}
+		if port.Runner.Selected && port.Runner.Aggregator.ID != 0 {
+			if bond.Aggregator == nil {
+				bond.Aggregator = &NetLACPAggregator{ID: port.Runner.Aggregator.ID}
+			}
+			bond.Aggregator.Ports++
+		}
+		bond.SlaveStatus = append(bond.SlaveStatus, slave)
+	}
+	// teamd reports the ports in a map.
+	sort.Slice(bond.SlaveStatus, func(i, j int) bool { return bond.SlaveStatus[i].Name < bond.SlaveStatus[j].Name })
+	return bond, nil
+}
@@ -0,0 +1,125 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseProcBonding(t *testing.T) {
+	status := `Ethernet Channel Bonding Driver: v5.15.0
+
+Bonding Mode: IEEE 802.3ad Dynamic link aggregation
+Transmit Hash Policy: layer3+4 (1)
+MII Status: up
+MII Polling Interval (ms): 100
+
+802.3ad info
+LACP active: on
+LACP rate: fast
+Active Aggregator Info:
+	Aggregator ID: 1
+	Number of ports: 1
+	Actor Key: 15
+	Partner Key: 1
+	Partner Mac Address: 00:11:22:33:44:55
+
+Slave Interface: eth0
+MII Status: up
+Speed: 25000 Mbps
+Duplex: full
+Link Failure Count: 0
+Permanent HW addr: 0c:42:a1:00:00:01
+Aggregator ID: 1
+Actor Churn State: none
+Partner Churn State: none
+
+Slave Interface: eth1
+MII Status: up
+Speed: 25000 Mbps
+Duplex: full
+Link Failure Count: 4
+Permanent HW addr: 0c:42:a1:00:00:02
+Aggregator ID: 2
+Actor Churn State: churned
+Partner Churn State: churned
+`
+	bond := NetBond{Mode: "802.3ad", Driver: NetBondDriverBonding}
+	parseProcBonding(strings.NewReader(status), &bond)
+	expected := NetBond{
+		Mode:       "802.3ad",
+		Driver:     NetBondDriverBonding,
+		MIIStatus:  "up",
+		LACPRate:   "fast",
+		Aggregator: &NetLACPAggregator{ID: 1, Ports: 1, ActorKey: 15, PartnerKey: 1, PartnerMAC: "00:11:22:33:44:55"},
+		SlaveStatus: []NetBondSlave{
+			{Name: "eth0", MIIStatus: "up", Speed: 25000, Duplex: "full", AggregatorID: 1, ActorChurnState: "none", PartnerChurnState: "none"},
+			{Name: "eth1", MIIStatus: "up", Speed: 25000, Duplex: "full", LinkFailures: 4, AggregatorID: 2, ActorChurnState: "churned", PartnerChurnState: "churned"},
+		},
+	}
+	if !reflect.DeepEqual(bond, expected) {
+		t.Errorf("expected %+v, got %+v", expected, bond)
+	}
+	if !bond.Degraded() {
+		t.Error("expected a bond with a slave outside the aggregator to be degraded")
+	}
+	bond.SlaveStatus = bond.SlaveStatus[:1]
+	if bond.Degraded() {
+		t.Error("expected a healthy bond")
+	}
+}
+
+func TestParseTeamdState(t *testing.T) {
+	bond, err := parseTeamdState([]byte(`{
+	"setup": {"runner_name": "lacp"},
+	"runner": {"active": true, "fast_rate": false},
+	"ports": {
+		"eth1": {
+			"link": {"duplex": "full", "speed": 10000, "up": false},
+			"link_watches": {"list": {"link_watch_0": {"down_count": 2, "name": "ethtool", "up": false}}},
+			"runner": {"aggregator": {"id": 4}, "selected": false}
+		},
+		"eth0": {
+			"link": {"duplex": "full", "speed": 10000, "up": true},
+			"link_watches": {"list": {"link_watch_0": {"down_count": 0, "name": "ethtool", "up": true}}},
+			"runner": {"aggregator": {"id": 3}, "selected": true}
+		}
+	}
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := NetBond{
+		Mode:       "lacp",
+		Driver:     NetBondDriverTeam,
+		MIIStatus:  "up",
+		LACPRate:   "slow",
+		Aggregator: &NetLACPAggregator{ID: 3, Ports: 1},
+		SlaveStatus: []NetBondSlave{
+			{Name: "eth0", MIIStatus: "up", Speed: 10000, Duplex: "full", AggregatorID: 3},
+			{Name: "eth1", MIIStatus: "down", Speed: 10000, Duplex: "full", LinkFailures: 2, AggregatorID: 4},
+		},
+	}
+	if !reflect.DeepEqual(bond, expected) {
+		t.Errorf("expected %+v, got %+v", expected, bond)
+	}
+	if !bond.Degraded() {
+		t.Error("expected a team with a port down to be degraded")
+	}
+}
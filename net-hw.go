@@ -0,0 +1,220 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ethtoolTimeout bounds every ethtool run.
+const ethtoolTimeout = 10 * time.Second
+
+// NetBond - bonding configuration of a bond or team interface.
+type NetBond struct {
+	Mode   string   `json:"mode" yaml:"mode"`
+	Slaves []string `json:"slaves,omitempty" yaml:"slaves,omitempty"`
+	// Driver is NetBondDriverBonding or NetBondDriverTeam, the status
+	// below is read from /proc/net/bonding or teamdctl respectively.
+	Driver      string `json:"driver,omitempty" yaml:"driver,omitempty"`
+	MIIStatus   string `json:"mii_status,omitempty" yaml:"mii_status,omitempty"`
+	ActiveSlave string `json:"active_slave,omitempty" yaml:"active_slave,omitempty"`
+	// LACPRate and Aggregator are only reported for 802.3ad (LACP).
+	LACPRate    string             `json:"lacp_rate,omitempty" yaml:"lacp_rate,omitempty"`
+	Aggregator  *NetLACPAggregator `json:"aggregator,omitempty" yaml:"aggregator,omitempty"`
+	SlaveStatus []NetBondSlave     `json:"slave_status,omitempty" yaml:"slave_status,omitempty"`
+}
+
+// NetInterface - hardware information of a network interface.
+type NetInterface struct {
+	Name  string `json:"name" yaml:"name"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	// Model is the PCI vendor and device ID, e.g. "0x8086:0x1572",
+	// empty for virtual interfaces.
+	Model  string `json:"model,omitempty" yaml:"model,omitempty"`
+	Driver string `json:"driver,omitempty" yaml:"driver,omitempty"`
+	MAC    string `json:"mac,omitempty" yaml:"mac,omitempty"`
+	State  string `json:"state,omitempty" yaml:"state,omitempty"`
+	// Speed is the negotiated speed in Mbit/s, zero if unknown.
+	Speed int `json:"speed,omitempty" yaml:"speed,omitempty"`
+	MTU   int `json:"mtu,omitempty" yaml:"mtu,omitempty"`
+	// Ring buffer sizes are read with ethtool, if installed.
+	RxRing    uint64 `json:"rx_ring,omitempty" yaml:"rx_ring,omitempty"`
+	TxRing    uint64 `json:"tx_ring,omitempty" yaml:"tx_ring,omitempty"`
+	RxRingMax uint64 `json:"rx_ring_max,omitempty" yaml:"rx_ring_max,omitempty"`
+	TxRingMax uint64 `json:"tx_ring_max,omitempty" yaml:"tx_ring_max,omitempty"`
+	// Bond is set for bond interfaces and Master for their slaves.
+	Bond   *NetBond `json:"bond,omitempty" yaml:"bond,omitempty"`
+	Master string   `json:"master,omitempty" yaml:"master,omitempty"`
+
+	RxErrors  uint64 `json:"rx_errors" yaml:"rx_errors"`
+	TxErrors  uint64 `json:"tx_errors" yaml:"tx_errors"`
+	RxDropped uint64 `json:"rx_dropped" yaml:"rx_dropped"`
+	TxDropped uint64 `json:"tx_dropped" yaml:"tx_dropped"`
+}
+
+// SysNetHw - network interfaces of a node.
+type SysNetHw struct {
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Interfaces []NetInterface `json:"interfaces,omitempty" yaml:"interfaces,omitempty"`
+}
+
+// GetNetInfo returns the hardware information of the network interfaces,
+// but loopback, of a node running linux.
+func GetNetInfo(ctx context.Context, addr string) SysNetHw {
+	if runtime.GOOS != "linux" {
+		return SysNetHw{
+			Addr:  addr,
+			Error: "unsupported operating system " + runtime.GOOS,
+		}
+	}
+
+	dirs, err := ioutil.ReadDir(filepath.Join(sysfsRoot, "class/net"))
+	if err != nil {
+		return SysNetHw{
+			Addr:  addr,
+			Error: err.Error(),
+		}
+	}
+
+	info := SysNetHw{Addr: addr}
+	for _, dir := range dirs {
+		if dir.Name() == "lo" {
+			continue
+		}
+		iface := readNetInterface(filepath.Join(sysfsRoot, "class/net", dir.Name()))
+		if ring, err := ethtoolRing(ctx, iface.Name); err == nil {
+			iface.RxRing, iface.TxRing = ring.rx, ring.tx
+			iface.RxRingMax, iface.TxRingMax = ring.rxMax, ring.txMax
+		}
+		info.Interfaces = append(info.Interfaces, iface)
+	}
+	addTeams(ctx, info.Interfaces)
+	sort.Slice(info.Interfaces, func(i, j int) bool { return info.Interfaces[i].Name < info.Interfaces[j].Name })
+	return info
+}
+
+// readNetInterface - reads an interface from its sysfs directory.
+func readNetInterface(dir string) NetInterface {
+	read := func(name string) string {
+		data, _ := ioutil.ReadFile(filepath.Join(dir, name))
+		return strings.TrimSpace(string(data))
+	}
+	readUint := func(name string) uint64 {
+		v, _ := strconv.ParseUint(read(name), 10, 64)
+		return v
+	}
+
+	iface := NetInterface{Name: filepath.Base(dir)}
+	if _, err := os.Stat(dir); err != nil {
+		iface.Error = err.Error()
+		return iface
+	}
+	iface.MAC = read("address")
+	iface.State = read("operstate")
+	iface.MTU, _ = strconv.Atoi(read("mtu"))
+	// Reading the speed fails on interfaces which are down and is -1
+	// for interfaces without link.
+	if speed, err := strconv.Atoi(read("speed")); err == nil && speed > 0 {
+		iface.Speed = speed
+	}
+	if vendor, device := read("device/vendor"), read("device/device"); vendor != "" && device != "" {
+		iface.Model = vendor + ":" + device
+	}
+	if driver, err := os.Readlink(filepath.Join(dir, "device/driver")); err == nil {
+		iface.Driver = filepath.Base(driver)
+	}
+	if master, err := os.Readlink(filepath.Join(dir, "master")); err == nil {
+		iface.Master = filepath.Base(master)
+	}
+	if mode := read("bonding/mode"); mode != "" {
+		// The mode reads like "802.3ad 4".
+		iface.Bond = &NetBond{
+			Mode:   strings.Fields(mode)[0],
+			Slaves: strings.Fields(read("bonding/slaves")),
+			Driver: NetBondDriverBonding,
+		}
+		readProcBonding(iface.Bond, iface.Name)
+	}
+	iface.RxErrors = readUint("statistics/rx_errors")
+	iface.TxErrors = readUint("statistics/tx_errors")
+	iface.RxDropped = readUint("statistics/rx_dropped")
+	iface.TxDropped = readUint("statistics/tx_dropped")
+	return iface
+}
+
+type ethtoolRingSizes struct {
+	rx, tx, rxMax, txMax uint64
+}
+
+// ethtoolRing - reads the ring buffer sizes of an interface.
+func ethtoolRing(ctx context.Context, name string) (ethtoolRingSizes, error) {
+	ctx, cancel := context.WithTimeout(ctx, ethtoolTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, "ethtool", "-g", name).Output()
+	if err != nil {
+		return ethtoolRingSizes{}, err
+	}
+	return parseEthtoolRing(strings.NewReader(string(out))), nil
+}
+
+// parseEthtoolRing - parses `ethtool -g` output, which lists the
+// maximum sizes followed by the current ones.
+func parseEthtoolRing(r io.Reader) ethtoolRingSizes {
+	var sizes ethtoolRingSizes
+	current := false
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "Current hardware settings") {
+			current = true
+			continue
+		}
+		i := strings.IndexByte(line, ':')
+		if i < 0 {
+			continue
+		}
+		v, err := strconv.ParseUint(strings.TrimSpace(line[i+1:]), 10, 64)
+		if err != nil {
+			continue
+		}
+		switch key := strings.TrimSpace(line[:i]); {
+		case key == "RX" && current:
+			sizes.rx = v
+		case key == "TX" && current:
+			sizes.tx = v
+		case key == "RX":
+			sizes.rxMax = v
+		case key == "TX":
+			sizes.txMax = v
+		}
+	}
+	return sizes
+}
@@ -0,0 +1,107 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadNetInterface(t *testing.T) {
+	root, err := ioutil.TempDir("", "sysfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	write := func(path, content string) {
+		path = filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("eth0/mtu", "9000")
+	write("eth0/speed", "25000")
+	write("eth0/operstate", "up")
+	write("eth0/address", "0c:42:a1:00:00:01")
+	write("eth0/device/vendor", "0x15b3")
+	write("eth0/device/device", "0x1017")
+	write("eth0/statistics/rx_errors", "3")
+	write("eth0/statistics/tx_dropped", "7")
+	write("drivers/mlx5_core/.keep", "")
+	if err = os.Symlink(filepath.Join(root, "drivers/mlx5_core"), filepath.Join(root, "eth0/device/driver")); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink(filepath.Join(root, "bond0"), filepath.Join(root, "eth0/master")); err != nil {
+		t.Fatal(err)
+	}
+	write("bond0/mtu", "9000")
+	write("bond0/speed", "-1")
+	write("bond0/bonding/mode", "802.3ad 4")
+	write("bond0/bonding/slaves", "eth0 eth1")
+
+	eth0 := readNetInterface(filepath.Join(root, "eth0"))
+	expected := NetInterface{
+		Name:      "eth0",
+		Model:     "0x15b3:0x1017",
+		Driver:    "mlx5_core",
+		MAC:       "0c:42:a1:00:00:01",
+		State:     "up",
+		Speed:     25000,
+		MTU:       9000,
+		Master:    "bond0",
+		RxErrors:  3,
+		TxDropped: 7,
+	}
+	if !reflect.DeepEqual(eth0, expected) {
+		t.Errorf("expected %+v, got %+v", expected, eth0)
+	}
+
+	bond0 := readNetInterface(filepath.Join(root, "bond0"))
+	if bond0.Speed != 0 || bond0.Model != "" || bond0.Bond == nil || bond0.Bond.Mode != "802.3ad" || len(bond0.Bond.Slaves) != 2 {
+		t.Errorf("unexpected bond %+v", bond0)
+	}
+
+	if missing := readNetInterface(filepath.Join(root, "eth9")); missing.Error == "" {
+		t.Error("expected an error for a missing interface")
+	}
+}
+
+func TestParseEthtoolRing(t *testing.T) {
+	out := `Ring parameters for eth0:
+Pre-set maximums:
+RX:		8192
+RX Mini:	n/a
+RX Jumbo:	n/a
+TX:		8192
+Current hardware settings:
+RX:		1024
+RX Mini:	n/a
+RX Jumbo:	n/a
+TX:		512
+`
+	sizes := parseEthtoolRing(strings.NewReader(out))
+	if sizes != (ethtoolRingSizes{rx: 1024, tx: 512, rxMax: 8192, txMax: 8192}) {
+		t.Errorf("unexpected ring sizes %+v", sizes)
+	}
+}
@@ -0,0 +1,168 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Defaults of NetperfClientOpts.
+const (
+	defaultNetperfClientSize   = 64 << 20
+	defaultNetperfClientRounds = 8
+)
+
+// netperfClientPath - discards uploads and sends size bytes of
+// zeros on downloads.
+const netperfClientPath = "/speedtest/client/devnull"
+
+// NetperfClientOpts - options of NetperfClient.
+type NetperfClientOpts struct {
+	// Nodes are the host:port of the servers to measure, all servers
+	// of the cluster by default.
+	Nodes []string
+	// Size is the number of bytes uploaded and downloaded per node,
+	// 64 MiB by default, split into Rounds transfers, 8 by default.
+	Size   int64
+	Rounds int
+}
+
+// NetperfClientResult - network performance from the client machine to
+// a node. Latency is the round-trip time of empty requests in seconds,
+// throughputs are in bytes per second.
+type NetperfClientResult struct {
+	Endpoint string `json:"endpoint"`
+	Error    string `json:"error,omitempty"`
+
+	Latency  Latency    `json:"latency"`
+	Upload   Throughput `json:"upload"`
+	Download Throughput `json:"download"`
+}
+
+// NetperfClient - measures the latency and upload and download
+// throughput from the client machine to each node, one node at a
+// time, to diagnose client-side network bottlenecks.
+func (adm *AdminClient) NetperfClient(ctx context.Context, opts NetperfClientOpts) ([]NetperfClientResult, error) {
+	if opts.Size < 0 || opts.Rounds < 0 {
+		return nil, ErrInvalidArgument("Size and rounds must not be negative.")
+	}
+	if opts.Size == 0 {
+		opts.Size = defaultNetperfClientSize
+	}
+	if opts.Rounds == 0 {
+		opts.Rounds = defaultNetperfClientRounds
+	}
+	nodes := opts.Nodes
+	if len(nodes) == 0 {
+		info, err := adm.ServerInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range info.Servers {
+			nodes = append(nodes, s.Endpoint)
+		}
+	}
+
+	results := make([]NetperfClientResult, 0, len(nodes))
+	for _, node := range nodes {
+		result := NetperfClientResult{Endpoint: node}
+		if err := adm.netperfClientNode(WithNode(ctx, node), opts, &result); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// netperfClientNode - measures the node ctx is sent to.
+func (adm *AdminClient) netperfClientNode(ctx context.Context, opts NetperfClientOpts, result *NetperfClientResult) error {
+	chunk := opts.Size / int64(opts.Rounds)
+	if chunk == 0 {
+		chunk = 1
+	}
+	payload := make([]byte, chunk)
+
+	var rtts []time.Duration
+	var uploads, downloads []uint64
+	for i := 0; i < opts.Rounds; i++ {
+		rtt, err := adm.netperfClientRequest(ctx, http.MethodPost, nil, 0)
+		if err != nil {
+			return err
+		}
+		rtts = append(rtts, rtt)
+
+		elapsed, err := adm.netperfClientRequest(ctx, http.MethodPost, payload, 0)
+		if err != nil {
+			return err
+		}
+		uploads = append(uploads, uint64(float64(chunk)/elapsed.Seconds()))
+
+		elapsed, err = adm.netperfClientRequest(ctx, http.MethodGet, nil, chunk)
+		if err != nil {
+			return err
+		}
+		downloads = append(downloads, uint64(float64(chunk)/elapsed.Seconds()))
+	}
+	result.Latency = ComputeLatency(rtts)
+	result.Upload = ComputeThroughput(uploads)
+	result.Download = ComputeThroughput(downloads)
+	return nil
+}
+
+// netperfClientRequest - returns the time to upload content or to
+// download size bytes, including reading the response.
+func (adm *AdminClient) netperfClientRequest(ctx context.Context, method string, content []byte, size int64) (time.Duration, error) {
+	// Retries would distort the measurement, failures are reported.
+	reqData := requestData{
+		relPath:     adminAPIPrefix + netperfClientPath,
+		content:     content,
+		retryPolicy: &RetryPolicy{MaxRetry: 1},
+	}
+	if size > 0 {
+		reqData.queryValues = url.Values{"size": []string{strconv.FormatInt(size, 10)}}
+	}
+	start := time.Now()
+	resp, err := adm.executeMethod(ctx, method, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, httpRespToErrorResponse(resp)
+	}
+	n, err := io.Copy(ioutil.Discard, resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if n < size {
+		return 0, io.ErrUnexpectedEOF
+	}
+	elapsed := time.Since(start)
+	if elapsed <= 0 {
+		elapsed = time.Nanosecond
+	}
+	return elapsed, nil
+}
@@ -0,0 +1,92 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestNetperfClient(t *testing.T) {
+	var uploaded, downloaded int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/minio/admin/v3/speedtest/client/devnull" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodPost:
+			n, _ := io.Copy(ioutil.Discard, r.Body)
+			atomic.AddInt64(&uploaded, n)
+		case http.MethodGet:
+			size, _ := strconv.ParseInt(r.URL.Query().Get("size"), 10, 64)
+			n, _ := w.Write(make([]byte, size))
+			atomic.AddInt64(&downloaded, int64(n))
+		}
+	}))
+	defer ts.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer failing.Close()
+
+	u, _ := url.Parse(ts.URL)
+	f, _ := url.Parse(failing.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Measuring is allowed on read-only clients.
+	adm.readOnly = true
+	results, err := adm.NetperfClient(context.Background(), NetperfClientOpts{
+		Nodes:  []string{u.Host, f.Host},
+		Size:   1 << 20,
+		Rounds: 4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %+v", results)
+	}
+	ok := results[0]
+	if ok.Endpoint != u.Host || ok.Error != "" {
+		t.Fatalf("unexpected result %+v", ok)
+	}
+	if uploaded != 1<<20 || downloaded != 1<<20 {
+		t.Errorf("expected 1 MiB each way, uploaded %d downloaded %d", uploaded, downloaded)
+	}
+	if ok.Latency.Min <= 0 || ok.Latency.Min > ok.Latency.Percentile50 || ok.Latency.Percentile99 > ok.Latency.Max {
+		t.Errorf("unexpected latency %+v", ok.Latency)
+	}
+	if ok.Upload.Avg <= 0 || ok.Download.Min <= 0 || ok.Download.Min > ok.Download.Max {
+		t.Errorf("unexpected throughput upload %+v download %+v", ok.Upload, ok.Download)
+	}
+	if results[1].Endpoint != f.Host || results[1].Error == "" {
+		t.Errorf("expected an error for the failing node, got %+v", results[1])
+	}
+
+	if _, err = adm.NetperfClient(context.Background(), NetperfClientOpts{Rounds: -1}); err == nil {
+		t.Error("expected an error for negative rounds")
+	}
+}
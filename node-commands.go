@@ -0,0 +1,130 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// NodeHealthInfo - like ServerHealthInfo, but collected by and for
+// node only, a host:port of a server of the cluster, which is addressed
+// directly instead of through the endpoint of the client.
+func (adm *AdminClient) NodeHealthInfo(ctx context.Context, node string, types []HealthDataType, deadline time.Duration) (*http.Response, string, error) {
+	if node == "" {
+		return nil, "", ErrInvalidArgument("Node cannot be empty.")
+	}
+	return adm.serverHealthInfo(WithNode(ctx, node), types, HealthInfoOpts{Deadline: deadline}, node)
+}
+
+// NodeLogs - like GetLogs, but reads the console log of node only,
+// addressing it directly instead of through the endpoint of the client.
+func (adm *AdminClient) NodeLogs(ctx context.Context, node string, lineCnt int, logKind string) <-chan LogInfo {
+	if node == "" {
+		logCh := make(chan LogInfo, 1)
+		logCh <- LogInfo{Err: ErrInvalidArgument("Node cannot be empty.")}
+		close(logCh)
+		return logCh
+	}
+	return adm.GetLogs(WithNode(ctx, node), node, lineCnt, logKind)
+}
+
+// nodeMetricsPath - Prometheus metrics of the server answering the
+// request only.
+const nodeMetricsPath = "/minio/v2/metrics/node"
+
+// NodeMetrics - scrapes the Prometheus node metrics of node, returning
+// the value of every series keyed by name and labels as exposed, e.g.
+// minio_node_file_descriptor_open_total{server="node1:9000"}.
+func (adm *AdminClient) NodeMetrics(ctx context.Context, node string) (map[string]float64, error) {
+	if node == "" {
+		return nil, ErrInvalidArgument("Node cannot be empty.")
+	}
+	creds, err := adm.credsProvider.Get()
+	if err != nil {
+		return nil, err
+	}
+	token, err := prometheusToken(creds.AccessKeyID, creds.SecretAccessKey, adm.now().Add(time.Hour))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adm.executeMethod(WithNode(ctx, node), http.MethodGet, requestData{
+		relPath:           nodeMetricsPath,
+		rootPath:          true,
+		unboundedResponse: true,
+		bearerToken:       token,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+	return parsePrometheusText(resp.Body)
+}
+
+// prometheusToken - returns the JWT the server expects from Prometheus
+// scrapers, signed with the secret key like `mc admin prometheus generate`.
+func prometheusToken(accessKey, secretKey string, expiry time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS512, jwt.StandardClaims{
+		ExpiresAt: expiry.Unix(),
+		Subject:   accessKey,
+		Issuer:    "prometheus",
+	})
+	return token.SignedString([]byte(secretKey))
+}
+
+// parsePrometheusText - parses the samples of the Prometheus text
+// exposition format, ignoring comments and timestamps.
+func parsePrometheusText(r io.Reader) (map[string]float64, error) {
+	samples := make(map[string]float64)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64<<10), 1<<20)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// Label values may contain spaces, the value follows the labels.
+		series, rest := line, ""
+		if i := strings.LastIndex(line, "}"); i > 0 {
+			series, rest = line[:i+1], line[i+1:]
+		} else if i := strings.IndexByte(line, ' '); i > 0 {
+			series, rest = line[:i], line[i:]
+		}
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("malformed metrics line %q", line)
+		}
+		v, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed metrics line %q: %w", line, err)
+		}
+		samples[series] = v
+	}
+	return samples, scanner.Err()
+}
@@ -0,0 +1,83 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNodeCommands(t *testing.T) {
+	lb := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer lb.Close()
+	var node string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == nodeMetricsPath:
+			parts := strings.Split(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), ".")
+			claims, _ := base64.RawURLEncoding.DecodeString(parts[1])
+			if len(parts) != 3 || !strings.Contains(string(claims), `"sub":"minioadmin"`) {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Write([]byte("# HELP minio_node_process_uptime_seconds Uptime\n" +
+				"minio_node_process_uptime_seconds{server=\"" + node + "\"} 42\n" +
+				"minio_node_drive_free{drive=\"/mnt/disk 1\"} 1e+09 1623456789\n"))
+		case strings.HasSuffix(r.URL.Path, "/healthinfo") && r.URL.Query().Get("node") == node:
+			w.Write([]byte(`{"version":"` + HealthInfoVersion + `"}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(lb.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nu, _ := url.Parse(ts.URL)
+	node = nu.Host
+
+	// Scraping metrics is allowed on read-only clients.
+	adm.readOnly = true
+	metrics, err := adm.NodeMetrics(context.Background(), node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm.readOnly = false
+	if metrics[`minio_node_process_uptime_seconds{server="`+node+`"}`] != 42 || metrics[`minio_node_drive_free{drive="/mnt/disk 1"}`] != 1e9 {
+		t.Errorf("unexpected metrics %v", metrics)
+	}
+
+	resp, version, err := adm.NodeHealthInfo(context.Background(), node, []HealthDataType{HealthDataTypeSysCPU}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	closeResponse(resp)
+	if version != HealthInfoVersion {
+		t.Errorf("unexpected version %s", version)
+	}
+}
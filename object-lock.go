@@ -0,0 +1,174 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// RetentionMode - object lock retention mode.
+type RetentionMode string
+
+// Object lock retention modes.
+const (
+	RetentionGovernance RetentionMode = "GOVERNANCE"
+	RetentionCompliance RetentionMode = "COMPLIANCE"
+)
+
+// ErrRetentionReduced is returned for object lock changes reducing
+// compliance retention which were not explicitly allowed.
+var ErrRetentionReduced = errors.New("change reduces compliance retention")
+
+// ObjectLockConfig - object lock configuration of a bucket, Mode is
+// empty if there is no default retention.
+type ObjectLockConfig struct {
+	Enabled bool          `json:"enabled"`
+	Mode    RetentionMode `json:"mode,omitempty"`
+	Days    int           `json:"days,omitempty"`
+	Years   int           `json:"years,omitempty"`
+}
+
+// retentionDays - the default retention period in days.
+func (c ObjectLockConfig) retentionDays() int {
+	return c.Days + c.Years*365
+}
+
+// reducesCompliance - returns true if changing from c to next weakens
+// a compliance mode default retention.
+func (c ObjectLockConfig) reducesCompliance(next ObjectLockConfig) bool {
+	if c.Mode != RetentionCompliance {
+		return false
+	}
+	return !next.Enabled || next.Mode != RetentionCompliance || next.retentionDays() < c.retentionDays()
+}
+
+type objectLockConfiguration struct {
+	XMLName           xml.Name        `xml:"ObjectLockConfiguration"`
+	XMLNS             string          `xml:"xmlns,attr,omitempty"`
+	ObjectLockEnabled string          `xml:"ObjectLockEnabled,omitempty"`
+	Rule              *objectLockRule `xml:"Rule,omitempty"`
+}
+
+type objectLockRule struct {
+	DefaultRetention struct {
+		Mode  RetentionMode `xml:"Mode"`
+		Days  int           `xml:"Days,omitempty"`
+		Years int           `xml:"Years,omitempty"`
+	} `xml:"DefaultRetention"`
+}
+
+// RetentionChange - an attempt to reduce compliance retention.
+type RetentionChange struct {
+	Time   time.Time        `json:"time"`
+	Bucket string           `json:"bucket"`
+	From   ObjectLockConfig `json:"from"`
+	To     ObjectLockConfig `json:"to"`
+	// Allowed is set if the change was explicitly allowed and sent
+	// to the server.
+	Allowed bool `json:"allowed"`
+}
+
+// ObjectLockOpts - options of SetObjectLockConfig.
+type ObjectLockOpts struct {
+	// AllowRetentionReduction sends changes which reduce compliance
+	// retention, which are refused otherwise.
+	AllowRetentionReduction bool
+}
+
+// SetRetentionChangeLog - calls fn with every attempt to reduce
+// compliance retention, whether refused or allowed.
+func (adm *AdminClient) SetRetentionChangeLog(fn func(RetentionChange)) {
+	adm.retentionChangeLog = fn
+}
+
+// GetObjectLockConfig - returns the object lock configuration of a
+// bucket.
+func (adm *AdminClient) GetObjectLockConfig(ctx context.Context, bucket string) (ObjectLockConfig, error) {
+	if bucket == "" {
+		return ObjectLockConfig{}, ErrInvalidArgument("Bucket name cannot be empty.")
+	}
+	var lock objectLockConfiguration
+	if err := adm.s3Request(ctx, http.MethodGet, bucket, "object-lock", nil, nil, &lock); err != nil {
+		if ToErrorResponse(err).Code == "ObjectLockConfigurationNotFoundError" {
+			return ObjectLockConfig{}, nil
+		}
+		return ObjectLockConfig{}, err
+	}
+	cfg := ObjectLockConfig{Enabled: lock.ObjectLockEnabled == "Enabled"}
+	if lock.Rule != nil {
+		cfg.Mode = lock.Rule.DefaultRetention.Mode
+		cfg.Days = lock.Rule.DefaultRetention.Days
+		cfg.Years = lock.Rule.DefaultRetention.Years
+	}
+	return cfg, nil
+}
+
+// SetObjectLockConfig - replaces the object lock configuration of a
+// bucket, an empty Mode removes the default retention. Changes which
+// remove or shorten a compliance mode default retention are refused
+// with ErrRetentionReduced unless opts allows them.
+func (adm *AdminClient) SetObjectLockConfig(ctx context.Context, bucket string, cfg ObjectLockConfig, opts ObjectLockOpts) error {
+	if cfg.Mode != "" && cfg.Mode != RetentionGovernance && cfg.Mode != RetentionCompliance {
+		return ErrInvalidArgument("Invalid retention mode " + string(cfg.Mode) + ".")
+	}
+	if cfg.Mode != "" && (cfg.Days > 0) == (cfg.Years > 0) {
+		return ErrInvalidArgument("Either days or years of retention must be set.")
+	}
+	if err := adm.checkReadOnly(http.MethodPut, "/"+bucket+"?object-lock"); err != nil {
+		return err
+	}
+
+	current, err := adm.GetObjectLockConfig(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if current.reducesCompliance(cfg) {
+		if adm.retentionChangeLog != nil {
+			adm.retentionChangeLog(RetentionChange{
+				Time:    adm.now().UTC(),
+				Bucket:  bucket,
+				From:    current,
+				To:      cfg,
+				Allowed: opts.AllowRetentionReduction,
+			})
+		}
+		if !opts.AllowRetentionReduction {
+			return fmt.Errorf("%w: bucket %s has %d days of compliance retention", ErrRetentionReduced, bucket, current.retentionDays())
+		}
+	}
+
+	lock := objectLockConfiguration{XMLNS: "http://s3.amazonaws.com/doc/2006-03-01/"}
+	if cfg.Enabled {
+		lock.ObjectLockEnabled = "Enabled"
+	}
+	if cfg.Mode != "" {
+		lock.Rule = &objectLockRule{}
+		lock.Rule.DefaultRetention.Mode = cfg.Mode
+		lock.Rule.DefaultRetention.Days = cfg.Days
+		lock.Rule.DefaultRetention.Years = cfg.Years
+	}
+	body, err := xml.Marshal(lock)
+	if err != nil {
+		return err
+	}
+	return adm.s3Request(ctx, http.MethodPut, bucket, "object-lock", nil, body, nil)
+}
@@ -0,0 +1,87 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestObjectLockGuardrails(t *testing.T) {
+	current := []byte(`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>COMPLIANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>`)
+	var puts int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts++
+			current, _ = ioutil.ReadAll(r.Body)
+			return
+		}
+		w.Write(current)
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var changes []RetentionChange
+	adm.SetRetentionChangeLog(func(change RetentionChange) {
+		changes = append(changes, change)
+	})
+
+	ctx := context.Background()
+	cfg, err := adm.GetObjectLockConfig(ctx, "bucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg != (ObjectLockConfig{Enabled: true, Mode: RetentionCompliance, Days: 30}) {
+		t.Fatalf("unexpected config %+v", cfg)
+	}
+
+	for _, reduced := range []ObjectLockConfig{
+		{Enabled: true, Mode: RetentionCompliance, Days: 7},
+		{Enabled: true, Mode: RetentionGovernance, Days: 30},
+		{Enabled: true},
+	} {
+		if err = adm.SetObjectLockConfig(ctx, "bucket", reduced, ObjectLockOpts{}); !errors.Is(err, ErrRetentionReduced) {
+			t.Errorf("%+v: expected %v, got %v", reduced, ErrRetentionReduced, err)
+		}
+	}
+	if puts != 0 || len(changes) != 3 || changes[0].Allowed {
+		t.Fatalf("unexpected puts %d or changes %+v", puts, changes)
+	}
+
+	// Longer retention needs no override.
+	if err = adm.SetObjectLockConfig(ctx, "bucket", ObjectLockConfig{Enabled: true, Mode: RetentionCompliance, Years: 1}, ObjectLockOpts{}); err != nil {
+		t.Fatal(err)
+	}
+	if err = adm.SetObjectLockConfig(ctx, "bucket", ObjectLockConfig{Enabled: true}, ObjectLockOpts{AllowRetentionReduction: true}); err != nil {
+		t.Fatal(err)
+	}
+	if puts != 2 || len(changes) != 4 || !changes[3].Allowed || changes[3].From.Years != 1 {
+		t.Errorf("unexpected puts %d or changes %+v", puts, changes)
+	}
+	if cfg, _ = adm.GetObjectLockConfig(ctx, "bucket"); cfg != (ObjectLockConfig{Enabled: true}) {
+		t.Errorf("expected the default retention removed, got %+v", cfg)
+	}
+}
@@ -0,0 +1,103 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrReadOnlyClient is returned when a client created with
+// Options.ReadOnly is asked to call a mutating admin API.
+var ErrReadOnlyClient = errors.New("admin client is read-only")
+
+// readOnlyAdminAPIs - allowlist of admin APIs an observer client may
+// call, keyed by HTTP method and path relative to the admin prefix.
+// Every new non-mutating API must be added here.
+var readOnlyAdminAPIs = map[string]struct{}{
+	http.MethodGet + " " + adminAPIPrefix + "/accountinfo":            {},
+	http.MethodGet + " " + adminAPIPrefix + "/bandwidth":              {},
+	http.MethodGet + " " + adminAPIPrefix + "/config":                 {},
+	http.MethodGet + " " + adminAPIPrefix + "/datausageinfo":          {},
+	http.MethodGet + " " + adminAPIPrefix + "/debug/faults":           {},
+	http.MethodGet + " " + adminAPIPrefix + "/drive-transitions":      {},
+	http.MethodGet + " " + adminAPIPrefix + "/get-bucket-quota":       {},
+	http.MethodGet + " " + adminAPIPrefix + "/get-config-kv":          {},
+	http.MethodGet + " " + adminAPIPrefix + "/group":                  {},
+	http.MethodGet + " " + adminAPIPrefix + "/groups":                 {},
+	http.MethodGet + " " + adminAPIPrefix + "/healthinfo":             {},
+	http.MethodGet + " " + adminAPIPrefix + "/help-config-kv":         {},
+	http.MethodGet + " " + adminAPIPrefix + "/info":                   {},
+	http.MethodGet + " " + adminAPIPrefix + "/info-canned-policy":     {},
+	http.MethodGet + " " + adminAPIPrefix + "/info-service-account":   {},
+	http.MethodGet + " " + adminAPIPrefix + "/kms/key/status":         {},
+	http.MethodGet + " " + adminAPIPrefix + "/list-canned-policies":   {},
+	http.MethodGet + " " + adminAPIPrefix + "/list-config-history-kv": {},
+	http.MethodGet + " " + adminAPIPrefix + "/list-remote-targets":    {},
+	http.MethodGet + " " + adminAPIPrefix + "/list-service-accounts":  {},
+	http.MethodGet + " " + adminAPIPrefix + "/list-users":             {},
+	http.MethodGet + " " + adminAPIPrefix + "/log":                    {},
+	http.MethodGet + " " + adminAPIPrefix + "/orphans":                {},
+	http.MethodGet + " " + adminAPIPrefix + "/peer-rpc-health":        {},
+	http.MethodGet + " " + adminAPIPrefix + "/profiling/download":     {},
+	http.MethodGet + " " + adminAPIPrefix + "/profiling/rates":        {},
+	http.MethodGet + " " + adminAPIPrefix + "/runtime-stats":          {},
+	http.MethodGet + " " + adminAPIPrefix + "/scanner/top-prefixes":   {},
+	http.MethodGet + " " + adminAPIPrefix + "/storageinfo":            {},
+	http.MethodGet + " " + adminAPIPrefix + "/status-job":             {},
+	http.MethodGet + " " + adminAPIPrefix + "/tier":                   {},
+	http.MethodGet + " " + adminAPIPrefix + "/top/locks":              {},
+	http.MethodGet + " " + adminAPIPrefix + "/trace":                  {},
+	http.MethodGet + " " + adminAPIPrefix + "/user-info":              {},
+	// Background heal status is queried with POST but never mutates.
+	http.MethodPost + " " + adminAPIPrefix + "/background-heal/status": {},
+	// Client speedtest payloads are discarded by the server.
+	http.MethodGet + " " + adminAPIPrefix + netperfClientPath:  {},
+	http.MethodPost + " " + adminAPIPrefix + netperfClientPath: {},
+}
+
+// isReadOnlyAdminAPI - returns true if the API is on the observer allowlist.
+func isReadOnlyAdminAPI(method, relPath string) bool {
+	if method == "" {
+		method = http.MethodPost
+	}
+	_, ok := readOnlyAdminAPIs[method+" "+relPath]
+	return ok
+}
+
+// checkReadOnly - refuses mutating APIs on read-only clients.
+func (adm AdminClient) checkReadOnly(method, relPath string) error {
+	if adm.readOnly && !isReadOnlyAdminAPI(method, relPath) {
+		return fmt.Errorf("%w: refusing %s %s", ErrReadOnlyClient, method, relPath)
+	}
+	return nil
+}
+
+// checkReadOnlyRequest - refuses mutating requests on read-only clients,
+// requests outside of the admin API only GET or HEAD are allowed.
+func (adm AdminClient) checkReadOnlyRequest(method string, reqData requestData) error {
+	if reqData.rootPath && (method == http.MethodGet || method == http.MethodHead) {
+		return nil
+	}
+	return adm.checkReadOnly(method, reqData.relPath)
+}
+
+// IsReadOnly - returns true if the client only calls non-mutating APIs.
+func (adm *AdminClient) IsReadOnly() bool {
+	return adm.readOnly
+}
@@ -0,0 +1,179 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// OrphanKind - kind of unreferenced data found on the drives.
+type OrphanKind string
+
+// Orphan kinds reported by the server.
+const (
+	// OrphanDanglingObject is an object without read quorum that can
+	// never be read again.
+	OrphanDanglingObject OrphanKind = "dangling-object"
+	// OrphanParts are data parts not referenced by any object metadata,
+	// e.g. left behind by an interrupted multipart upload.
+	OrphanParts OrphanKind = "orphaned-parts"
+	// OrphanTmp is data left in the temporary area of a drive.
+	OrphanTmp OrphanKind = "tmp"
+)
+
+// OrphanScanOpts - options for DetectOrphans.
+type OrphanScanOpts struct {
+	// Bucket and Prefix limit the scan, all buckets if empty.
+	Bucket string
+	Prefix string
+	// MinAge skips data modified more recently, so that uploads in
+	// progress are not reported. The server default is used if zero.
+	MinAge time.Duration
+}
+
+// OrphanEntry - unreferenced data on a single drive.
+type OrphanEntry struct {
+	Kind      OrphanKind `json:"kind"`
+	Pool      int        `json:"pool"`
+	Set       int        `json:"set"`
+	Drive     string     `json:"drive"`
+	Bucket    string     `json:"bucket"`
+	Object    string     `json:"object"`
+	VersionID string     `json:"versionId,omitempty"`
+	Size      int64      `json:"size"`
+	ModTime   time.Time  `json:"modTime"`
+}
+
+// OrphanReport - result of DetectOrphans.
+type OrphanReport struct {
+	Time    time.Time     `json:"time"`
+	Bucket  string        `json:"bucket,omitempty"`
+	Prefix  string        `json:"prefix,omitempty"`
+	Entries []OrphanEntry `json:"entries"`
+	// Truncated is set if the server stopped collecting entries, scan
+	// a narrower prefix to see the rest.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ReclaimableBytes - returns the number of bytes purging would free,
+// optionally only for the given kinds.
+func (r OrphanReport) ReclaimableBytes(kinds ...OrphanKind) int64 {
+	var total int64
+	for _, e := range r.Entries {
+		if len(kinds) == 0 || containsOrphanKind(kinds, e.Kind) {
+			total += e.Size
+		}
+	}
+	return total
+}
+
+func containsOrphanKind(kinds []OrphanKind, kind OrphanKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectOrphans - asks the server for dangling objects and orphaned
+// parts which can be reclaimed, nothing is removed.
+func (adm *AdminClient) DetectOrphans(ctx context.Context, opts OrphanScanOpts) (OrphanReport, error) {
+	queryValues := url.Values{}
+	if opts.Bucket != "" {
+		queryValues.Set("bucket", opts.Bucket)
+	}
+	if opts.Prefix != "" {
+		if opts.Bucket == "" {
+			return OrphanReport{}, ErrInvalidArgument("Prefix requires a bucket.")
+		}
+		queryValues.Set("prefix", opts.Prefix)
+	}
+	if opts.MinAge > 0 {
+		queryValues.Set("min-age", opts.MinAge.String())
+	}
+
+	// Execute GET on /minio/admin/v3/orphans
+	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{
+		relPath:     adminAPIPrefix + "/orphans",
+		queryValues: queryValues,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return OrphanReport{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return OrphanReport{}, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return OrphanReport{}, err
+	}
+	var report OrphanReport
+	if err = adm.unmarshalResponse(resp, b, &report); err != nil {
+		return OrphanReport{}, err
+	}
+	return report, nil
+}
+
+// OrphanPurgeResult - result of PurgeOrphans.
+type OrphanPurgeResult struct {
+	Purged         int   `json:"purged"`
+	ReclaimedBytes int64 `json:"reclaimedBytes"`
+	// Skipped entries were referenced again or modified since they
+	// were reported and are left untouched.
+	Skipped []OrphanEntry `json:"skipped,omitempty"`
+}
+
+// PurgeOrphans - removes the given entries of a previous DetectOrphans
+// report. The server re-validates every entry before removing it.
+func (adm *AdminClient) PurgeOrphans(ctx context.Context, entries []OrphanEntry) (OrphanPurgeResult, error) {
+	if len(entries) == 0 {
+		return OrphanPurgeResult{}, ErrInvalidArgument("No orphan entries to purge.")
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return OrphanPurgeResult{}, err
+	}
+
+	// Execute POST on /minio/admin/v3/orphans/purge
+	resp, err := adm.executeMethod(ctx, http.MethodPost, requestData{
+		relPath: adminAPIPrefix + "/orphans/purge",
+		content: data,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return OrphanPurgeResult{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return OrphanPurgeResult{}, httpRespToErrorResponse(resp)
+	}
+
+	var result OrphanPurgeResult
+	if err = adm.decodeResponse(resp, &result); err != nil {
+		return OrphanPurgeResult{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,113 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestOrphanReportReclaimableBytes(t *testing.T) {
+	report := OrphanReport{Entries: []OrphanEntry{
+		{Kind: OrphanDanglingObject, Size: 100},
+		{Kind: OrphanParts, Size: 20},
+		{Kind: OrphanTmp, Size: 3},
+	}}
+	if n := report.ReclaimableBytes(); n != 123 {
+		t.Errorf("expected 123 bytes, got %d", n)
+	}
+	if n := report.ReclaimableBytes(OrphanParts, OrphanTmp); n != 23 {
+		t.Errorf("expected 23 bytes, got %d", n)
+	}
+	if n := report.ReclaimableBytes("unknown"); n != 0 {
+		t.Errorf("expected 0 bytes, got %d", n)
+	}
+}
+
+func TestDetectOrphans(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case libraryAdminURLPrefix + adminAPIPrefix + "/orphans":
+			q := r.URL.Query()
+			if q.Get("bucket") != "photos" || q.Get("prefix") != "2021/" || q.Get("min-age") != "24h0m0s" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"Code":"InvalidArgument","Message":"unexpected query"}`))
+				return
+			}
+			w.Write([]byte(`{"time":"2021-05-01T00:00:00Z","bucket":"photos","prefix":"2021/","entries":[` +
+				`{"kind":"dangling-object","pool":0,"set":1,"drive":"/data1","bucket":"photos","object":"2021/a.jpg","size":1024,"modTime":"2021-04-01T00:00:00Z"},` +
+				`{"kind":"orphaned-parts","pool":0,"set":1,"drive":"/data2","bucket":"photos","object":"2021/b.jpg","size":512,"modTime":"2021-04-01T00:00:00Z"}],"truncated":true}`))
+		case libraryAdminURLPrefix + adminAPIPrefix + "/orphans/purge":
+			body, _ := ioutil.ReadAll(r.Body)
+			var entries []OrphanEntry
+			if err := json.Unmarshal(body, &entries); err != nil || len(entries) != 1 || entries[0].Object != "2021/b.jpg" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte(`{"purged":1,"reclaimedBytes":512}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if _, err = adm.DetectOrphans(ctx, OrphanScanOpts{Prefix: "2021/"}); err == nil || err.Error() != "Prefix requires a bucket." {
+		t.Fatalf("expected prefix without bucket to be rejected, got %v", err)
+	}
+
+	report, err := adm.DetectOrphans(ctx, OrphanScanOpts{Bucket: "photos", Prefix: "2021/", MinAge: 24 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Entries) != 2 || !report.Truncated || report.ReclaimableBytes(OrphanParts) != 512 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+
+	if _, err = adm.PurgeOrphans(ctx, nil); err == nil {
+		t.Fatal("expected an empty purge list to be rejected")
+	}
+	result, err := adm.PurgeOrphans(ctx, report.Entries[1:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Purged != 1 || result.ReclaimedBytes != 512 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	// Observers may detect but never purge orphans.
+	adm.readOnly = true
+	if _, err = adm.DetectOrphans(ctx, OrphanScanOpts{Bucket: "photos", Prefix: "2021/", MinAge: 24 * time.Hour}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = adm.PurgeOrphans(ctx, report.Entries[1:]); !errors.Is(err, ErrReadOnlyClient) {
+		t.Fatalf("expected ErrReadOnlyClient, got %v", err)
+	}
+}
@@ -0,0 +1,286 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/disk"
+)
+
+// Parallel filesystem types as reported in the mount table.
+const (
+	ParallelFSGPFS   = "gpfs"
+	ParallelFSLustre = "lustre"
+	ParallelFSBeeGFS = "beegfs"
+)
+
+// parallelFSProbeTimeout bounds every probe of a mount, hung parallel
+// filesystem clients block system calls indefinitely.
+const parallelFSProbeTimeout = 10 * time.Second
+
+// ParallelFSQuota - quota of the user running the server on a
+// parallel filesystem, zero limits are unlimited.
+type ParallelFSQuota struct {
+	Used      ByteSize `json:"used" yaml:"used"`
+	Limit     ByteSize `json:"limit,omitempty" yaml:"limit,omitempty"`
+	Files     uint64   `json:"files" yaml:"files"`
+	FileLimit uint64   `json:"file_limit,omitempty" yaml:"file_limit,omitempty"`
+}
+
+// ParallelFSMount - client side state of a parallel filesystem mount.
+type ParallelFSMount struct {
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Mountpoint string `json:"mountpoint" yaml:"mountpoint"`
+	Device     string `json:"device" yaml:"device"`
+	FSType     string `json:"fs_type" yaml:"fs_type"`
+	// Healthy is true if the mount answered a statfs in time.
+	Healthy bool `json:"healthy" yaml:"healthy"`
+	// ACL is true if POSIX ACLs are supported.
+	ACL bool `json:"acl" yaml:"acl"`
+	// ByteRangeLocks is true if POSIX byte-range locks are supported,
+	// e.g. Lustre mounted without flock only supports local locks.
+	ByteRangeLocks bool `json:"byte_range_locks" yaml:"byte_range_locks"`
+	// QuotaError is set if the quota could not be read, e.g. because
+	// the filesystem tools are not installed.
+	QuotaError string           `json:"quota_error,omitempty" yaml:"quota_error,omitempty"`
+	Quota      *ParallelFSQuota `json:"quota,omitempty" yaml:"quota,omitempty"`
+}
+
+// ParallelFSInfo - parallel filesystem mounts of a node.
+type ParallelFSInfo struct {
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Mounts []ParallelFSMount `json:"mounts,omitempty" yaml:"mounts,omitempty"`
+}
+
+// isParallelFS - returns the parallel filesystem type of a mount table
+// filesystem type, empty if it is none.
+func isParallelFS(fstype string) string {
+	switch {
+	case fstype == ParallelFSGPFS:
+		return ParallelFSGPFS
+	case fstype == ParallelFSLustre:
+		return ParallelFSLustre
+	case fstype == ParallelFSBeeGFS, strings.HasPrefix(fstype, "fuse.beegfs"):
+		return ParallelFSBeeGFS
+	}
+	return ""
+}
+
+// GetParallelFSInfo returns the client state of the GPFS, Lustre and
+// BeeGFS mounts of a node. Quotas are read with mmlsquota, lfs and
+// beegfs-ctl if installed.
+func GetParallelFSInfo(ctx context.Context, addr string) ParallelFSInfo {
+	parts, err := disk.PartitionsWithContext(ctx, true)
+	if err != nil {
+		return ParallelFSInfo{
+			Addr:  addr,
+			Error: err.Error(),
+		}
+	}
+
+	info := ParallelFSInfo{Addr: addr}
+	for _, part := range parts {
+		fstype := isParallelFS(part.Fstype)
+		if fstype == "" {
+			continue
+		}
+		mount := ParallelFSMount{
+			Mountpoint: part.Mountpoint,
+			Device:     part.Device,
+			FSType:     fstype,
+		}
+		if err := probeWithTimeout(ctx, func() error {
+			_, err := disk.UsageWithContext(ctx, part.Mountpoint)
+			return err
+		}); err != nil {
+			mount.Error = err.Error()
+			info.Mounts = append(info.Mounts, mount)
+			continue
+		}
+		mount.Healthy = true
+		mount.ACL = hasMountOption(part.Opts, "acl") || probeWithTimeout(ctx, func() error {
+			return checkPosixACL(part.Mountpoint)
+		}) == nil
+		mount.ByteRangeLocks = !hasMountOption(part.Opts, "noflock") && !hasMountOption(part.Opts, "localflock") &&
+			probeWithTimeout(ctx, func() error { return checkByteRangeLock(part.Mountpoint) }) == nil
+		quota, err := parallelFSQuota(ctx, fstype, part.Mountpoint)
+		if err != nil {
+			mount.QuotaError = err.Error()
+		} else {
+			mount.Quota = &quota
+		}
+		info.Mounts = append(info.Mounts, mount)
+	}
+	return info
+}
+
+func hasMountOption(opts, opt string) bool {
+	for _, o := range strings.Split(opts, ",") {
+		if strings.TrimSpace(o) == opt {
+			return true
+		}
+	}
+	return false
+}
+
+var errParallelFSProbeTimeout = errors.New("mount did not respond in time")
+
+// probeWithTimeout - runs probe, giving up after parallelFSProbeTimeout.
+// The probe keeps running in the background if it hangs.
+func probeWithTimeout(ctx context.Context, probe func() error) error {
+	ctx, cancel := context.WithTimeout(ctx, parallelFSProbeTimeout)
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() { errCh <- probe() }()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return errParallelFSProbeTimeout
+	}
+}
+
+// parallelFSQuota - reads the quota of the current user on a mount
+// with the filesystem specific tools.
+func parallelFSQuota(ctx context.Context, fstype, mountpoint string) (ParallelFSQuota, error) {
+	ctx, cancel := context.WithTimeout(ctx, parallelFSProbeTimeout)
+	defer cancel()
+	uid := strconv.Itoa(os.Getuid())
+	var cmd *exec.Cmd
+	var parse func(io.Reader) (ParallelFSQuota, error)
+	switch fstype {
+	case ParallelFSGPFS:
+		cmd = exec.CommandContext(ctx, "mmlsquota", "-u", uid, "-Y", mountpoint)
+		parse = parseGPFSQuota
+	case ParallelFSLustre:
+		cmd = exec.CommandContext(ctx, "lfs", "quota", "-q", "-u", uid, mountpoint)
+		parse = parseLustreQuota
+	case ParallelFSBeeGFS:
+		cmd = exec.CommandContext(ctx, "beegfs-ctl", "--getquota", "--uid", uid, "--csv", "--mount="+mountpoint)
+		parse = parseBeeGFSQuota
+	default:
+		return ParallelFSQuota{}, fmt.Errorf("quota of %s is not supported", fstype)
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return ParallelFSQuota{}, fmt.Errorf("%s: %w", cmd.Path, err)
+	}
+	return parse(strings.NewReader(string(out)))
+}
+
+// parseGPFSQuota - parses `mmlsquota -Y` output, colon separated rows
+// described by a HEADER row. Block values are in KiB.
+func parseGPFSQuota(r io.Reader) (ParallelFSQuota, error) {
+	var header []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ":")
+		if len(fields) > 2 && fields[2] == "HEADER" {
+			header = fields
+			continue
+		}
+		if header == nil || len(fields) < len(header) {
+			continue
+		}
+		values := make(map[string]uint64)
+		for i, name := range header {
+			values[name], _ = strconv.ParseUint(fields[i], 10, 64)
+		}
+		return ParallelFSQuota{
+			Used:      ByteSize(values["blockUsage"]) * KiB,
+			Limit:     ByteSize(values["blockLimit"]) * KiB,
+			Files:     values["filesUsage"],
+			FileLimit: values["filesLimit"],
+		}, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return ParallelFSQuota{}, err
+	}
+	return ParallelFSQuota{}, errors.New("no quota in mmlsquota output")
+}
+
+// parseLustreQuota - parses `lfs quota -q` output: the filesystem,
+// kbytes, quota, limit, grace, files, quota, limit, grace. Exceeded
+// values are marked with a trailing '*'.
+func parseLustreQuota(r io.Reader) (ParallelFSQuota, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 8 {
+			continue
+		}
+		value := func(i int) (uint64, error) {
+			return strconv.ParseUint(strings.TrimSuffix(fields[i], "*"), 10, 64)
+		}
+		used, err := value(1)
+		if err != nil {
+			return ParallelFSQuota{}, fmt.Errorf("malformed lfs quota output %q", scanner.Text())
+		}
+		limit, _ := value(3)
+		files, _ := value(5)
+		fileLimit, _ := value(7)
+		return ParallelFSQuota{
+			Used:      ByteSize(used) * KiB,
+			Limit:     ByteSize(limit) * KiB,
+			Files:     files,
+			FileLimit: fileLimit,
+		}, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return ParallelFSQuota{}, err
+	}
+	return ParallelFSQuota{}, errors.New("no quota in lfs output")
+}
+
+// parseBeeGFSQuota - parses `beegfs-ctl --getquota --csv` output:
+// name,id,size,hard,files,hard with sizes in bytes and "unlimited".
+func parseBeeGFSQuota(r io.Reader) (ParallelFSQuota, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Split(strings.TrimSpace(scanner.Text()), ",")
+		if len(fields) < 6 || fields[0] == "name" {
+			continue
+		}
+		value := func(i int) uint64 {
+			v, _ := strconv.ParseUint(fields[i], 10, 64)
+			return v
+		}
+		return ParallelFSQuota{
+			Used:      ByteSize(value(2)),
+			Limit:     ByteSize(value(3)),
+			Files:     value(4),
+			FileLimit: value(5),
+		}, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return ParallelFSQuota{}, err
+	}
+	return ParallelFSQuota{}, errors.New("no quota in beegfs-ctl output")
+}
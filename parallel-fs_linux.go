@@ -0,0 +1,47 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build linux
+
+package madmin
+
+import (
+	"os"
+	"syscall"
+)
+
+// checkPosixACL - reads the access ACL of path, which fails with
+// ENOTSUP if the filesystem does not support POSIX ACLs.
+func checkPosixACL(path string) error {
+	_, err := syscall.Getxattr(path, "system.posix_acl_access", make([]byte, 256))
+	if err == syscall.ENODATA || err == syscall.ERANGE {
+		// No ACL set, but supported.
+		return nil
+	}
+	return err
+}
+
+// checkByteRangeLock - tests for a conflicting byte-range lock on path,
+// which fails with ENOSYS or ENOLCK without lock support.
+func checkByteRangeLock(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	lock := syscall.Flock_t{Type: syscall.F_RDLCK, Whence: 0, Start: 0, Len: 1}
+	return syscall.FcntlFlock(f.Fd(), syscall.F_GETLK, &lock)
+}
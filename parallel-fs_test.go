@@ -0,0 +1,50 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestParseParallelFSQuota(t *testing.T) {
+	testCases := []struct {
+		name   string
+		parse  func(io.Reader) (ParallelFSQuota, error)
+		output string
+	}{
+		{"gpfs", parseGPFSQuota, "mmlsquota::HEADER:version:reserved:reserved:filesystemName:quotaType:id:name:blockUsage:blockQuota:blockLimit:blockInDoubt:blockGrace:filesUsage:filesQuota:filesLimit:filesInDoubt:filesGrace:\n" +
+			"mmlsquota::0:1:::gpfs0:USR:1000:minio:2048:0:4096:0:none:10:0:100:0:none:\n"},
+		{"lustre", parseLustreQuota, "     /mnt/lustre    2048*       0    4096       -      10       0     100       -\n"},
+		{"beegfs", parseBeeGFSQuota, "name,id,size,hard,files,hard\nminio,1000,2097152,4194304,10,100\n"},
+	}
+	expected := ParallelFSQuota{Used: 2 * MiB, Limit: 4 * MiB, Files: 10, FileLimit: 100}
+	for _, tc := range testCases {
+		quota, err := tc.parse(strings.NewReader(tc.output))
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if quota != expected {
+			t.Errorf("%s: expected %+v, got %+v", tc.name, expected, quota)
+		}
+		if _, err = tc.parse(strings.NewReader("")); err == nil {
+			t.Errorf("%s: expected error for empty output", tc.name)
+		}
+	}
+}
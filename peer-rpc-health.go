@@ -0,0 +1,107 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// PeerRPCStatus - the view a node has of its internode RPC connection
+// to a peer.
+type PeerRPCStatus struct {
+	Peer   string `json:"peer"`
+	Online bool   `json:"online"`
+	// LastError is the last error of a call to the peer, if any.
+	LastError     string    `json:"lastError,omitempty"`
+	LastErrorTime time.Time `json:"lastErrorTime,omitempty"`
+	// Reconnects since the node started.
+	Reconnects   uint64 `json:"reconnects"`
+	PendingCalls int    `json:"pendingCalls"`
+}
+
+// NodePeerRPCHealth - the views a node has of all its peers.
+type NodePeerRPCHealth struct {
+	Node  string          `json:"node"`
+	Error string          `json:"error,omitempty"`
+	Peers []PeerRPCStatus `json:"peers"`
+}
+
+// PeerRPCHealth - internode RPC health matrix of a cluster.
+type PeerRPCHealth struct {
+	Nodes []NodePeerRPCHealth `json:"nodes"`
+}
+
+// Peer - returns the view node has of peer, false if not reported.
+func (h PeerRPCHealth) Peer(node, peer string) (PeerRPCStatus, bool) {
+	for _, n := range h.Nodes {
+		if n.Node != node {
+			continue
+		}
+		for _, p := range n.Peers {
+			if p.Peer == peer {
+				return p, true
+			}
+		}
+	}
+	return PeerRPCStatus{}, false
+}
+
+// Asymmetric - returns the node pairs, as [from, to], where from sees
+// to offline while to sees from online.
+func (h PeerRPCHealth) Asymmetric() [][2]string {
+	var pairs [][2]string
+	for _, n := range h.Nodes {
+		for _, p := range n.Peers {
+			if p.Online {
+				continue
+			}
+			if back, ok := h.Peer(p.Peer, n.Node); ok && back.Online {
+				pairs = append(pairs, [2]string{n.Node, p.Peer})
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+	return pairs
+}
+
+// GetPeerRPCHealth - returns each node's view of the internode RPC
+// connections to every other node.
+func (adm *AdminClient) GetPeerRPCHealth(ctx context.Context) (PeerRPCHealth, error) {
+	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{relPath: adminAPIPrefix + "/peer-rpc-health"})
+	defer closeResponse(resp)
+	if err != nil {
+		return PeerRPCHealth{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return PeerRPCHealth{}, httpRespToErrorResponse(resp)
+	}
+
+	var health PeerRPCHealth
+	if err = adm.decodeResponse(resp, &health); err != nil {
+		return PeerRPCHealth{}, err
+	}
+	return health, nil
+}
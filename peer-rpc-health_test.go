@@ -0,0 +1,107 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestPeerRPCHealthAsymmetric(t *testing.T) {
+	view := func(node string, peers map[string]bool) NodePeerRPCHealth {
+		n := NodePeerRPCHealth{Node: node}
+		for peer, online := range peers {
+			n.Peers = append(n.Peers, PeerRPCStatus{Peer: peer, Online: online})
+		}
+		return n
+	}
+	testCases := []struct {
+		name     string
+		health   PeerRPCHealth
+		expected [][2]string
+	}{
+		{
+			name: "one-way partition",
+			health: PeerRPCHealth{Nodes: []NodePeerRPCHealth{
+				view("node1", map[string]bool{"node2": false, "node3": true}),
+				view("node2", map[string]bool{"node1": true, "node3": true}),
+				view("node3", map[string]bool{"node1": true, "node2": true}),
+			}},
+			expected: [][2]string{{"node1", "node2"}},
+		},
+		{
+			name: "symmetric outage",
+			health: PeerRPCHealth{Nodes: []NodePeerRPCHealth{
+				view("node1", map[string]bool{"node2": false}),
+				view("node2", map[string]bool{"node1": false}),
+			}},
+		},
+		{
+			name: "missing peer view",
+			health: PeerRPCHealth{Nodes: []NodePeerRPCHealth{
+				view("node1", map[string]bool{"node2": false}),
+				{Node: "node2", Error: "timeout"},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if pairs := tc.health.Asymmetric(); !reflect.DeepEqual(pairs, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, pairs)
+			}
+		})
+	}
+}
+
+func TestGetPeerRPCHealth(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/minio/admin/v3/peer-rpc-health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"nodes":[{"node":"node1:9000","peers":[{"peer":"node2:9000","online":false,"lastError":"connection refused","lastErrorTime":"2021-05-01T00:00:00Z","reconnects":3,"pendingCalls":2}]},
+{"node":"node2:9000","peers":[{"peer":"node1:9000","online":true,"reconnects":0,"pendingCalls":0}]}]}`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	health, err := adm.GetPeerRPCHealth(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(health.Nodes) != 2 {
+		t.Fatalf("expected 2 nodes, got %+v", health.Nodes)
+	}
+	p, ok := health.Peer("node1:9000", "node2:9000")
+	if !ok || p.Online || p.LastError != "connection refused" || p.LastErrorTime.IsZero() || p.Reconnects != 3 || p.PendingCalls != 2 {
+		t.Errorf("unexpected peer status %+v", p)
+	}
+	if _, ok = health.Peer("node1:9000", "node3:9000"); ok {
+		t.Error("expected no status for an unknown peer")
+	}
+	if pairs := health.Asymmetric(); len(pairs) != 1 || pairs[0] != [2]string{"node1:9000", "node2:9000"} {
+		t.Errorf("unexpected asymmetric pairs %v", pairs)
+	}
+}
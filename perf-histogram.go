@@ -0,0 +1,154 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Histogram - bucketed counts of latency samples of a perf run, to
+// analyze tail latencies beyond the fixed percentiles of Latency.
+type Histogram struct {
+	// Bounds are the inclusive upper bounds of the buckets in seconds,
+	// in ascending order. The last bucket counts the samples above the
+	// last bound.
+	Bounds []float64 `json:"bounds" yaml:"bounds"`
+	// Counts holds len(Bounds)+1 bucket counts.
+	Counts []uint32 `json:"counts" yaml:"counts"`
+}
+
+// NewHistogram - returns an empty histogram, DefaultLatencyBounds are
+// used if bounds is empty.
+func NewHistogram(bounds []float64) *Histogram {
+	if len(bounds) == 0 {
+		bounds = DefaultLatencyBounds
+	}
+	return &Histogram{
+		Bounds: append([]float64(nil), bounds...),
+		Counts: make([]uint32, len(bounds)+1),
+	}
+}
+
+// Record - adds a latency sample.
+func (h *Histogram) Record(latency time.Duration) {
+	if len(h.Counts) < len(h.Bounds)+1 {
+		h.Counts = append(h.Counts, make([]uint32, len(h.Bounds)+1-len(h.Counts))...)
+	}
+	h.Counts[sort.SearchFloat64s(h.Bounds, latency.Seconds())]++
+}
+
+// Percentile - returns the upper bound in seconds of the bucket the
+// p-th percentile, between 0 and 1, falls in. It returns +Inf if it
+// falls in the last bucket and 0 if the histogram is empty.
+func (h Histogram) Percentile(p float64) float64 {
+	var total uint64
+	for _, c := range h.Counts {
+		total += uint64(c)
+	}
+	if total == 0 {
+		return 0
+	}
+	rank := uint64(math.Ceil(p * float64(total)))
+	if rank == 0 {
+		rank = 1
+	}
+	var seen uint64
+	for i, c := range h.Counts {
+		if seen += uint64(c); seen >= rank {
+			if i < len(h.Bounds) {
+				return h.Bounds[i]
+			}
+			break
+		}
+	}
+	return math.Inf(1)
+}
+
+// PerfPercentile - a percentile of a perf run requested with
+// HealthInfoOpts.Percentiles, e.g. 0.999 for p99.9.
+type PerfPercentile struct {
+	Percentile float64  `json:"percentile" yaml:"percentile"`
+	Latency    float64  `json:"latency" yaml:"latency"`
+	Throughput ByteSize `json:"throughput" yaml:"throughput"`
+}
+
+// ComputePercentiles - returns the percentiles ps, between 0 and 1, of
+// latency and throughput samples, with the nearest rank method of
+// ComputeLatency. Either samples may be empty.
+func ComputePercentiles(latencies []time.Duration, throughputs []uint64, ps []float64) []PerfPercentile {
+	l := make([]float64, len(latencies))
+	for i, s := range latencies {
+		l[i] = s.Seconds()
+	}
+	sort.Float64s(l)
+	t := make([]float64, len(throughputs))
+	for i, s := range throughputs {
+		t[i] = float64(s)
+	}
+	sort.Float64s(t)
+
+	pcts := make([]PerfPercentile, 0, len(ps))
+	for _, p := range ps {
+		pct := PerfPercentile{Percentile: p}
+		if len(l) > 0 {
+			pct.Latency = nearestRank(l, p)
+		}
+		if len(t) > 0 {
+			pct.Throughput = ByteSize(nearestRank(t, p))
+		}
+		pcts = append(pcts, pct)
+	}
+	return pcts
+}
+
+// LatencyPercentile - returns the p-th latency percentile of the drive,
+// see perfLatencyPercentile.
+func (d DrivePerfInfo) LatencyPercentile(p float64) (float64, bool) {
+	return perfLatencyPercentile(d.Percentiles, d.Histogram, d.Latency, p)
+}
+
+// LatencyPercentile - returns the p-th latency percentile of the peer,
+// see perfLatencyPercentile.
+func (n PeerNetPerfInfo) LatencyPercentile(p float64) (float64, bool) {
+	return perfLatencyPercentile(n.Percentiles, n.Histogram, n.Latency, p)
+}
+
+// perfLatencyPercentile - returns the p-th latency percentile in
+// seconds from the requested percentiles, the fixed percentiles of
+// latency or, with the resolution of its buckets, the histogram, in
+// this order. It returns false if none of them has it.
+func perfLatencyPercentile(pcts []PerfPercentile, h *Histogram, latency Latency, p float64) (float64, bool) {
+	for _, pct := range pcts {
+		if pct.Percentile == p {
+			return pct.Latency, true
+		}
+	}
+	switch p {
+	case 0.5:
+		return latency.Percentile50, true
+	case 0.9:
+		return latency.Percentile90, true
+	case 0.99:
+		return latency.Percentile99, true
+	}
+	if h != nil {
+		return h.Percentile(p), true
+	}
+	return 0, false
+}
@@ -0,0 +1,125 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestHistogram(t *testing.T) {
+	h := NewHistogram([]float64{0.001, 0.01, 0.1})
+	for i := 0; i < 990; i++ {
+		h.Record(500 * time.Microsecond)
+	}
+	for i := 0; i < 9; i++ {
+		h.Record(10 * time.Millisecond)
+	}
+	h.Record(time.Second)
+
+	for _, test := range []struct {
+		p        float64
+		expected float64
+	}{
+		{0.5, 0.001},
+		{0.99, 0.001},
+		{0.999, 0.01},
+		{1, math.Inf(1)},
+	} {
+		if got := h.Percentile(test.p); got != test.expected {
+			t.Errorf("expected percentile %v to be %v, got %v", test.p, test.expected, got)
+		}
+	}
+	if p := (Histogram{}).Percentile(0.5); p != 0 {
+		t.Errorf("expected 0 for an empty histogram, got %v", p)
+	}
+}
+
+func TestComputePercentiles(t *testing.T) {
+	var latencies []time.Duration
+	var throughputs []uint64
+	for i := 1000; i >= 1; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+		throughputs = append(throughputs, uint64(i))
+	}
+	pcts := ComputePercentiles(latencies, throughputs, []float64{0.999, 0.5})
+	expected := []PerfPercentile{
+		{Percentile: 0.999, Latency: 0.999, Throughput: 999},
+		{Percentile: 0.5, Latency: 0.5, Throughput: 500},
+	}
+	if len(pcts) != len(expected) || pcts[0] != expected[0] || pcts[1] != expected[1] {
+		t.Errorf("expected %+v, got %+v", expected, pcts)
+	}
+
+	perf := DrivePerfInfo{
+		Latency:     Latency{Percentile99: 0.2},
+		Percentiles: pcts,
+		Histogram:   &Histogram{Bounds: []float64{1}, Counts: []uint32{1, 0}},
+	}
+	for _, test := range []struct {
+		p        float64
+		expected float64
+	}{
+		{0.999, 0.999},
+		{0.99, 0.2},
+		{0.9999, 1},
+	} {
+		if got, ok := perf.LatencyPercentile(test.p); !ok || got != test.expected {
+			t.Errorf("expected percentile %v to be %v, got %v", test.p, test.expected, got)
+		}
+	}
+	if _, ok := (PeerNetPerfInfo{}).LatencyPercentile(0.999); ok {
+		t.Error("expected no p99.9 without percentiles or histogram")
+	}
+}
+
+func TestHealthInfoPercentiles(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("perfpercentiles") != "0.999,0.9999" || q.Get("perfhistogram") != "true" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"Code":"AccessDenied"}`))
+			return
+		}
+		w.Write([]byte(`{"version":"` + HealthInfoVersion + `"}`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := []HealthDataType{HealthDataTypePerfDrive, HealthDataTypePerfNet}
+	resp, _, err := adm.ServerHealthInfoWithOpts(context.Background(), types, HealthInfoOpts{
+		Percentiles: []float64{0.999, 0.9999},
+		Histogram:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	closeResponse(resp)
+
+	if _, _, err = adm.ServerHealthInfoWithOpts(context.Background(), types, HealthInfoOpts{Percentiles: []float64{99.9}}); err == nil {
+		t.Error("expected an error for a percentile above 1")
+	}
+}
@@ -0,0 +1,92 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// ComputeLatency - returns the average, minimum, maximum and the 50th,
+// 90th and 99th percentiles of latency samples, in seconds. Percentiles
+// use the nearest rank method, i.e. are always one of the samples.
+func ComputeLatency(samples []time.Duration) Latency {
+	values := make([]float64, len(samples))
+	for i, s := range samples {
+		values[i] = s.Seconds()
+	}
+	avg, min, max, p50, p90, p99 := sampleStats(values)
+	return Latency{Avg: avg, Max: max, Min: min, Percentile50: p50, Percentile90: p90, Percentile99: p99}
+}
+
+// ComputeThroughput - returns the average, minimum, maximum and the
+// 50th, 90th and 99th percentiles of throughput samples in bytes per
+// second, with the percentiles of ComputeLatency.
+func ComputeThroughput(samples []uint64) Throughput {
+	values := make([]float64, len(samples))
+	for i, s := range samples {
+		values[i] = float64(s)
+	}
+	avg, min, max, p50, p90, p99 := sampleStats(values)
+	return Throughput{
+		Avg:          ByteSize(avg),
+		Max:          ByteSize(max),
+		Min:          ByteSize(min),
+		Percentile50: ByteSize(p50),
+		Percentile90: ByteSize(p90),
+		Percentile99: ByteSize(p99),
+	}
+}
+
+// sampleStats - returns the statistics of ComputeLatency, all zero for
+// no samples. samples is sorted in place.
+func sampleStats(samples []float64) (avg, min, max, p50, p90, p99 float64) {
+	if len(samples) == 0 {
+		return
+	}
+	sort.Float64s(samples)
+	for _, s := range samples {
+		avg += s
+	}
+	avg /= float64(len(samples))
+	return avg, samples[0], samples[len(samples)-1], nearestRank(samples, 0.5), nearestRank(samples, 0.9), nearestRank(samples, 0.99)
+}
+
+// quantile - returns the q-th quantile of samples using the nearest
+// rank method.
+func quantile(samples []float64, q float64) float64 {
+	if len(samples) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), samples...)
+	sort.Float64s(sorted)
+	return nearestRank(sorted, q)
+}
+
+// nearestRank - returns the q-th quantile of non-empty sorted samples,
+// the smallest sample with at least q of the samples at or below it.
+func nearestRank(sorted []float64, q float64) float64 {
+	rank := int(math.Ceil(q*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
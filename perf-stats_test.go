@@ -0,0 +1,60 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestComputeLatency(t *testing.T) {
+	var samples []time.Duration
+	for i := 100; i >= 1; i-- {
+		samples = append(samples, time.Duration(i)*time.Millisecond)
+	}
+	l := ComputeLatency(samples)
+	if math.Abs(l.Avg-0.0505) > 1e-9 {
+		t.Errorf("expected average 0.0505, got %v", l.Avg)
+	}
+	l.Avg = 0
+	expected := Latency{Max: 0.1, Min: 0.001, Percentile50: 0.05, Percentile90: 0.09, Percentile99: 0.099}
+	if l != expected {
+		t.Errorf("expected %+v, got %+v", expected, l)
+	}
+	if samples[0] != 100*time.Millisecond {
+		t.Error("expected the samples to be left unsorted")
+	}
+	if l = ComputeLatency(nil); l != (Latency{}) {
+		t.Errorf("expected zero latency, got %+v", l)
+	}
+}
+
+func TestComputeThroughput(t *testing.T) {
+	th := ComputeThroughput([]uint64{400, 100, 300, 200})
+	expected := Throughput{Avg: 250, Max: 400, Min: 100, Percentile50: 200, Percentile90: 400, Percentile99: 400}
+	if th != expected {
+		t.Errorf("expected %+v, got %+v", expected, th)
+	}
+	// The rank is rounded up, ceil(0.9*7) is the 7th sample.
+	if th = ComputeThroughput([]uint64{100, 200, 300, 400, 500, 600, 700}); th.Percentile50 != 400 || th.Percentile90 != 700 {
+		t.Errorf("unexpected nearest ranks %+v", th)
+	}
+	if th = ComputeThroughput([]uint64{42}); th.Min != 42 || th.Percentile99 != 42 {
+		t.Errorf("unexpected throughput of a single sample %+v", th)
+	}
+}
@@ -73,7 +73,7 @@ func (adm *AdminClient) ListCannedPolicies(ctx context.Context) (map[string]json
 	}
 
 	var policies = make(map[string]json.RawMessage)
-	if err = json.Unmarshal(respBytes, &policies); err != nil {
+	if err = adm.unmarshalResponse(resp, respBytes, &policies); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,197 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PoolParity - erasure coding settings of a server pool.
+type PoolParity struct {
+	Pool          int `json:"pool"`
+	Sets          int `json:"sets"`
+	SetDriveCount int `json:"setDriveCount"`
+
+	StandardSCData   int `json:"standardSCData"`
+	StandardSCParity int `json:"standardSCParity"`
+	RRSCData         int `json:"rrSCData,omitempty"`
+	RRSCParity       int `json:"rrSCParity,omitempty"`
+}
+
+// ReadTolerance - number of drives per set which may be lost while
+// objects of the standard storage class stay readable.
+func (p PoolParity) ReadTolerance() int {
+	return p.StandardSCParity
+}
+
+// WriteTolerance - number of drives per set which may be lost while
+// objects of the standard storage class can still be written, write
+// quorum needs one more drive if data and parity are equal.
+func (p PoolParity) WriteTolerance() int {
+	if p.StandardSCParity > 0 && p.StandardSCParity == p.StandardSCData {
+		return p.StandardSCParity - 1
+	}
+	return p.StandardSCParity
+}
+
+// String - describes the tolerance of the pool.
+func (p PoolParity) String() string {
+	return fmt.Sprintf("pool %d (EC:%d): can lose %d drives per set for reads, %d for writes",
+		p.Pool+1, p.StandardSCParity, p.ReadTolerance(), p.WriteTolerance())
+}
+
+// Validate - checks the parity settings are consistent.
+func (p PoolParity) Validate() error {
+	if p.StandardSCParity < 0 || p.RRSCParity < 0 {
+		return fmt.Errorf("pool %d: negative parity", p.Pool+1)
+	}
+	if p.StandardSCData > 0 && p.StandardSCParity > p.StandardSCData {
+		return fmt.Errorf("pool %d: parity EC:%d exceeds %d data drives", p.Pool+1, p.StandardSCParity, p.StandardSCData)
+	}
+	if p.RRSCParity > p.StandardSCParity {
+		return fmt.Errorf("pool %d: reduced redundancy parity EC:%d exceeds standard parity EC:%d", p.Pool+1, p.RRSCParity, p.StandardSCParity)
+	}
+	if p.SetDriveCount > 0 && p.StandardSCData > 0 && p.StandardSCData+p.StandardSCParity != p.SetDriveCount {
+		return fmt.Errorf("pool %d: EC:%d with %d data drives does not match %d drives per set",
+			p.Pool+1, p.StandardSCParity, p.StandardSCData, p.SetDriveCount)
+	}
+	return nil
+}
+
+// PoolParities - returns the validated parity settings of every pool,
+// set layout is derived from the drives' pool and set indexes.
+func (si StorageInfo) PoolParities() ([]PoolParity, error) {
+	b := si.Backend
+	if b.Type != Erasure {
+		return nil, nil
+	}
+
+	type setKey struct{ pool, set int }
+	setDrives := make(map[setKey]int)
+	pools := len(b.StandardSCData)
+	for _, d := range si.Disks {
+		if d.PoolIndex < 0 || d.SetIndex < 0 {
+			continue
+		}
+		setDrives[setKey{d.PoolIndex, d.SetIndex}]++
+		if d.PoolIndex >= pools {
+			pools = d.PoolIndex + 1
+		}
+	}
+	if len(b.StandardSCParities) > pools {
+		pools = len(b.StandardSCParities)
+	}
+
+	parities := make([]PoolParity, pools)
+	for i := range parities {
+		p := PoolParity{
+			Pool:             i,
+			StandardSCParity: b.StandardSCParity,
+			RRSCParity:       b.RRSCParity,
+		}
+		if i < len(b.StandardSCParities) {
+			p.StandardSCParity = b.StandardSCParities[i]
+		}
+		if i < len(b.RRSCParities) {
+			p.RRSCParity = b.RRSCParities[i]
+		}
+		if i < len(b.StandardSCData) {
+			p.StandardSCData = b.StandardSCData[i]
+		}
+		if i < len(b.RRSCData) {
+			p.RRSCData = b.RRSCData[i]
+		}
+		for k, n := range setDrives {
+			if k.pool != i {
+				continue
+			}
+			if p.SetDriveCount != 0 && p.SetDriveCount != n {
+				return nil, fmt.Errorf("pool %d: sets with %d and %d drives", i+1, p.SetDriveCount, n)
+			}
+			p.SetDriveCount = n
+			p.Sets++
+		}
+		if p.StandardSCData == 0 && p.SetDriveCount > 0 {
+			p.StandardSCData = p.SetDriveCount - p.StandardSCParity
+		}
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+		parities[i] = p
+	}
+	return parities, nil
+}
+
+// SetTolerance - remaining fault tolerance of an erasure set.
+type SetTolerance struct {
+	Pool    int `json:"pool"`
+	Set     int `json:"set"`
+	Drives  int `json:"drives"`
+	Offline int `json:"offline"`
+	// ReadRemaining and WriteRemaining are the number of additional
+	// drives the set can lose, negative if quorum is already lost.
+	ReadRemaining  int `json:"readRemaining"`
+	WriteRemaining int `json:"writeRemaining"`
+}
+
+// SetTolerances - returns the remaining fault tolerance of every
+// erasure set, taking each pool's parity and offline drives into
+// account. Sets with the least remaining tolerance come first.
+func (si StorageInfo) SetTolerances() ([]SetTolerance, error) {
+	parities, err := si.PoolParities()
+	if err != nil {
+		return nil, err
+	}
+
+	type setKey struct{ pool, set int }
+	sets := make(map[setKey]*SetTolerance)
+	for _, d := range si.Disks {
+		if d.PoolIndex < 0 || d.SetIndex < 0 || d.PoolIndex >= len(parities) {
+			continue
+		}
+		k := setKey{d.PoolIndex, d.SetIndex}
+		st, ok := sets[k]
+		if !ok {
+			st = &SetTolerance{Pool: d.PoolIndex, Set: d.SetIndex}
+			sets[k] = st
+		}
+		st.Drives++
+		if d.State != DriveStateOk {
+			st.Offline++
+		}
+	}
+
+	tolerances := make([]SetTolerance, 0, len(sets))
+	for _, st := range sets {
+		p := parities[st.Pool]
+		st.ReadRemaining = p.ReadTolerance() - st.Offline
+		st.WriteRemaining = p.WriteTolerance() - st.Offline
+		tolerances = append(tolerances, *st)
+	}
+	sort.Slice(tolerances, func(i, j int) bool {
+		a, b := tolerances[i], tolerances[j]
+		if a.ReadRemaining != b.ReadRemaining {
+			return a.ReadRemaining < b.ReadRemaining
+		}
+		if a.Pool != b.Pool {
+			return a.Pool < b.Pool
+		}
+		return a.Set < b.Set
+	})
+	return tolerances, nil
+}
@@ -0,0 +1,73 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import "testing"
+
+func TestPoolParities(t *testing.T) {
+	si := StorageInfo{
+		Backend: BackendInfo{
+			Type:               Erasure,
+			StandardSCData:     []int{4, 6},
+			StandardSCParity:   4,
+			StandardSCParities: []int{4, 2},
+		},
+	}
+	// Pool 1 has a single set of 8 drives, pool 2 two sets of 8 drives.
+	for i := 0; i < 8; i++ {
+		si.Disks = append(si.Disks, Disk{PoolIndex: 0, SetIndex: 0, DiskIndex: i, State: DriveStateOk})
+	}
+	for set := 0; set < 2; set++ {
+		for i := 0; i < 8; i++ {
+			state := DriveStateOk
+			if set == 1 && i < 3 {
+				state = DriveStateOffline
+			}
+			si.Disks = append(si.Disks, Disk{PoolIndex: 1, SetIndex: set, DiskIndex: i, State: state})
+		}
+	}
+
+	parities, err := si.PoolParities()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parities) != 2 {
+		t.Fatalf("expected 2 pools, got %d", len(parities))
+	}
+	if p := parities[0]; p.Sets != 1 || p.ReadTolerance() != 4 || p.WriteTolerance() != 3 {
+		t.Errorf("unexpected pool 1 %+v", p)
+	}
+	if p := parities[1]; p.Sets != 2 || p.ReadTolerance() != 2 || p.WriteTolerance() != 2 {
+		t.Errorf("unexpected pool 2 %+v", p)
+	}
+	if s := parities[1].String(); s != "pool 2 (EC:2): can lose 2 drives per set for reads, 2 for writes" {
+		t.Errorf("unexpected description %q", s)
+	}
+
+	tolerances, err := si.SetTolerances()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st := tolerances[0]; st.Pool != 1 || st.Set != 1 || st.Offline != 3 || st.ReadRemaining != -1 {
+		t.Errorf("expected pool 2 set 2 to have lost quorum, got %+v", st)
+	}
+
+	si.Backend.StandardSCParities = []int{4, 3}
+	if _, err = si.PoolParities(); err == nil {
+		t.Fatal("expected mismatching parity to be rejected")
+	}
+}
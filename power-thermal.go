@@ -0,0 +1,207 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/host"
+)
+
+// raplSampleInterval is the time between the two readings of the RAPL
+// energy counters a power draw is computed from, replaced in tests.
+var raplSampleInterval = time.Second
+
+// ipmitoolTimeout bounds the ipmitool run, BMCs can be slow to answer.
+const ipmitoolTimeout = 10 * time.Second
+
+// Sources of PowerReading.
+const (
+	PowerSourceRAPL = "rapl"
+	PowerSourceIPMI = "ipmi"
+)
+
+// PowerReading - the power draw of a CPU package or DRAM domain read
+// from RAPL, or of the chassis or a power supply read from the BMC.
+type PowerReading struct {
+	Source string  `json:"source" yaml:"source"`
+	Name   string  `json:"name" yaml:"name"`
+	Watts  float64 `json:"watts" yaml:"watts"`
+}
+
+// AddPowerThermal - appends the power draw and chassis thermal data of
+// a linux node to info, for HealthDataTypeSysPower. Temperatures of
+// thermal zones and of the BMC are appended to info.Sensors, power
+// draws from RAPL and the BMC to info.Power and the CPU thermal
+// throttling events are counted in info.ThermalThrottles. Sources which
+// are not accessible, e.g. RAPL counters without root or nodes without
+// ipmitool, are skipped, so this never fails.
+func AddPowerThermal(ctx context.Context, info *OSInfo) {
+	if runtime.GOOS != "linux" {
+		return
+	}
+	seen := make(map[string]bool, len(info.Sensors))
+	for _, s := range info.Sensors {
+		seen[s.SensorKey] = true
+	}
+	appendSensors := func(sensors []host.TemperatureStat) {
+		for _, s := range sensors {
+			if !seen[s.SensorKey] {
+				seen[s.SensorKey] = true
+				info.Sensors = append(info.Sensors, s)
+			}
+		}
+	}
+
+	appendSensors(thermalZones())
+	info.Power = append(info.Power, raplPower(ctx)...)
+	info.ThermalThrottles = thermalThrottles()
+
+	ctx, cancel := context.WithTimeout(ctx, ipmitoolTimeout)
+	defer cancel()
+	if out, err := exec.CommandContext(ctx, "ipmitool", "sdr", "elist").Output(); err == nil {
+		sensors, power := parseIPMISDR(string(out))
+		appendSensors(sensors)
+		info.Power = append(info.Power, power...)
+	}
+}
+
+// thermalZones - reads the temperatures of the kernel thermal zones,
+// reported in millidegrees Celsius.
+func thermalZones() []host.TemperatureStat {
+	zones, _ := filepath.Glob(filepath.Join(sysfsRoot, "class/thermal/thermal_zone[0-9]*"))
+	var sensors []host.TemperatureStat
+	for _, zone := range zones {
+		temp, err := readSysfsInt(filepath.Join(zone, "temp"))
+		if err != nil {
+			continue
+		}
+		name, _ := ioutil.ReadFile(filepath.Join(zone, "type"))
+		sensors = append(sensors, host.TemperatureStat{
+			SensorKey:   filepath.Base(zone) + "_" + strings.TrimSpace(string(name)),
+			Temperature: float64(temp) / 1000,
+		})
+	}
+	return sensors
+}
+
+// raplPower - computes the power draw of the RAPL domains from two
+// readings of their energy counters, in microjoules, which wrap at
+// max_energy_range_uj.
+func raplPower(ctx context.Context) []PowerReading {
+	domains, _ := filepath.Glob(filepath.Join(sysfsRoot, "class/powercap/intel-rapl:*"))
+	start := make(map[string]int64, len(domains))
+	for _, d := range domains {
+		if energy, err := readSysfsInt(filepath.Join(d, "energy_uj")); err == nil {
+			start[d] = energy
+		}
+	}
+	if len(start) == 0 {
+		return nil
+	}
+	begin := time.Now()
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-time.After(raplSampleInterval):
+	}
+	elapsed := time.Since(begin).Seconds()
+
+	var power []PowerReading
+	for _, d := range domains {
+		before, ok := start[d]
+		if !ok {
+			continue
+		}
+		after, err := readSysfsInt(filepath.Join(d, "energy_uj"))
+		if err != nil {
+			continue
+		}
+		if after < before {
+			max, _ := readSysfsInt(filepath.Join(d, "max_energy_range_uj"))
+			after += max
+		}
+		name, _ := ioutil.ReadFile(filepath.Join(d, "name"))
+		power = append(power, PowerReading{
+			Source: PowerSourceRAPL,
+			Name:   filepath.Base(d) + "_" + strings.TrimSpace(string(name)),
+			Watts:  float64(after-before) / 1e6 / elapsed,
+		})
+	}
+	return power
+}
+
+// thermalThrottles - returns the number of thermal throttling events of
+// all cores and packages since boot.
+func thermalThrottles() uint64 {
+	counts, _ := filepath.Glob(filepath.Join(sysfsRoot, "devices/system/cpu/cpu[0-9]*/thermal_throttle/*_throttle_count"))
+	var total uint64
+	for _, c := range counts {
+		if n, err := readSysfsInt(c); err == nil && n > 0 {
+			total += uint64(n)
+		}
+	}
+	return total
+}
+
+// parseIPMISDR - parses the temperature and power sensors of `ipmitool
+// sdr elist` output, with lines like
+//
+//	Inlet Temp       | 04h | ok  |  7.1 | 23 degrees C
+//	Pwr Consumption  | 77h | ok  |  7.1 | 308 Watts
+func parseIPMISDR(out string) ([]host.TemperatureStat, []PowerReading) {
+	var sensors []host.TemperatureStat
+	var power []PowerReading
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Split(line, "|")
+		if len(fields) != 5 || strings.TrimSpace(fields[2]) != "ok" {
+			continue
+		}
+		name := strings.TrimSpace(fields[0])
+		reading := strings.Fields(fields[4])
+		if len(reading) < 2 {
+			continue
+		}
+		value, err := strconv.ParseFloat(reading[0], 64)
+		if err != nil {
+			continue
+		}
+		switch unit := strings.Join(reading[1:], " "); unit {
+		case "degrees C":
+			sensors = append(sensors, host.TemperatureStat{SensorKey: "ipmi_" + name, Temperature: value})
+		case "Watts":
+			power = append(power, PowerReading{Source: PowerSourceIPMI, Name: name, Watts: value})
+		}
+	}
+	return sensors, power
+}
+
+// readSysfsInt - reads a sysfs file holding an integer.
+func readSysfsInt(path string) (int64, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
@@ -0,0 +1,99 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/shirou/gopsutil/host"
+)
+
+func TestAddPowerThermal(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("power and thermal telemetry is only collected on linux")
+	}
+	root, err := ioutil.TempDir("", "sysfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	write := func(path, content string) {
+		path = filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("class/thermal/thermal_zone0/type", "x86_pkg_temp\n")
+	write("class/thermal/thermal_zone0/temp", "71500\n")
+	write("class/thermal/thermal_zone1/type", "acpitz\n")
+	write("class/powercap/intel-rapl:0/name", "package-0\n")
+	write("class/powercap/intel-rapl:0/energy_uj", "123456789\n")
+	write("devices/system/cpu/cpu0/thermal_throttle/core_throttle_count", "3\n")
+	write("devices/system/cpu/cpu0/thermal_throttle/package_throttle_count", "5\n")
+	write("devices/system/cpu/cpu1/thermal_throttle/core_throttle_count", "2\n")
+
+	defer func(old string) { sysfsRoot = old }(sysfsRoot)
+	defer func(old time.Duration) { raplSampleInterval = old }(raplSampleInterval)
+	sysfsRoot = root
+	raplSampleInterval = time.Millisecond
+
+	info := OSInfo{Sensors: []host.TemperatureStat{{SensorKey: "coretemp_core_0", Temperature: 65}}}
+	AddPowerThermal(context.Background(), &info)
+	expectedSensors := []host.TemperatureStat{
+		{SensorKey: "coretemp_core_0", Temperature: 65},
+		{SensorKey: "thermal_zone0_x86_pkg_temp", Temperature: 71.5},
+	}
+	if !reflect.DeepEqual(info.Sensors[:2], expectedSensors) {
+		t.Errorf("expected sensors %+v, got %+v", expectedSensors, info.Sensors)
+	}
+	if len(info.Power) == 0 || info.Power[0] != (PowerReading{Source: PowerSourceRAPL, Name: "intel-rapl:0_package-0"}) {
+		t.Errorf("unexpected power readings %+v", info.Power)
+	}
+	if info.ThermalThrottles != 10 {
+		t.Errorf("expected 10 thermal throttles, got %d", info.ThermalThrottles)
+	}
+}
+
+func TestParseIPMISDR(t *testing.T) {
+	sensors, power := parseIPMISDR(`Inlet Temp       | 04h | ok  |  7.1 | 23 degrees C
+Exhaust Temp     | 01h | ok  |  7.1 | 38 degrees C
+Temp             | 0Eh | ns  |  3.1 | Disabled
+Pwr Consumption  | 77h | ok  |  7.1 | 308 Watts
+Current 1        | 6Ah | ok  | 10.1 | 0.80 Amps
+`)
+	expectedSensors := []host.TemperatureStat{
+		{SensorKey: "ipmi_Inlet Temp", Temperature: 23},
+		{SensorKey: "ipmi_Exhaust Temp", Temperature: 38},
+	}
+	if !reflect.DeepEqual(sensors, expectedSensors) {
+		t.Errorf("expected sensors %+v, got %+v", expectedSensors, sensors)
+	}
+	expectedPower := []PowerReading{{Source: PowerSourceIPMI, Name: "Pwr Consumption", Watts: 308}}
+	if !reflect.DeepEqual(power, expectedPower) {
+		t.Errorf("expected power %+v, got %+v", expectedPower, power)
+	}
+}
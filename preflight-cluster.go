@@ -0,0 +1,349 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Names of the cluster preflight checks.
+const (
+	PreflightCheckEndpoints  = "endpoints"
+	PreflightCheckDriveCount = "drive-count"
+	PreflightCheckDNS        = "dns"
+	PreflightCheckPort       = "port"
+	PreflightCheckTimeSync   = "time-sync"
+	PreflightCheckProxy      = "proxy-headers"
+)
+
+// ClusterPreflightOpts - options for PreflightCluster.
+type ClusterPreflightOpts struct {
+	// Timeout of every network probe, defaults to 5 seconds.
+	Timeout time.Duration
+	// MaxClockSkew tolerated between a host and this machine,
+	// defaults to 15 minutes, beyond which request signatures fail.
+	MaxClockSkew time.Duration
+	// Resolver used for DNS lookups, net.DefaultResolver if nil.
+	Resolver *net.Resolver
+	// Transport used for HTTP probes, DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// HostPreflight - preflight results of a host of the cluster.
+type HostPreflight struct {
+	Host   string   `json:"host"`
+	Addrs  []string `json:"addrs,omitempty"`
+	Drives int      `json:"drives"`
+	// ClockSkew is the time of the host minus the local time, only
+	// known if the host answered HTTP.
+	ClockSkew Duration         `json:"clockSkew,omitempty"`
+	Checks    []PreflightCheck `json:"checks"`
+}
+
+func (h *HostPreflight) add(name string, status PreflightStatus, format string, args ...interface{}) {
+	h.Checks = append(h.Checks, PreflightCheck{Name: name, Status: status, Message: fmt.Sprintf(format, args...)})
+}
+
+// ClusterPreflightReport - readiness of a set of hosts for a deployment.
+type ClusterPreflightReport struct {
+	// Checks spanning all hosts: endpoints, drive counts and DNS.
+	Checks []PreflightCheck `json:"checks"`
+	Hosts  []HostPreflight  `json:"hosts"`
+}
+
+func (r *ClusterPreflightReport) add(name string, status PreflightStatus, format string, args ...interface{}) {
+	r.Checks = append(r.Checks, PreflightCheck{Name: name, Status: status, Message: fmt.Sprintf(format, args...)})
+}
+
+// Ready - returns true if no check failed.
+func (r ClusterPreflightReport) Ready() bool {
+	for _, c := range r.Checks {
+		if c.Status == PreflightFail {
+			return false
+		}
+	}
+	for _, h := range r.Hosts {
+		if !(DrivePreflight{Checks: h.Checks}).Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+var ellipsesRegex = regexp.MustCompile(`\{([0-9]+)\.\.\.([0-9]+)\}`)
+
+// expandEllipses - expands ranges like "http://node{1...4}/disk{01...16}"
+// the way the server command line does.
+func expandEllipses(arg string) ([]string, error) {
+	m := ellipsesRegex.FindStringSubmatchIndex(arg)
+	if m == nil {
+		return []string{arg}, nil
+	}
+	start, end := arg[m[2]:m[3]], arg[m[4]:m[5]]
+	from, err := strconv.Atoi(start)
+	if err != nil {
+		return nil, err
+	}
+	to, err := strconv.Atoi(end)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid range %s in %s", arg[m[0]:m[1]], arg)
+	}
+	width := 0
+	if len(start) > 1 && start[0] == '0' {
+		width = len(start)
+	}
+	var expanded []string
+	for i := from; i <= to; i++ {
+		rest, err := expandEllipses(arg[m[1]:])
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rest {
+			expanded = append(expanded, fmt.Sprintf("%s%0*d%s", arg[:m[0]], width, i, r))
+		}
+	}
+	return expanded, nil
+}
+
+// PreflightCluster - validates, before deployment, that the hosts of
+// the given server endpoints, in the syntax of the server command line
+// with ellipses, are ready: drives are spread uniformly, host names
+// resolve consistently, the ports are reachable and, for hosts already
+// answering HTTP, clocks are in sync and proxies forward to MinIO.
+func PreflightCluster(ctx context.Context, endpoints []string, opts ClusterPreflightOpts) ClusterPreflightReport {
+	if opts.Timeout == 0 {
+		opts.Timeout = 5 * time.Second
+	}
+	if opts.MaxClockSkew == 0 {
+		opts.MaxClockSkew = 15 * time.Minute
+	}
+	if opts.Resolver == nil {
+		opts.Resolver = net.DefaultResolver
+	}
+
+	var report ClusterPreflightReport
+	hosts := make(map[string]*url.URL)
+	drives := make(map[string]int)
+	seen := make(map[string]bool)
+	for _, arg := range endpoints {
+		expanded, err := expandEllipses(arg)
+		if err != nil {
+			report.add(PreflightCheckEndpoints, PreflightFail, "%v", err)
+			return report
+		}
+		for _, e := range expanded {
+			u, err := url.Parse(e)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				report.add(PreflightCheckEndpoints, PreflightFail, "invalid endpoint %q", e)
+				return report
+			}
+			if seen[u.Host+u.Path] {
+				report.add(PreflightCheckEndpoints, PreflightFail, "duplicate endpoint %q", e)
+				return report
+			}
+			seen[u.Host+u.Path] = true
+			if _, ok := hosts[u.Host]; !ok {
+				hosts[u.Host] = u
+			}
+			drives[u.Host]++
+		}
+	}
+	if len(hosts) == 0 {
+		report.add(PreflightCheckEndpoints, PreflightFail, "no endpoints")
+		return report
+	}
+	report.add(PreflightCheckEndpoints, PreflightPass, "%d drives on %d hosts", len(seen), len(hosts))
+
+	names := make([]string, 0, len(hosts))
+	for host := range hosts {
+		names = append(names, host)
+	}
+	sort.Strings(names)
+
+	counts := make(map[int][]string)
+	for _, host := range names {
+		counts[drives[host]] = append(counts[drives[host]], host)
+	}
+	if len(counts) == 1 {
+		report.add(PreflightCheckDriveCount, PreflightPass, "%d drives per host", drives[names[0]])
+	} else {
+		var parts []string
+		for n, hs := range counts {
+			parts = append(parts, fmt.Sprintf("%d drives on %s", n, strings.Join(hs, ", ")))
+		}
+		sort.Strings(parts)
+		report.add(PreflightCheckDriveCount, PreflightFail, "drive counts differ: %s", strings.Join(parts, "; "))
+	}
+
+	report.Hosts = make([]HostPreflight, len(names))
+	var wg sync.WaitGroup
+	for i, host := range names {
+		wg.Add(1)
+		go func(i int, u *url.URL) {
+			defer wg.Done()
+			report.Hosts[i] = preflightHost(ctx, u, drives[u.Host], opts)
+		}(i, hosts[host])
+	}
+	wg.Wait()
+
+	checkPreflightDNS(&report)
+	return report
+}
+
+// checkPreflightDNS - fails if different host names resolve to the same
+// address and warns when host names and IP addresses are mixed.
+func checkPreflightDNS(report *ClusterPreflightReport) {
+	owners := make(map[string][]string)
+	literals := 0
+	for _, h := range report.Hosts {
+		hostname := h.Host
+		if host, _, err := net.SplitHostPort(h.Host); err == nil {
+			hostname = host
+		}
+		if net.ParseIP(hostname) != nil {
+			literals++
+		}
+		for _, addr := range h.Addrs {
+			owners[addr] = appendUnique(owners[addr], hostname)
+		}
+	}
+	var conflicts []string
+	for addr, hs := range owners {
+		if len(hs) > 1 {
+			conflicts = append(conflicts, fmt.Sprintf("%s resolves from %s", addr, strings.Join(hs, ", ")))
+		}
+	}
+	sort.Strings(conflicts)
+	switch {
+	case len(conflicts) > 0:
+		report.add(PreflightCheckDNS, PreflightFail, "hosts share addresses: %s", strings.Join(conflicts, "; "))
+	case literals > 0 && literals < len(report.Hosts):
+		report.add(PreflightCheckDNS, PreflightWarn, "endpoints mix host names and IP addresses")
+	default:
+		report.add(PreflightCheckDNS, PreflightPass, "")
+	}
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, v := range list {
+		if v == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+// preflightHost - resolves and probes a single host.
+func preflightHost(ctx context.Context, u *url.URL, drives int, opts ClusterPreflightOpts) HostPreflight {
+	h := HostPreflight{Host: u.Host, Drives: drives}
+
+	hostname, port := u.Hostname(), u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	addrs, err := opts.Resolver.LookupHost(lookupCtx, hostname)
+	cancel()
+	if err != nil {
+		h.add(PreflightCheckDNS, PreflightFail, "%v", err)
+		return h
+	}
+	sort.Strings(addrs)
+	h.Addrs = addrs
+	h.add(PreflightCheckDNS, PreflightPass, "%s", strings.Join(addrs, ", "))
+
+	dialer := net.Dialer{Timeout: opts.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(hostname, port))
+	switch {
+	case err == nil:
+		conn.Close()
+		h.add(PreflightCheckPort, PreflightPass, "port %s is listening", port)
+	case errors.Is(err, syscall.ECONNREFUSED):
+		// Nothing listens yet, but no firewall drops the traffic.
+		h.add(PreflightCheckPort, PreflightPass, "port %s is reachable, nothing is listening yet", port)
+		return h
+	default:
+		h.add(PreflightCheckPort, PreflightFail, "port %s is not reachable: %v", port, err)
+		return h
+	}
+
+	transport := opts.Transport
+	if transport == nil {
+		transport = DefaultTransport(u.Scheme == "https")
+	}
+	client := &http.Client{Transport: transport, Timeout: opts.Timeout}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.Scheme+"://"+u.Host+"/minio/health/live", nil)
+	if err != nil {
+		h.add(PreflightCheckTimeSync, PreflightWarn, "%v", err)
+		return h
+	}
+	sent := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		h.add(PreflightCheckTimeSync, PreflightWarn, "unable to check, no HTTP response: %v", err)
+		return h
+	}
+	closeResponse(resp)
+	local := sent.Add(time.Since(sent) / 2)
+
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err != nil {
+		h.add(PreflightCheckTimeSync, PreflightWarn, "unable to check, no Date header")
+	} else {
+		skew := date.Sub(local).Truncate(time.Second)
+		h.ClockSkew = Duration(skew)
+		if skew < 0 {
+			skew = -skew
+		}
+		if skew > opts.MaxClockSkew {
+			h.add(PreflightCheckTimeSync, PreflightFail, "clock is off by %s, more than %s", skew, opts.MaxClockSkew)
+		} else {
+			h.add(PreflightCheckTimeSync, PreflightPass, "clock is off by %s", skew)
+		}
+	}
+
+	// MinIO answers with its Server and request ID headers, a proxy in
+	// front of it has to forward them.
+	server := resp.Header.Get("Server")
+	switch {
+	case strings.HasPrefix(server, "MinIO"):
+		h.add(PreflightCheckProxy, PreflightPass, "answered by %s", server)
+	case resp.Header.Get("X-Amz-Request-Id") != "":
+		h.add(PreflightCheckProxy, PreflightPass, "answered by MinIO through %s", server)
+	default:
+		h.add(PreflightCheckProxy, PreflightWarn, "answered by %q instead of MinIO, make sure a proxy in front forwards the Host and X-Forwarded-* headers", server)
+	}
+	return h
+}
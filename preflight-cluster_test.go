@@ -0,0 +1,99 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestExpandEllipses(t *testing.T) {
+	expanded, err := expandEllipses("http://node{1...2}/disk{09...10}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"http://node1/disk09", "http://node1/disk10", "http://node2/disk09", "http://node2/disk10"}
+	if !reflect.DeepEqual(expanded, expected) {
+		t.Fatalf("expected %v, got %v", expected, expanded)
+	}
+	if _, err = expandEllipses("http://node{4...1}/disk"); err == nil {
+		t.Fatal("expected descending range to be rejected")
+	}
+}
+
+func TestPreflightCluster(t *testing.T) {
+	minio := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Server", "MinIO")
+	}))
+	defer minio.Close()
+	skewed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Date", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	}))
+	defer skewed.Close()
+
+	// A closed port is reachable, nothing listens on it yet.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := l.Addr().String()
+	l.Close()
+
+	report := PreflightCluster(context.Background(), []string{
+		minio.URL + "/data{1...4}",
+		skewed.URL + "/data{1...4}",
+		"http://" + closed + "/data{1...2}",
+	}, ClusterPreflightOpts{Timeout: time.Second})
+
+	if report.Ready() {
+		t.Fatal("expected cluster not to be ready")
+	}
+	cluster := DrivePreflight{Checks: report.Checks}
+	if s := preflightStatus(cluster, PreflightCheckDriveCount); s != PreflightFail {
+		t.Errorf("expected drive count to fail, got %s", s)
+	}
+	if s := preflightStatus(cluster, PreflightCheckDNS); s != PreflightPass {
+		t.Errorf("expected DNS to pass, got %s", s)
+	}
+
+	hosts := make(map[string]DrivePreflight)
+	for _, h := range report.Hosts {
+		hosts[h.Host] = DrivePreflight{Checks: h.Checks}
+	}
+	minioHost := hosts[minio.Listener.Addr().String()]
+	if s := preflightStatus(minioHost, PreflightCheckTimeSync); s != PreflightPass {
+		t.Errorf("expected time sync to pass, got %+v", minioHost.Checks)
+	}
+	if s := preflightStatus(minioHost, PreflightCheckProxy); s != PreflightPass {
+		t.Errorf("expected proxy headers to pass, got %+v", minioHost.Checks)
+	}
+	skewedHost := hosts[skewed.Listener.Addr().String()]
+	if s := preflightStatus(skewedHost, PreflightCheckTimeSync); s != PreflightFail {
+		t.Errorf("expected time sync to fail, got %+v", skewedHost.Checks)
+	}
+	if s := preflightStatus(skewedHost, PreflightCheckProxy); s != PreflightWarn {
+		t.Errorf("expected proxy headers to warn, got %+v", skewedHost.Checks)
+	}
+	if s := preflightStatus(hosts[closed], PreflightCheckPort); s != PreflightPass {
+		t.Errorf("expected closed port to be reachable, got %+v", hosts[closed].Checks)
+	}
+}
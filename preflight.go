@@ -0,0 +1,262 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/shirou/gopsutil/disk"
+)
+
+// PreflightStatus - outcome of a preflight check.
+type PreflightStatus string
+
+// Preflight check outcomes.
+const (
+	PreflightPass PreflightStatus = "pass"
+	// PreflightWarn does not prevent MinIO from starting but is
+	// not recommended in production.
+	PreflightWarn PreflightStatus = "warn"
+	PreflightFail PreflightStatus = "fail"
+)
+
+// Names of the drive preflight checks.
+const (
+	PreflightCheckPath         = "path"
+	PreflightCheckFilesystem   = "filesystem"
+	PreflightCheckMountOptions = "mount-options"
+	PreflightCheckPermissions  = "permissions"
+	PreflightCheckXattr        = "xattr"
+	PreflightCheckFreeSpace    = "free-space"
+)
+
+// DefaultPreflightMinFreeSpace is the free space a drive needs when
+// PreflightOpts.MinFreeSpace is not set.
+const DefaultPreflightMinFreeSpace = 1 * GiB
+
+// errPreflightUnsupported is returned by checks not available on this
+// operating system.
+var errPreflightUnsupported = errors.New("not supported on this operating system")
+
+// unsupportedFSTypes are filesystems MinIO drives must not use.
+var unsupportedFSTypes = map[string]bool{
+	"nfs":     true,
+	"nfs4":    true,
+	"cifs":    true,
+	"smb":     true,
+	"smbfs":   true,
+	"tmpfs":   true,
+	"overlay": true,
+	"fuse":    true,
+}
+
+// PreflightOpts - options for PreflightNode.
+type PreflightOpts struct {
+	// FSTypes passing the filesystem check, defaults to xfs. Other
+	// local filesystems only warn.
+	FSTypes []string
+	// MinFreeSpace every drive needs, defaults to
+	// DefaultPreflightMinFreeSpace.
+	MinFreeSpace ByteSize
+}
+
+// PreflightCheck - result of a single preflight check.
+type PreflightCheck struct {
+	Name    string          `json:"name" yaml:"name"`
+	Status  PreflightStatus `json:"status" yaml:"status"`
+	Message string          `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// DrivePreflight - results of the preflight checks of a drive.
+type DrivePreflight struct {
+	Path   string           `json:"path"`
+	Checks []PreflightCheck `json:"checks"`
+}
+
+// Passed - returns true if no check of the drive failed.
+func (d DrivePreflight) Passed() bool {
+	for _, c := range d.Checks {
+		if c.Status == PreflightFail {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *DrivePreflight) add(name string, status PreflightStatus, format string, args ...interface{}) {
+	d.Checks = append(d.Checks, PreflightCheck{Name: name, Status: status, Message: fmt.Sprintf(format, args...)})
+}
+
+// PreflightNode - runs local checks on the drives MinIO is about to be
+// started with: filesystem type, mount options, permissions, extended
+// attribute support and free space. It is meant for deployment
+// automation and runs on the node itself, not against a server.
+func PreflightNode(ctx context.Context, drivePaths []string, opts PreflightOpts) []DrivePreflight {
+	if len(opts.FSTypes) == 0 {
+		opts.FSTypes = []string{"xfs"}
+	}
+	if opts.MinFreeSpace == 0 {
+		opts.MinFreeSpace = DefaultPreflightMinFreeSpace
+	}
+
+	// Partitions are only needed for the mount options, a failure
+	// is reported by that check.
+	parts, partsErr := disk.PartitionsWithContext(ctx, true)
+
+	results := make([]DrivePreflight, 0, len(drivePaths))
+	for _, drivePath := range drivePaths {
+		result := DrivePreflight{Path: drivePath}
+		if err := ctx.Err(); err != nil {
+			result.add(PreflightCheckPath, PreflightFail, "%v", err)
+			results = append(results, result)
+			continue
+		}
+
+		absPath, err := filepath.Abs(drivePath)
+		if err == nil {
+			var fi os.FileInfo
+			if fi, err = os.Stat(absPath); err == nil && !fi.IsDir() {
+				err = errors.New("not a directory")
+			}
+		}
+		if err != nil {
+			result.add(PreflightCheckPath, PreflightFail, "%v", err)
+			results = append(results, result)
+			continue
+		}
+		result.add(PreflightCheckPath, PreflightPass, "")
+
+		usage, err := disk.UsageWithContext(ctx, absPath)
+		if err != nil {
+			result.add(PreflightCheckFilesystem, PreflightFail, "%v", err)
+		} else {
+			checkPreflightFilesystem(&result, usage.Fstype, opts)
+		}
+
+		if partsErr != nil {
+			result.add(PreflightCheckMountOptions, PreflightWarn, "unable to list mounts: %v", partsErr)
+		} else {
+			checkPreflightMount(&result, mountOf(absPath, parts))
+		}
+
+		checkPreflightPermissions(&result, absPath)
+
+		if err == nil {
+			checkPreflightFreeSpace(&result, ByteSize(usage.Free), opts)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func checkPreflightFilesystem(result *DrivePreflight, fsType string, opts PreflightOpts) {
+	for _, t := range opts.FSTypes {
+		if strings.EqualFold(t, fsType) {
+			result.add(PreflightCheckFilesystem, PreflightPass, "%s", fsType)
+			return
+		}
+	}
+	if fsType == "" || unsupportedFSTypes[strings.ToLower(fsType)] || strings.HasPrefix(fsType, "fuse.") {
+		result.add(PreflightCheckFilesystem, PreflightFail, "filesystem %q is not supported for MinIO drives", fsType)
+		return
+	}
+	result.add(PreflightCheckFilesystem, PreflightWarn, "filesystem %s is not one of the recommended %s", fsType, strings.Join(opts.FSTypes, ", "))
+}
+
+// mountOf - returns the partition absPath is mounted on, the one with
+// the longest mount point containing it.
+func mountOf(absPath string, parts []disk.PartitionStat) *disk.PartitionStat {
+	var mount *disk.PartitionStat
+	for i := range parts {
+		mp := parts[i].Mountpoint
+		if absPath != mp && !strings.HasPrefix(absPath, strings.TrimSuffix(mp, string(filepath.Separator))+string(filepath.Separator)) {
+			continue
+		}
+		if mount == nil || len(mp) > len(mount.Mountpoint) {
+			mount = &parts[i]
+		}
+	}
+	return mount
+}
+
+func checkPreflightMount(result *DrivePreflight, mount *disk.PartitionStat) {
+	switch {
+	case mount == nil:
+		result.add(PreflightCheckMountOptions, PreflightWarn, "unable to find the mount point")
+	case mount.Mountpoint == "/":
+		result.add(PreflightCheckMountOptions, PreflightWarn, "drive is on the root filesystem, use a dedicated drive")
+	default:
+		for _, opt := range strings.Split(mount.Opts, ",") {
+			if strings.TrimSpace(opt) == "noatime" {
+				result.add(PreflightCheckMountOptions, PreflightPass, "%s", mount.Opts)
+				return
+			}
+		}
+		result.add(PreflightCheckMountOptions, PreflightWarn, "%s is mounted without noatime, access time updates slow down metadata operations", mount.Mountpoint)
+	}
+}
+
+// checkPreflightPermissions - writes, syncs and removes a probe file,
+// also used to check extended attribute support.
+func checkPreflightPermissions(result *DrivePreflight, absPath string) {
+	f, err := ioutil.TempFile(absPath, ".minio-preflight-")
+	if err != nil {
+		result.add(PreflightCheckPermissions, PreflightFail, "drive is not writable: %v", err)
+		result.add(PreflightCheckXattr, PreflightFail, "drive is not writable")
+		return
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write([]byte("minio"))
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	switch {
+	case err != nil:
+		result.add(PreflightCheckPermissions, PreflightFail, "drive is not writable: %v", err)
+	case !ownedByCurrentUser(absPath):
+		result.add(PreflightCheckPermissions, PreflightWarn, "drive is writable but not owned by the current user")
+	default:
+		result.add(PreflightCheckPermissions, PreflightPass, "")
+	}
+
+	switch err = checkXattr(f.Name()); {
+	case err == nil:
+		result.add(PreflightCheckXattr, PreflightPass, "")
+	case errors.Is(err, errPreflightUnsupported):
+		result.add(PreflightCheckXattr, PreflightWarn, "%v", err)
+	default:
+		result.add(PreflightCheckXattr, PreflightFail, "extended attributes are not supported: %v", err)
+	}
+}
+
+func checkPreflightFreeSpace(result *DrivePreflight, free ByteSize, opts PreflightOpts) {
+	if free < opts.MinFreeSpace {
+		result.add(PreflightCheckFreeSpace, PreflightFail, "%s free, %s required", free, opts.MinFreeSpace)
+		return
+	}
+	result.add(PreflightCheckFreeSpace, PreflightPass, "%s free", free)
+}
@@ -0,0 +1,48 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build linux
+
+package madmin
+
+import (
+	"os"
+	"syscall"
+)
+
+// checkXattr - sets, reads and removes a user extended attribute.
+func checkXattr(path string) error {
+	const name = "user.minio.preflight"
+	if err := syscall.Setxattr(path, name, []byte("1"), 0); err != nil {
+		return err
+	}
+	buf := make([]byte, 8)
+	if _, err := syscall.Getxattr(path, name, buf); err != nil {
+		return err
+	}
+	return syscall.Removexattr(path, name)
+}
+
+// ownedByCurrentUser - returns true if path is owned by the user
+// running the process.
+func ownedByCurrentUser(path string) bool {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	return ok && int(st.Uid) == os.Getuid()
+}
@@ -0,0 +1,102 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shirou/gopsutil/disk"
+)
+
+func preflightStatus(d DrivePreflight, name string) PreflightStatus {
+	for _, c := range d.Checks {
+		if c.Name == name {
+			return c.Status
+		}
+	}
+	return ""
+}
+
+func TestPreflightChecks(t *testing.T) {
+	opts := PreflightOpts{FSTypes: []string{"xfs"}, MinFreeSpace: GiB}
+	parts := []disk.PartitionStat{
+		{Mountpoint: "/", Opts: "rw,relatime"},
+		{Mountpoint: "/mnt/disk1", Opts: "rw,noatime,attr2"},
+		{Mountpoint: "/mnt/disk10", Opts: "rw,relatime"},
+		{Mountpoint: "/mnt/nfs", Fstype: "nfs4"},
+	}
+
+	testCases := []struct {
+		path   string
+		fsType string
+		free   ByteSize
+		fs     PreflightStatus
+		mount  PreflightStatus
+		space  PreflightStatus
+	}{
+		{"/mnt/disk1/minio", "xfs", 10 * GiB, PreflightPass, PreflightPass, PreflightPass},
+		{"/mnt/disk10", "ext4", 10 * GiB, PreflightWarn, PreflightWarn, PreflightPass},
+		{"/mnt/nfs/data", "nfs4", 10 * GiB, PreflightFail, PreflightWarn, PreflightPass},
+		{"/data", "xfs", 512 * MiB, PreflightPass, PreflightWarn, PreflightFail},
+	}
+	for _, testCase := range testCases {
+		result := DrivePreflight{Path: testCase.path}
+		checkPreflightFilesystem(&result, testCase.fsType, opts)
+		checkPreflightMount(&result, mountOf(testCase.path, parts))
+		checkPreflightFreeSpace(&result, testCase.free, opts)
+		if s := preflightStatus(result, PreflightCheckFilesystem); s != testCase.fs {
+			t.Errorf("%s: expected filesystem %s, got %s", testCase.path, testCase.fs, s)
+		}
+		if s := preflightStatus(result, PreflightCheckMountOptions); s != testCase.mount {
+			t.Errorf("%s: expected mount options %s, got %s", testCase.path, testCase.mount, s)
+		}
+		if s := preflightStatus(result, PreflightCheckFreeSpace); s != testCase.space {
+			t.Errorf("%s: expected free space %s, got %s", testCase.path, testCase.space, s)
+		}
+		if passed := testCase.fs != PreflightFail && testCase.space != PreflightFail; result.Passed() != passed {
+			t.Errorf("%s: expected passed %v", testCase.path, passed)
+		}
+	}
+}
+
+func TestPreflightNode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "preflight")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	results := PreflightNode(context.Background(), []string{dir, filepath.Join(dir, "missing")}, PreflightOpts{})
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if s := preflightStatus(results[0], PreflightCheckPermissions); s == PreflightFail || s == "" {
+		t.Errorf("expected writable temporary directory, got %+v", results[0].Checks)
+	}
+	if results[1].Passed() || preflightStatus(results[1], PreflightCheckPath) != PreflightFail {
+		t.Errorf("expected missing drive to fail, got %+v", results[1].Checks)
+	}
+
+	// Probe files are removed.
+	if entries, _ := ioutil.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("expected no leftover files, got %d", len(entries))
+	}
+}
@@ -0,0 +1,354 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+)
+
+// ProfileFunctionDelta - change of the samples attributed to a function
+// between two profiles.
+type ProfileFunctionDelta struct {
+	Function string `json:"function"`
+	// Flat values are attributed to the function itself.
+	FlatBefore int64 `json:"flatBefore"`
+	FlatAfter  int64 `json:"flatAfter"`
+	FlatDelta  int64 `json:"flatDelta"`
+	// Cumulative values include the functions it calls.
+	CumBefore int64 `json:"cumBefore"`
+	CumAfter  int64 `json:"cumAfter"`
+	CumDelta  int64 `json:"cumDelta"`
+}
+
+// ProfileDiff - comparison of two profiles of the same kind.
+type ProfileDiff struct {
+	// SampleType and Unit of the compared values, e.g. inuse_space
+	// and bytes for heap profiles or cpu and nanoseconds.
+	SampleType  string `json:"sampleType"`
+	Unit        string `json:"unit"`
+	TotalBefore int64  `json:"totalBefore"`
+	TotalAfter  int64  `json:"totalAfter"`
+	// Functions whose values changed, by descending FlatDelta.
+	Functions []ProfileFunctionDelta `json:"functions"`
+}
+
+// Growing - returns up to n functions whose flat value grew the most.
+func (d ProfileDiff) Growing(n int) []ProfileFunctionDelta {
+	var growing []ProfileFunctionDelta
+	for _, f := range d.Functions {
+		if f.FlatDelta <= 0 || len(growing) == n {
+			break
+		}
+		growing = append(growing, f)
+	}
+	return growing
+}
+
+// CompareProfiles - compares two pprof profiles, as captured by the
+// profiling API for CPU or heap, using the default sample type, i.e.
+// inuse_space for heap profiles. Profiles may be gzip compressed.
+func CompareProfiles(before, after io.Reader) (ProfileDiff, error) {
+	b, err := readProfile(before)
+	if err != nil {
+		return ProfileDiff{}, fmt.Errorf("before: %w", err)
+	}
+	a, err := readProfile(after)
+	if err != nil {
+		return ProfileDiff{}, fmt.Errorf("after: %w", err)
+	}
+	bType, bUnit, bIdx := b.defaultSampleType()
+	aType, aUnit, aIdx := a.defaultSampleType()
+	if bType != aType || bUnit != aUnit {
+		return ProfileDiff{}, fmt.Errorf("cannot compare %s/%s with %s/%s profiles", bType, bUnit, aType, aUnit)
+	}
+
+	diff := ProfileDiff{SampleType: aType, Unit: aUnit}
+	deltas := make(map[string]*ProfileFunctionDelta)
+	get := func(name string) *ProfileFunctionDelta {
+		d, ok := deltas[name]
+		if !ok {
+			d = &ProfileFunctionDelta{Function: name}
+			deltas[name] = d
+		}
+		return d
+	}
+	diff.TotalBefore = b.aggregate(bIdx, func(name string, flat, cum int64) {
+		d := get(name)
+		d.FlatBefore += flat
+		d.CumBefore += cum
+	})
+	diff.TotalAfter = a.aggregate(aIdx, func(name string, flat, cum int64) {
+		d := get(name)
+		d.FlatAfter += flat
+		d.CumAfter += cum
+	})
+
+	for _, d := range deltas {
+		d.FlatDelta = d.FlatAfter - d.FlatBefore
+		d.CumDelta = d.CumAfter - d.CumBefore
+		if d.FlatDelta != 0 || d.CumDelta != 0 {
+			diff.Functions = append(diff.Functions, *d)
+		}
+	}
+	sort.Slice(diff.Functions, func(i, j int) bool {
+		fi, fj := diff.Functions[i], diff.Functions[j]
+		if fi.FlatDelta != fj.FlatDelta {
+			return fi.FlatDelta > fj.FlatDelta
+		}
+		if fi.CumDelta != fj.CumDelta {
+			return fi.CumDelta > fj.CumDelta
+		}
+		return fi.Function < fj.Function
+	})
+	return diff, nil
+}
+
+// pprofProfile - the parts of a pprof profile.proto needed for diffs.
+type pprofProfile struct {
+	sampleTypes [][2]int64 // type and unit string indexes
+	samples     []pprofSample
+	locations   map[uint64][]uint64 // function IDs, leaf first
+	functions   map[uint64]int64    // name string index
+	strings     []string
+	defaultType int64
+}
+
+type pprofSample struct {
+	locations []uint64
+	values    []int64
+}
+
+func (p *pprofProfile) str(i int64) string {
+	if i < 0 || i >= int64(len(p.strings)) {
+		return ""
+	}
+	return p.strings[i]
+}
+
+// defaultSampleType - returns the default sample type, the last one
+// unless the profile names it.
+func (p *pprofProfile) defaultSampleType() (string, string, int) {
+	if len(p.sampleTypes) == 0 {
+		return "", "", -1
+	}
+	idx := len(p.sampleTypes) - 1
+	for i, st := range p.sampleTypes {
+		if p.defaultType != 0 && st[0] == p.defaultType {
+			idx = i
+		}
+	}
+	return p.str(p.sampleTypes[idx][0]), p.str(p.sampleTypes[idx][1]), idx
+}
+
+// aggregate - calls fn with the flat and cumulative value of every
+// function of every sample, returns the total.
+func (p *pprofProfile) aggregate(idx int, fn func(name string, flat, cum int64)) int64 {
+	var total int64
+	for _, s := range p.samples {
+		if idx < 0 || idx >= len(s.values) {
+			continue
+		}
+		v := s.values[idx]
+		total += v
+		seen := make(map[string]bool)
+		for i, loc := range s.locations {
+			for j, fnID := range p.locations[loc] {
+				name := p.str(p.functions[fnID])
+				if name == "" {
+					name = fmt.Sprintf("0x%x", loc)
+				}
+				var flat int64
+				if i == 0 && j == 0 {
+					flat = v
+				}
+				var cum int64
+				if !seen[name] {
+					seen[name] = true
+					cum = v
+				}
+				if flat != 0 || cum != 0 {
+					fn(name, flat, cum)
+				}
+			}
+		}
+	}
+	return total
+}
+
+var errMalformedProfile = errors.New("malformed pprof profile")
+
+// readProfile - decodes a, possibly gzip compressed, pprof profile.
+func readProfile(r io.Reader) (*pprofProfile, error) {
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	} else {
+		r = br
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pprofProfile{locations: make(map[uint64][]uint64), functions: make(map[uint64]int64)}
+	err = forEachProtoField(data, func(field int, wire int, v uint64, buf []byte) error {
+		switch field {
+		case 1: // sample_type
+			var st [2]int64
+			err := forEachProtoField(buf, func(field int, wire int, v uint64, _ []byte) error {
+				if field == 1 || field == 2 {
+					st[field-1] = int64(v)
+				}
+				return nil
+			})
+			p.sampleTypes = append(p.sampleTypes, st)
+			return err
+		case 2: // sample
+			var s pprofSample
+			err := forEachProtoField(buf, func(field int, wire int, v uint64, buf []byte) error {
+				switch field {
+				case 1:
+					return protoVarints(wire, v, buf, func(v uint64) { s.locations = append(s.locations, v) })
+				case 2:
+					return protoVarints(wire, v, buf, func(v uint64) { s.values = append(s.values, int64(v)) })
+				}
+				return nil
+			})
+			p.samples = append(p.samples, s)
+			return err
+		case 4: // location
+			var id uint64
+			var fns []uint64
+			err := forEachProtoField(buf, func(field int, wire int, v uint64, buf []byte) error {
+				switch field {
+				case 1:
+					id = v
+				case 4: // line
+					return forEachProtoField(buf, func(field int, wire int, v uint64, _ []byte) error {
+						if field == 1 {
+							fns = append(fns, v)
+						}
+						return nil
+					})
+				}
+				return nil
+			})
+			p.locations[id] = fns
+			return err
+		case 5: // function
+			var id uint64
+			var name int64
+			err := forEachProtoField(buf, func(field int, wire int, v uint64, _ []byte) error {
+				switch field {
+				case 1:
+					id = v
+				case 2:
+					name = int64(v)
+				}
+				return nil
+			})
+			p.functions[id] = name
+			return err
+		case 6: // string_table
+			p.strings = append(p.strings, string(buf))
+		case 14: // default_sample_type
+			p.defaultType = int64(v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(p.sampleTypes) == 0 {
+		return nil, errMalformedProfile
+	}
+	return p, nil
+}
+
+// forEachProtoField - calls fn for every field of a protobuf message
+// with its varint or fixed value, or its bytes if length delimited.
+func forEachProtoField(data []byte, fn func(field int, wire int, v uint64, buf []byte) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errMalformedProfile
+		}
+		data = data[n:]
+		field, wire := int(key>>3), int(key&7)
+		var v uint64
+		var buf []byte
+		switch wire {
+		case 0:
+			if v, n = binary.Uvarint(data); n <= 0 {
+				return errMalformedProfile
+			}
+			data = data[n:]
+		case 1:
+			if len(data) < 8 {
+				return errMalformedProfile
+			}
+			v, data = binary.LittleEndian.Uint64(data), data[8:]
+		case 2:
+			l, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < l {
+				return errMalformedProfile
+			}
+			buf, data = data[n:n+int(l)], data[n+int(l):]
+		case 5:
+			if len(data) < 4 {
+				return errMalformedProfile
+			}
+			v, data = uint64(binary.LittleEndian.Uint32(data)), data[4:]
+		default:
+			return errMalformedProfile
+		}
+		if err := fn(field, wire, v, buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// protoVarints - decodes a repeated varint field, packed or not.
+func protoVarints(wire int, v uint64, buf []byte, fn func(uint64)) error {
+	if wire == 0 {
+		fn(v)
+		return nil
+	}
+	r := bytes.NewReader(buf)
+	for r.Len() > 0 {
+		v, err := binary.ReadUvarint(r)
+		if err != nil {
+			return errMalformedProfile
+		}
+		fn(v)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"testing"
+)
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+// protoMessage - minimal protobuf encoder for test profiles.
+type protoMessage []byte
+
+func (m protoMessage) varint(field int, v uint64) protoMessage {
+	m = appendUvarint(m, uint64(field<<3))
+	return appendUvarint(m, v)
+}
+
+func (m protoMessage) bytes(field int, b []byte) protoMessage {
+	m = appendUvarint(m, uint64(field<<3|2))
+	m = appendUvarint(m, uint64(len(b)))
+	return append(m, b...)
+}
+
+func (m protoMessage) packed(field int, vs ...uint64) protoMessage {
+	var b []byte
+	for _, v := range vs {
+		b = appendUvarint(b, v)
+	}
+	return m.bytes(field, b)
+}
+
+// testHeapProfile - returns a heap profile with a sample of inuse bytes
+// per stack, stacks are lists of function IDs, leaf first.
+func testHeapProfile(samples map[int64][]uint64) []byte {
+	var p protoMessage
+	for _, s := range []string{"", "alloc_space", "inuse_space", "bytes", "main", "handler", "leak", "cache"} {
+		p = p.bytes(6, []byte(s))
+	}
+	p = p.bytes(1, protoMessage{}.varint(1, 1).varint(2, 3))
+	p = p.bytes(1, protoMessage{}.varint(1, 2).varint(2, 3))
+	for id := uint64(1); id <= 4; id++ {
+		p = p.bytes(5, protoMessage{}.varint(1, id).varint(2, id+3))
+		// Location IDs equal the function IDs.
+		p = p.bytes(4, protoMessage{}.varint(1, id).bytes(4, protoMessage{}.varint(1, id)))
+	}
+	for inuse, stack := range samples {
+		p = p.bytes(2, protoMessage{}.packed(1, stack...).packed(2, uint64(inuse)*2, uint64(inuse)))
+	}
+	return p
+}
+
+func TestCompareProfiles(t *testing.T) {
+	before := testHeapProfile(map[int64][]uint64{
+		100: {3, 2, 1}, // leak <- handler <- main
+		50:  {4, 1},    // cache <- main
+	})
+	after := testHeapProfile(map[int64][]uint64{
+		1000: {3, 2, 1},
+		40:   {4, 1},
+	})
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	w.Write(after)
+	w.Close()
+
+	diff, err := CompareProfiles(bytes.NewReader(before), &gz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.SampleType != "inuse_space" || diff.Unit != "bytes" || diff.TotalBefore != 150 || diff.TotalAfter != 1040 {
+		t.Fatalf("unexpected diff %+v", diff)
+	}
+	growing := diff.Growing(5)
+	if len(growing) != 1 || growing[0].Function != "leak" || growing[0].FlatDelta != 900 || growing[0].CumDelta != 900 {
+		t.Fatalf("unexpected growing functions %+v", growing)
+	}
+	last := diff.Functions[len(diff.Functions)-1]
+	if last.Function != "cache" || last.FlatDelta != -10 {
+		t.Fatalf("unexpected shrinking function %+v", last)
+	}
+	for _, f := range diff.Functions {
+		if f.Function == "main" && (f.FlatDelta != 0 || f.CumDelta != 890) {
+			t.Fatalf("unexpected caller delta %+v", f)
+		}
+	}
+
+	if _, err = CompareProfiles(bytes.NewReader(before), bytes.NewReader([]byte{0xff})); err == nil {
+		t.Fatal("expected malformed profile to be rejected")
+	}
+}
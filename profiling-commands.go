@@ -18,7 +18,6 @@ package madmin
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -76,7 +75,7 @@ func (adm *AdminClient) StartProfiling(ctx context.Context, profiler ProfilerTyp
 	}
 
 	var startResults []StartProfilingResult
-	err = json.Unmarshal(jsonResult, &startResults)
+	err = adm.unmarshalResponse(resp, jsonResult, &startResults)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +89,8 @@ func (adm *AdminClient) DownloadProfilingData(ctx context.Context) (io.ReadClose
 	path := fmt.Sprintf(adminAPIPrefix + "/profiling/download")
 	resp, err := adm.executeMethod(ctx,
 		http.MethodGet, requestData{
-			relPath: path,
+			relPath:           path,
+			unboundedResponse: true,
 		},
 	)
 
@@ -41,7 +41,7 @@ func (t QuotaType) IsValid() bool {
 
 // BucketQuota holds bucket quota restrictions
 type BucketQuota struct {
-	Quota uint64    `json:"quota"`
+	Quota ByteSize  `json:"quota"`
 	Type  QuotaType `json:"quotatype,omitempty"`
 }
 
@@ -81,7 +81,7 @@ func (adm *AdminClient) GetBucketQuota(ctx context.Context, bucket string) (q Bu
 	if err != nil {
 		return q, err
 	}
-	if err = json.Unmarshal(b, &q); err != nil {
+	if err = adm.unmarshalResponse(resp, b, &q); err != nil {
 		return q, err
 	}
 
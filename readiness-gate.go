@@ -0,0 +1,262 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ReadinessData - the admin data a readiness check is evaluated
+// against, combined with |.
+type ReadinessData uint8
+
+// Admin data of readiness checks.
+const (
+	ReadinessDataServerInfo ReadinessData = 1 << iota
+	ReadinessDataStorage
+	ReadinessDataHeal
+)
+
+// ReadinessInput is the admin data a ReadinessGate evaluates its checks
+// against, sections not needed by any check are nil.
+type ReadinessInput struct {
+	Time    time.Time
+	Info    *InfoMessage
+	Storage *StorageInfo
+	Heal    *BgHealState
+}
+
+// ReadinessCheck - a named predicate on admin data, along with a human
+// readable detail of the observed value.
+type ReadinessCheck struct {
+	Name string
+	// Needs is the data Ready is evaluated against, fetched by
+	// ReadinessGate.Evaluate.
+	Needs ReadinessData
+	Ready func(in ReadinessInput) (ready bool, detail string)
+}
+
+// ReadinessCheckResult - outcome of a readiness check.
+type ReadinessCheckResult struct {
+	Name   string `json:"name"`
+	Ready  bool   `json:"ready"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// ReadinessResult - outcome of a ReadinessGate, ready if all of its
+// checks are.
+type ReadinessResult struct {
+	Ready  bool                   `json:"ready"`
+	Time   time.Time              `json:"time"`
+	Checks []ReadinessCheckResult `json:"checks"`
+}
+
+// Reason - returns the details of the checks that are not ready, e.g.
+// for the message of a Kubernetes status condition.
+func (r ReadinessResult) Reason() string {
+	var reasons []string
+	for _, c := range r.Checks {
+		if !c.Ready {
+			reasons = append(reasons, c.Name+": "+c.Detail)
+		}
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// ReadinessGate - composes readiness checks into a single verdict, e.g.
+// to gate rolling upgrades or scaling in the reconcile loop of a
+// Kubernetes operator.
+type ReadinessGate struct {
+	Checks []ReadinessCheck
+}
+
+// NewReadinessGate - returns a gate ready once all checks are.
+func NewReadinessGate(checks ...ReadinessCheck) (*ReadinessGate, error) {
+	names := make(map[string]struct{}, len(checks))
+	for _, c := range checks {
+		if c.Name == "" {
+			return nil, ErrInvalidArgument("Readiness check name cannot be empty.")
+		}
+		if c.Ready == nil {
+			return nil, ErrInvalidArgument("Readiness check " + c.Name + " has no predicate.")
+		}
+		if _, ok := names[c.Name]; ok {
+			return nil, ErrInvalidArgument("Readiness check " + c.Name + " already exists.")
+		}
+		names[c.Name] = struct{}{}
+	}
+	return &ReadinessGate{Checks: checks}, nil
+}
+
+// Needs - returns the data the checks of the gate need.
+func (g *ReadinessGate) Needs() ReadinessData {
+	var needs ReadinessData
+	for _, c := range g.Checks {
+		needs |= c.Needs
+	}
+	return needs
+}
+
+// Check - evaluates all checks against in, checks are not ready if the
+// data they need is missing.
+func (g *ReadinessGate) Check(in ReadinessInput) ReadinessResult {
+	if in.Time.IsZero() {
+		in.Time = time.Now()
+	}
+	r := ReadinessResult{Ready: true, Time: in.Time}
+	for _, c := range g.Checks {
+		res := ReadinessCheckResult{Name: c.Name}
+		if missing := in.missing(c.Needs); missing != "" {
+			res.Detail = missing + " is not available"
+		} else {
+			res.Ready, res.Detail = c.Ready(in)
+		}
+		r.Ready = r.Ready && res.Ready
+		r.Checks = append(r.Checks, res)
+	}
+	return r
+}
+
+// missing - returns the first section of needs missing in in.
+func (in ReadinessInput) missing(needs ReadinessData) string {
+	switch {
+	case needs&ReadinessDataServerInfo != 0 && in.Info == nil:
+		return "server info"
+	case needs&ReadinessDataStorage != 0 && in.Storage == nil:
+		return "storage info"
+	case needs&ReadinessDataHeal != 0 && in.Heal == nil:
+		return "heal status"
+	}
+	return ""
+}
+
+// Evaluate - fetches the live admin data the checks need and evaluates
+// them. Errors fetching the data are returned rather than reported as
+// not ready, so that reconcile loops can requeue.
+func (g *ReadinessGate) Evaluate(ctx context.Context, adm *AdminClient) (ReadinessResult, error) {
+	in := ReadinessInput{Time: adm.now()}
+	needs := g.Needs()
+	if needs&ReadinessDataServerInfo != 0 {
+		info, err := adm.ServerInfo(ctx)
+		if err != nil {
+			return ReadinessResult{}, err
+		}
+		in.Info = &info
+	}
+	if needs&ReadinessDataStorage != 0 {
+		storage, err := adm.StorageInfo(ctx)
+		if err != nil {
+			return ReadinessResult{}, err
+		}
+		in.Storage = &storage
+	}
+	if needs&ReadinessDataHeal != 0 {
+		heal, err := adm.BackgroundHealStatus(ctx)
+		if err != nil {
+			return ReadinessResult{}, err
+		}
+		in.Heal = &heal
+	}
+	return g.Check(in), nil
+}
+
+// AllServersOnline - ready when every server of the cluster is online.
+func AllServersOnline() ReadinessCheck {
+	return ReadinessCheck{
+		Name:  "all-servers-online",
+		Needs: ReadinessDataServerInfo,
+		Ready: func(in ReadinessInput) (bool, string) {
+			var offline []string
+			for _, srv := range in.Info.Servers {
+				if srv.State != string(ItemOnline) {
+					offline = append(offline, srv.Endpoint)
+				}
+			}
+			if len(offline) > 0 {
+				return false, fmt.Sprintf("%d of %d servers offline: %s", len(offline), len(in.Info.Servers), strings.Join(offline, ", "))
+			}
+			return true, fmt.Sprintf("%d servers online", len(in.Info.Servers))
+		},
+	}
+}
+
+// AllDrivesOnline - ready when every drive of the cluster is ok.
+func AllDrivesOnline() ReadinessCheck {
+	return ReadinessCheck{
+		Name:  "all-drives-online",
+		Needs: ReadinessDataStorage,
+		Ready: func(in ReadinessInput) (bool, string) {
+			var offline []string
+			for _, d := range in.Storage.Disks {
+				if d.State != DriveStateOk {
+					offline = append(offline, d.Endpoint+" ("+d.State+")")
+				}
+			}
+			if len(offline) > 0 {
+				return false, fmt.Sprintf("%d of %d drives not ok: %s", len(offline), len(in.Storage.Disks), strings.Join(offline, ", "))
+			}
+			return true, fmt.Sprintf("%d drives ok", len(in.Storage.Disks))
+		},
+	}
+}
+
+// HealBacklogBelow - ready when fewer than n buckets are queued for
+// healing across all healing drives.
+func HealBacklogBelow(n int) ReadinessCheck {
+	return ReadinessCheck{
+		Name:  "heal-backlog-below",
+		Needs: ReadinessDataHeal,
+		Ready: func(in ReadinessInput) (bool, string) {
+			backlog := healBacklog(*in.Heal)
+			return backlog < n, fmt.Sprintf("heal backlog is %d buckets, threshold %d", backlog, n)
+		},
+	}
+}
+
+// QuorumHealthy - ready when every erasure set keeps write quorum even
+// if it loses tolerance more drives, zero to only require quorum now.
+func QuorumHealthy(tolerance int) ReadinessCheck {
+	return ReadinessCheck{
+		Name:  "quorum-healthy",
+		Needs: ReadinessDataStorage,
+		Ready: func(in ReadinessInput) (bool, string) {
+			tolerances, err := in.Storage.SetTolerances()
+			if err != nil {
+				return false, err.Error()
+			}
+			if len(tolerances) == 0 {
+				return true, "no erasure sets"
+			}
+			worst := tolerances[0]
+			for _, st := range tolerances {
+				if st.WriteRemaining < worst.WriteRemaining {
+					worst = st
+				}
+			}
+			detail := fmt.Sprintf("pool %d set %d has %d of %d drives offline", worst.Pool+1, worst.Set+1, worst.Offline, worst.Drives)
+			if worst.WriteRemaining < 0 {
+				detail += ", write quorum is lost"
+			} else {
+				detail += fmt.Sprintf(", can lose %d more for writes", worst.WriteRemaining)
+			}
+			return worst.WriteRemaining >= tolerance, detail
+		},
+	}
+}
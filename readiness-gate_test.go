@@ -0,0 +1,101 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestReadinessGate(t *testing.T) {
+	storage := StorageInfo{Backend: BackendInfo{Type: Erasure, StandardSCData: []int{6}, StandardSCParity: 2}}
+	for i := 0; i < 8; i++ {
+		storage.Disks = append(storage.Disks, Disk{Endpoint: "http://node" + string(rune('1'+i)) + "/data", State: DriveStateOk})
+	}
+	heal := BgHealState{Sets: []SetStatus{{Disks: []Disk{{HealInfo: &HealingDisk{QueuedBuckets: []string{"a", "b"}}}}}}}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/storageinfo"):
+			json.NewEncoder(w).Encode(storage)
+		case strings.HasSuffix(r.URL.Path, "/background-heal/status"):
+			json.NewEncoder(w).Encode(heal)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gate, err := NewReadinessGate(AllDrivesOnline(), HealBacklogBelow(3), QuorumHealthy(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if needs := gate.Needs(); needs != ReadinessDataStorage|ReadinessDataHeal {
+		t.Errorf("unexpected data needed %b", needs)
+	}
+	r, err := gate.Evaluate(context.Background(), adm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Ready || len(r.Checks) != 3 || r.Reason() != "" {
+		t.Errorf("expected the cluster to be ready, got %+v", r)
+	}
+
+	storage.Disks[0].State = DriveStateOffline
+	heal.Sets[0].Disks[0].HealInfo.QueuedBuckets = []string{"a", "b", "c"}
+	if r, err = gate.Evaluate(context.Background(), adm); err != nil {
+		t.Fatal(err)
+	}
+	if r.Ready || !r.Checks[2].Ready {
+		t.Errorf("expected only the drives and heal backlog not to be ready, got %+v", r)
+	}
+	if reason := r.Reason(); !strings.HasPrefix(reason, "all-drives-online: 1 of 8 drives not ok: http://node1/data (offline)") ||
+		!strings.Contains(reason, "; heal-backlog-below: heal backlog is 3 buckets, threshold 3") {
+		t.Errorf("unexpected reason %q", reason)
+	}
+
+	storage.Disks[1].State = DriveStateOffline
+	if r, err = gate.Evaluate(context.Background(), adm); err != nil {
+		t.Fatal(err)
+	}
+	if c := r.Checks[2]; c.Ready || c.Detail != "pool 1 set 1 has 2 of 8 drives offline, can lose 0 more for writes" {
+		t.Errorf("expected the quorum to be at risk, got %+v", c)
+	}
+
+	// Checks are not ready if their data is missing.
+	gate, _ = NewReadinessGate(AllServersOnline())
+	if r = gate.Check(ReadinessInput{}); r.Ready || r.Checks[0].Detail != "server info is not available" {
+		t.Errorf("expected missing data not to be ready, got %+v", r)
+	}
+	if _, err = gate.Evaluate(context.Background(), adm); err == nil {
+		t.Error("expected an error fetching the server info")
+	}
+
+	if _, err = NewReadinessGate(AllDrivesOnline(), AllDrivesOnline()); err == nil {
+		t.Error("expected duplicate checks to be rejected")
+	}
+}
@@ -0,0 +1,297 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultRedactionReplacement replaces redacted strings when a rule
+// has no replacement.
+const DefaultRedactionReplacement = "REDACTED"
+
+// RedactionRule - a rule of a redaction policy. Path selects values
+// with a JSONPath subset: "$", ".name", "['name']", "[0]", "[*]", ".*"
+// and recursive descent "..name". Without a pattern the selected values
+// are redacted as a whole, strings become the replacement and other
+// values their zero value. With a pattern only the matches in strings
+// of the selected values, or of the whole document if Path is empty,
+// are replaced, the replacement may refer to groups like "$1".
+type RedactionRule struct {
+	Name        string `json:"name,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Pattern     string `json:"pattern,omitempty"`
+	Replacement string `json:"replacement,omitempty"`
+}
+
+type redactionRule struct {
+	RedactionRule
+	steps   []pathStep
+	pattern *regexp.Regexp
+}
+
+// RedactionPolicy - a compiled set of redaction rules applied to
+// diagnostics, log and trace exports. A nil policy redacts nothing.
+type RedactionPolicy struct {
+	rules []redactionRule
+}
+
+// redactionPolicyFile - the JSON format of a redaction policy.
+type redactionPolicyFile struct {
+	Rules []RedactionRule `json:"rules"`
+}
+
+// NewRedactionPolicy - compiles rules into a policy.
+func NewRedactionPolicy(rules []RedactionRule) (*RedactionPolicy, error) {
+	p := &RedactionPolicy{}
+	for i, rule := range rules {
+		if rule.Name == "" {
+			rule.Name = "rule " + strconv.Itoa(i)
+		}
+		if rule.Path == "" && rule.Pattern == "" {
+			return nil, ErrInvalidArgument("Redaction " + rule.Name + " needs a path or a pattern.")
+		}
+		if rule.Replacement == "" {
+			rule.Replacement = DefaultRedactionReplacement
+		}
+		r := redactionRule{RedactionRule: rule}
+		var err error
+		if rule.Path != "" {
+			if r.steps, err = parseJSONPath(rule.Path); err != nil {
+				return nil, ErrInvalidArgument("Redaction " + rule.Name + ": " + err.Error())
+			}
+		}
+		if rule.Pattern != "" {
+			if r.pattern, err = regexp.Compile(rule.Pattern); err != nil {
+				return nil, ErrInvalidArgument("Redaction " + rule.Name + ": " + err.Error())
+			}
+		}
+		p.rules = append(p.rules, r)
+	}
+	return p, nil
+}
+
+// LoadRedactionPolicy - reads a policy in the form
+// {"rules": [{"name": ..., "path": ..., "pattern": ..., "replacement": ...}]}.
+func LoadRedactionPolicy(r io.Reader) (*RedactionPolicy, error) {
+	var f redactionPolicyFile
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&f); err != nil {
+		return nil, err
+	}
+	return NewRedactionPolicy(f.Rules)
+}
+
+// Rules - returns the rules of the policy.
+func (p *RedactionPolicy) Rules() []RedactionRule {
+	if p == nil {
+		return nil
+	}
+	rules := make([]RedactionRule, len(p.rules))
+	for i, r := range p.rules {
+		rules[i] = r.RedactionRule
+	}
+	return rules
+}
+
+// RedactJSON - applies the policy to a JSON document.
+func (p *RedactionPolicy) RedactJSON(data []byte) ([]byte, error) {
+	if p == nil || len(p.rules) == 0 {
+		return data, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	for _, r := range p.rules {
+		if r.steps == nil {
+			doc = r.replaceStrings(doc)
+			continue
+		}
+		doc = applyJSONPath(doc, r.steps, r.redact)
+	}
+	return json.Marshal(doc)
+}
+
+// Redact - returns a redacted copy of v, of the same type, made through
+// its JSON encoding. Fields not encoded to JSON are left zero.
+func (p *RedactionPolicy) Redact(v interface{}) (interface{}, error) {
+	if p == nil || len(p.rules) == 0 || v == nil {
+		return v, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = p.RedactJSON(data); err != nil {
+		return nil, err
+	}
+	redacted := reflect.New(reflect.TypeOf(v))
+	if err = json.Unmarshal(data, redacted.Interface()); err != nil {
+		return nil, err
+	}
+	return redacted.Elem().Interface(), nil
+}
+
+// redact - redacts a value selected by the rule path.
+func (r redactionRule) redact(v interface{}) interface{} {
+	if r.pattern != nil {
+		return r.replaceStrings(v)
+	}
+	switch v.(type) {
+	case string:
+		return r.Replacement
+	case json.Number:
+		return json.Number("0")
+	case bool:
+		return false
+	}
+	return nil
+}
+
+// replaceStrings - replaces the pattern in all strings within v.
+func (r redactionRule) replaceStrings(v interface{}) interface{} {
+	switch t := v.(type) {
+	case string:
+		return r.pattern.ReplaceAllString(t, r.Replacement)
+	case map[string]interface{}:
+		for k, c := range t {
+			t[k] = r.replaceStrings(c)
+		}
+	case []interface{}:
+		for i, c := range t {
+			t[i] = r.replaceStrings(c)
+		}
+	}
+	return v
+}
+
+// pathStep - a step of a parsed JSONPath.
+type pathStep struct {
+	name      string
+	index     int // -1 unless an array index
+	wildcard  bool
+	recursive bool
+}
+
+// parseJSONPath - parses the JSONPath subset supported by redaction rules.
+func parseJSONPath(path string) ([]pathStep, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path %q must start with $", path)
+	}
+	steps := []pathStep{}
+	rest := path[1:]
+	for rest != "" {
+		step := pathStep{index: -1}
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			step.recursive = true
+			rest = rest[2:]
+		case rest[0] == '.':
+			rest = rest[1:]
+		case rest[0] == '[':
+		default:
+			return nil, fmt.Errorf("unexpected %q in path %q", rest, path)
+		}
+
+		if rest != "" && rest[0] == '[' {
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated [ in path %q", path)
+			}
+			sel := rest[1:end]
+			rest = rest[end+1:]
+			switch {
+			case sel == "*":
+				step.wildcard = true
+			case len(sel) >= 2 && (sel[0] == '\'' || sel[0] == '"') && sel[len(sel)-1] == sel[0]:
+				step.name = sel[1 : len(sel)-1]
+			default:
+				i, err := strconv.Atoi(sel)
+				if err != nil || i < 0 {
+					return nil, fmt.Errorf("invalid selector [%s] in path %q", sel, path)
+				}
+				step.index = i
+			}
+		} else {
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			step.name, rest = rest[:end], rest[end:]
+			if step.name == "" {
+				return nil, fmt.Errorf("empty name in path %q", path)
+			}
+			if step.name == "*" {
+				step.wildcard, step.name = true, ""
+			}
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// applyJSONPath - replaces every value of v selected by steps with the
+// result of f.
+func applyJSONPath(v interface{}, steps []pathStep, f func(interface{}) interface{}) interface{} {
+	if len(steps) == 0 {
+		return f(v)
+	}
+	step := steps[0]
+	if step.recursive {
+		here := step
+		here.recursive = false
+		v = applyJSONPath(v, append([]pathStep{here}, steps[1:]...), f)
+		switch t := v.(type) {
+		case map[string]interface{}:
+			for k, c := range t {
+				t[k] = applyJSONPath(c, steps, f)
+			}
+		case []interface{}:
+			for i, c := range t {
+				t[i] = applyJSONPath(c, steps, f)
+			}
+		}
+		return v
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, c := range t {
+			if step.wildcard || (step.index < 0 && k == step.name) {
+				t[k] = applyJSONPath(c, steps[1:], f)
+			}
+		}
+	case []interface{}:
+		for i, c := range t {
+			if step.wildcard || step.index == i {
+				t[i] = applyJSONPath(c, steps[1:], f)
+			}
+		}
+	}
+	return v
+}
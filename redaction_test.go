@@ -0,0 +1,90 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactJSON(t *testing.T) {
+	policy, err := LoadRedactionPolicy(strings.NewReader(`{"rules": [
+		{"name": "hosts", "path": "$.servers[*].endpoint"},
+		{"name": "secrets", "path": "$..secret"},
+		{"name": "first-port", "path": "$.ports[0]"},
+		{"name": "emails", "pattern": "[a-z]+@([a-z.]+)", "replacement": "***@$1"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := policy.RedactJSON([]byte(`{
+		"servers": [{"endpoint": "node1:9000", "state": "ok"}, {"endpoint": "node2:9000"}],
+		"config": {"secret": "s3cr3t", "nested": [{"secret": true}]},
+		"ports": [9000, 9001],
+		"owner": "admin@example.com"
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"config":{"nested":[{"secret":false}],"secret":"REDACTED"},` +
+		`"owner":"***@example.com","ports":[0,9001],` +
+		`"servers":[{"endpoint":"REDACTED","state":"ok"},{"endpoint":"REDACTED"}]}`
+	if string(data) != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, data)
+	}
+
+	for _, rule := range []RedactionRule{
+		{Name: "empty"},
+		{Path: "servers"},
+		{Path: "$.servers[x]"},
+		{Pattern: "("},
+	} {
+		if _, err = NewRedactionPolicy([]RedactionRule{rule}); err == nil {
+			t.Errorf("expected rule %+v to be rejected", rule)
+		}
+	}
+}
+
+func TestRedactValue(t *testing.T) {
+	policy, err := NewRedactionPolicy([]RedactionRule{
+		{Path: "$.node"},
+		{Path: "$.api.args.bucket", Pattern: "^tenant-[0-9]+", Replacement: "tenant-x"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := LogInfo{NodeName: "node1", ConsoleMsg: "hello"}
+	info.API = &logAPI{Args: &logArgs{Bucket: "tenant-42-logs"}}
+
+	v, err := policy.Redact(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	redacted := v.(LogInfo)
+	if redacted.NodeName != DefaultRedactionReplacement || redacted.ConsoleMsg != "hello" || redacted.API.Args.Bucket != "tenant-x-logs" {
+		t.Fatalf("unexpected redaction %+v", redacted)
+	}
+	if info.NodeName != "node1" || info.API.Args.Bucket != "tenant-42-logs" {
+		t.Fatal("original value must not be modified")
+	}
+
+	var nilPolicy *RedactionPolicy
+	if v, err = nilPolicy.Redact(info); err != nil || v.(LogInfo).NodeName != "node1" {
+		t.Fatal("nil policy must not redact")
+	}
+}
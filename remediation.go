@@ -0,0 +1,250 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RemediationInput is the data a RemediationEngine evaluates its rules
+// against, any of the sections may be left empty.
+type RemediationInput struct {
+	Time    time.Time
+	Health  *HealthInfo
+	Heal    *BgHealState
+	Metrics map[string]float64
+}
+
+// RemediationCondition reports whether a rule fires for the given
+// input, along with a human readable detail of the observed value.
+type RemediationCondition func(in RemediationInput) (fired bool, detail string)
+
+// RemediationAction is invoked once a rule fired for long enough.
+type RemediationAction func(ctx context.Context, alert RemediationAlert) error
+
+// RemediationRule - a named condition and the remediation to run.
+type RemediationRule struct {
+	Name      string
+	Condition RemediationCondition
+	// For is how long the condition must hold before acting.
+	For time.Duration
+	// Cooldown is the minimum time between two actions of this rule.
+	Cooldown time.Duration
+	Action   RemediationAction
+	// DryRun reports the alert without running the action.
+	DryRun bool
+}
+
+// RemediationAlert describes a rule that fired.
+type RemediationAlert struct {
+	Rule         string    `json:"rule"`
+	Detail       string    `json:"detail"`
+	FiringSince  time.Time `json:"firingSince"`
+	Time         time.Time `json:"time"`
+	DryRun       bool      `json:"dryRun"`
+	ActionError  string    `json:"actionError,omitempty"`
+	ActionCalled bool      `json:"actionCalled"`
+}
+
+type remediationRuleState struct {
+	RemediationRule
+	firingSince time.Time
+	lastAction  time.Time
+}
+
+// RemediationEngine evaluates remediation rules against successive
+// health and metrics samples.
+type RemediationEngine struct {
+	mu     sync.Mutex
+	dryRun bool
+	rules  []*remediationRuleState
+}
+
+// NewRemediationEngine - returns a new engine, when dryRun is set no
+// action of any rule is ever run.
+func NewRemediationEngine(dryRun bool, rules ...RemediationRule) (*RemediationEngine, error) {
+	e := &RemediationEngine{dryRun: dryRun}
+	for _, rule := range rules {
+		if err := e.AddRule(rule); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// AddRule - registers a new rule, rule names must be unique.
+func (e *RemediationEngine) AddRule(rule RemediationRule) error {
+	if rule.Name == "" {
+		return ErrInvalidArgument("Remediation rule name cannot be empty.")
+	}
+	if rule.Condition == nil {
+		return ErrInvalidArgument("Remediation rule " + rule.Name + " has no condition.")
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, r := range e.rules {
+		if r.Name == rule.Name {
+			return ErrInvalidArgument("Remediation rule " + rule.Name + " already exists.")
+		}
+	}
+	e.rules = append(e.rules, &remediationRuleState{RemediationRule: rule})
+	return nil
+}
+
+// Evaluate - evaluates all rules against in and runs the actions of the
+// rules that held for their configured duration and are not cooling down.
+// The returned alerts include dry-run alerts whose action was skipped.
+// Actions run after the engine is unlocked, so they may take long or
+// call into the engine.
+func (e *RemediationEngine) Evaluate(ctx context.Context, in RemediationInput) []RemediationAlert {
+	if in.Time.IsZero() {
+		in.Time = time.Now()
+	}
+
+	var alerts []RemediationAlert
+	var actions []RemediationAction
+	e.mu.Lock()
+	for _, rule := range e.rules {
+		fired, detail := rule.Condition(in)
+		if !fired {
+			rule.firingSince = time.Time{}
+			continue
+		}
+		if rule.firingSince.IsZero() {
+			rule.firingSince = in.Time
+		}
+		if in.Time.Sub(rule.firingSince) < rule.For {
+			continue
+		}
+		if !rule.lastAction.IsZero() && in.Time.Sub(rule.lastAction) < rule.Cooldown {
+			continue
+		}
+		rule.lastAction = in.Time
+
+		alert := RemediationAlert{
+			Rule:        rule.Name,
+			Detail:      detail,
+			FiringSince: rule.firingSince,
+			Time:        in.Time,
+			DryRun:      e.dryRun || rule.DryRun,
+		}
+		var action RemediationAction
+		if !alert.DryRun && rule.Action != nil {
+			alert.ActionCalled = true
+			action = rule.Action
+		}
+		alerts = append(alerts, alert)
+		actions = append(actions, action)
+	}
+	e.mu.Unlock()
+
+	for i, action := range actions {
+		if action == nil {
+			continue
+		}
+		if err := action(ctx, alerts[i]); err != nil {
+			alerts[i].ActionError = err.Error()
+		}
+	}
+	return alerts
+}
+
+// MetricAbove - fires when the named metric is above threshold.
+func MetricAbove(name string, threshold float64) RemediationCondition {
+	return func(in RemediationInput) (bool, string) {
+		v, ok := in.Metrics[name]
+		if !ok {
+			return false, ""
+		}
+		return v > threshold, fmt.Sprintf("%s is %v, threshold %v", name, v, threshold)
+	}
+}
+
+// HealBacklogAbove - fires when more than n buckets are queued for
+// healing across all healing drives.
+func HealBacklogAbove(n int) RemediationCondition {
+	return func(in RemediationInput) (bool, string) {
+		if in.Heal == nil {
+			return false, ""
+		}
+		backlog := healBacklog(*in.Heal)
+		return backlog > n, fmt.Sprintf("heal backlog is %d buckets, threshold %d", backlog, n)
+	}
+}
+
+// healBacklog - returns the number of buckets queued for healing across
+// all healing drives.
+func healBacklog(state BgHealState) int {
+	var backlog int
+	for _, set := range state.Sets {
+		for _, disk := range set.Disks {
+			if disk.HealInfo != nil {
+				backlog += len(disk.HealInfo.QueuedBuckets)
+			}
+		}
+	}
+	return backlog
+}
+
+// HealthErrorsAbove - fires when more than n nodes reported an error
+// for any of the system sections of a health report.
+func HealthErrorsAbove(n int) RemediationCondition {
+	return func(in RemediationInput) (bool, string) {
+		if in.Health == nil {
+			return false, ""
+		}
+		nodes := map[string]struct{}{}
+		sys := in.Health.Sys
+		for _, v := range sys.CPUInfo {
+			if v.Error != "" {
+				nodes[v.Addr] = struct{}{}
+			}
+		}
+		for _, v := range sys.Partitions {
+			if v.Error != "" {
+				nodes[v.Addr] = struct{}{}
+			}
+		}
+		for _, v := range sys.OSInfo {
+			if v.Error != "" {
+				nodes[v.Addr] = struct{}{}
+			}
+		}
+		for _, v := range sys.MemInfo {
+			if v.Error != "" {
+				nodes[v.Addr] = struct{}{}
+			}
+		}
+		for _, v := range sys.ProcInfo {
+			if v.Error != "" {
+				nodes[v.Addr] = struct{}{}
+			}
+		}
+		return len(nodes) > n, fmt.Sprintf("%d nodes report errors, threshold %d", len(nodes), n)
+	}
+}
+
+// RestartServiceAction - remediation restarting the MinIO cluster.
+func RestartServiceAction(adm *AdminClient) RemediationAction {
+	return func(ctx context.Context, alert RemediationAlert) error {
+		return adm.ServiceRestart(ctx)
+	}
+}
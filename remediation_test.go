@@ -0,0 +1,127 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRemediationEngine(t *testing.T) {
+	var calls int
+	e, err := NewRemediationEngine(false, RemediationRule{
+		Name:      "backlog",
+		Condition: MetricAbove("heal_backlog", 100),
+		For:       10 * time.Minute,
+		Cooldown:  time.Hour,
+		Action: func(ctx context.Context, alert RemediationAlert) error {
+			calls++
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	sample := func(minutes int, backlog float64) []RemediationAlert {
+		return e.Evaluate(context.Background(), RemediationInput{
+			Time:    start.Add(time.Duration(minutes) * time.Minute),
+			Metrics: map[string]float64{"heal_backlog": backlog},
+		})
+	}
+
+	testCases := []struct {
+		minutes int
+		backlog float64
+		alerts  int
+		calls   int
+	}{
+		{0, 200, 0, 0},   // starts firing
+		{5, 200, 0, 0},   // not long enough
+		{6, 50, 0, 0},    // resets
+		{7, 200, 0, 0},   // starts firing again
+		{17, 200, 1, 1},  // fired for 10m
+		{30, 200, 0, 1},  // cooling down
+		{78, 200, 1, 2},  // cooldown expired
+		{80, 200, 0, 2},  // cooling down
+		{200, 10, 0, 2},  // resolved
+		{201, 300, 0, 2}, // starts firing
+	}
+	for i, testCase := range testCases {
+		alerts := sample(testCase.minutes, testCase.backlog)
+		if len(alerts) != testCase.alerts {
+			t.Errorf("Test %d: expected %d alerts, got %d", i+1, testCase.alerts, len(alerts))
+		}
+		if calls != testCase.calls {
+			t.Errorf("Test %d: expected %d action calls, got %d", i+1, testCase.calls, calls)
+		}
+	}
+}
+
+func TestRemediationDryRun(t *testing.T) {
+	e, err := NewRemediationEngine(true, RemediationRule{
+		Name:      "backlog",
+		Condition: MetricAbove("heal_backlog", 100),
+		Action: func(ctx context.Context, alert RemediationAlert) error {
+			t.Fatal("action must not be called in dry-run mode")
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	alerts := e.Evaluate(context.Background(), RemediationInput{Metrics: map[string]float64{"heal_backlog": 101}})
+	if len(alerts) != 1 || !alerts[0].DryRun || alerts[0].ActionCalled {
+		t.Fatalf("unexpected alerts %v", alerts)
+	}
+
+	if err = e.AddRule(RemediationRule{Name: "backlog", Condition: MetricAbove("x", 1)}); err == nil {
+		t.Fatal("expected error for duplicate rule name")
+	}
+}
+
+func TestRemediationActionUnlocked(t *testing.T) {
+	var e *RemediationEngine
+	e, err := NewRemediationEngine(false, RemediationRule{
+		Name:      "escalate",
+		Condition: MetricAbove("errors", 0),
+		Action: func(ctx context.Context, alert RemediationAlert) error {
+			// Actions may call into the engine.
+			return e.AddRule(RemediationRule{Name: "followup", Condition: MetricAbove("errors", 10)})
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan []RemediationAlert)
+	go func() {
+		done <- e.Evaluate(context.Background(), RemediationInput{Metrics: map[string]float64{"errors": 1}})
+	}()
+	select {
+	case alerts := <-done:
+		if len(alerts) != 1 || !alerts[0].ActionCalled || alerts[0].ActionError != "" {
+			t.Errorf("unexpected alerts %+v", alerts)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("action calling into the engine deadlocked")
+	}
+	if err = e.AddRule(RemediationRule{Name: "followup", Condition: MetricAbove("errors", 10)}); err == nil {
+		t.Error("expected the rule added by the action to exist")
+	}
+}
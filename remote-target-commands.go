@@ -187,7 +187,7 @@ func (adm *AdminClient) ListRemoteTargets(ctx context.Context, bucket, arnType s
 	if err != nil {
 		return targets, err
 	}
-	if err = json.Unmarshal(b, &targets); err != nil {
+	if err = adm.unmarshalResponse(resp, b, &targets); err != nil {
 		return targets, err
 	}
 	return targets, nil
@@ -199,7 +199,7 @@ func (adm *AdminClient) SetRemoteTarget(ctx context.Context, bucket string, targ
 	if err != nil {
 		return "", err
 	}
-	encData, err := EncryptData(adm.getSecretKey(), data)
+	encData, err := adm.encryptData(data)
 	if err != nil {
 		return "", err
 	}
@@ -228,7 +228,7 @@ func (adm *AdminClient) SetRemoteTarget(ctx context.Context, bucket string, targ
 		return "", err
 	}
 	var arn string
-	if err = json.Unmarshal(b, &arn); err != nil {
+	if err = adm.unmarshalResponse(resp, b, &arn); err != nil {
 		return "", err
 	}
 	return arn, nil
@@ -289,7 +289,7 @@ func (adm *AdminClient) UpdateRemoteTarget(ctx context.Context, target *BucketTa
 	if err != nil {
 		return "", err
 	}
-	encData, err := EncryptData(adm.getSecretKey(), data)
+	encData, err := adm.encryptData(data)
 	if err != nil {
 		return "", err
 	}
@@ -336,7 +336,7 @@ func (adm *AdminClient) UpdateRemoteTarget(ctx context.Context, target *BucketTa
 		return "", err
 	}
 	var arn string
-	if err = json.Unmarshal(b, &arn); err != nil {
+	if err = adm.unmarshalResponse(resp, b, &arn); err != nil {
 		return "", err
 	}
 	return arn, nil
@@ -0,0 +1,369 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// OutputFormat - output format of Render.
+type OutputFormat string
+
+// Supported output formats.
+const (
+	FormatTable OutputFormat = "table"
+	FormatJSON  OutputFormat = "json"
+	FormatYAML  OutputFormat = "yaml"
+)
+
+// ParseOutputFormat - parses an output format name, case-insensitive.
+func ParseOutputFormat(s string) (OutputFormat, error) {
+	switch f := OutputFormat(strings.ToLower(s)); f {
+	case FormatTable, FormatJSON, FormatYAML:
+		return f, nil
+	}
+	return "", ErrInvalidArgument("Unknown output format " + s)
+}
+
+// Render - writes v in the given format. JSON and YAML follow the json
+// struct tags of v. Tables are driven by `table` struct tags:
+//
+//	`table:"HEADER"`        field is a column
+//	`table:"HEADER,bytes"`  column value is a byte size, e.g. "10 GiB"
+//	`table:",rows"`         the slice field holds the rows of the struct
+//
+// Slices render one row per element and maps one row per key, sorted,
+// with the key as first column.
+func Render(w io.Writer, format OutputFormat, v interface{}) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case FormatYAML:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		return jsonToYAML(w, data)
+	case FormatTable, "":
+		return renderTable(w, reflect.ValueOf(v))
+	}
+	return ErrInvalidArgument("Unknown output format " + string(format))
+}
+
+type tableColumn struct {
+	header string
+	index  []int
+	bytes  bool
+}
+
+// tableColumns - returns the columns of struct type t.
+func tableColumns(t reflect.Type) []tableColumn {
+	var cols []tableColumn
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup("table")
+		if !ok || tag == "-" || f.PkgPath != "" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		if parts[0] == "" {
+			continue
+		}
+		col := tableColumn{header: parts[0], index: f.Index}
+		for _, opt := range parts[1:] {
+			col.bytes = col.bytes || opt == "bytes"
+		}
+		cols = append(cols, col)
+	}
+	return cols
+}
+
+// tableRowsField - returns the field tagged `table:",rows"`.
+func tableRowsField(v reflect.Value) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("table")
+		if strings.HasPrefix(tag, ",") && strings.Contains(tag+",", ",rows,") {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func renderTable(w io.Writer, v reflect.Value) error {
+	v = indirect(v)
+	if !v.IsValid() {
+		return nil
+	}
+
+	var header []string
+	var rows [][]string
+	switch v.Kind() {
+	case reflect.Struct:
+		if rv, ok := tableRowsField(v); ok {
+			return renderTable(w, rv)
+		}
+		cols := tableColumns(v.Type())
+		if len(cols) == 0 {
+			return ErrInvalidArgument("Type " + v.Type().String() + " has no table columns.")
+		}
+		header = columnHeaders(cols, "")
+		rows = append(rows, tableRow(v, cols, ""))
+	case reflect.Slice, reflect.Array:
+		cols, scalar := elemColumns(v.Type().Elem())
+		if scalar {
+			header = []string{"VALUE"}
+		} else {
+			header = columnHeaders(cols, "")
+		}
+		for i := 0; i < v.Len(); i++ {
+			rows = append(rows, elemRow(v.Index(i), cols, scalar, ""))
+		}
+	case reflect.Map:
+		cols, scalar := elemColumns(v.Type().Elem())
+		if scalar {
+			header = []string{"NAME", "VALUE"}
+		} else {
+			header = columnHeaders(cols, "NAME")
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, k := range keys {
+			rows = append(rows, elemRow(v.MapIndex(k), cols, scalar, fmt.Sprint(k.Interface())))
+		}
+	default:
+		_, err := fmt.Fprintln(w, formatCell(v, false))
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func elemColumns(t reflect.Type) ([]tableColumn, bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == reflect.TypeOf(time.Time{}) {
+		return nil, true
+	}
+	return tableColumns(t), false
+}
+
+func columnHeaders(cols []tableColumn, key string) []string {
+	var header []string
+	if key != "" {
+		header = append(header, key)
+	}
+	for _, c := range cols {
+		header = append(header, c.header)
+	}
+	return header
+}
+
+func elemRow(v reflect.Value, cols []tableColumn, scalar bool, key string) []string {
+	if scalar {
+		if key != "" {
+			return []string{key, formatCell(v, false)}
+		}
+		return []string{formatCell(v, false)}
+	}
+	return tableRow(indirect(v), cols, key)
+}
+
+func tableRow(v reflect.Value, cols []tableColumn, key string) []string {
+	var row []string
+	if key != "" {
+		row = append(row, key)
+	}
+	for _, c := range cols {
+		if !v.IsValid() {
+			row = append(row, "")
+			continue
+		}
+		row = append(row, formatCell(v.FieldByIndex(c.index), c.bytes))
+	}
+	return row
+}
+
+func formatCell(v reflect.Value, bytes bool) string {
+	v = indirect(v)
+	if !v.IsValid() {
+		return ""
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		if t.IsZero() {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if bytes {
+			return formatBytes(float64(v.Int()))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if bytes {
+			return formatBytes(float64(v.Uint()))
+		}
+	case reflect.Float32, reflect.Float64:
+		if bytes {
+			return formatBytes(v.Float())
+		}
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Slice, reflect.Array:
+		cells := make([]string, v.Len())
+		for i := range cells {
+			cells[i] = formatCell(v.Index(i), bytes)
+		}
+		return strings.Join(cells, ",")
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// formatBytes - formats n bytes with IEC units, e.g. "10 GiB".
+func formatBytes(n float64) string {
+	const unit = 1024
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	i := 0
+	for ; (n >= unit || n <= -unit) && i < len(units)-1; i++ {
+		n /= unit
+	}
+	if i == 0 {
+		return strconv.FormatFloat(n, 'f', -1, 64) + " " + units[i]
+	}
+	return strings.TrimSuffix(strconv.FormatFloat(n, 'f', 1, 64), ".0") + " " + units[i]
+}
+
+// jsonToYAML - converts a JSON document to block style YAML, keeping
+// the key order of the document.
+func jsonToYAML(w io.Writer, data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var buf bytes.Buffer
+	if err := yamlValue(&buf, dec, 0, false); err != nil {
+		return err
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// yamlValue - writes the next JSON value of dec, inline is set if the
+// value follows a "key:" or "- " on the current line.
+func yamlValue(buf *bytes.Buffer, dec *json.Decoder, indent int, inline bool) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	pad := strings.Repeat("  ", indent)
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			if !dec.More() {
+				dec.Token()
+				buf.WriteString(" {}\n")
+				return nil
+			}
+			if inline {
+				buf.WriteString("\n")
+			}
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				buf.WriteString(pad + yamlString(fmt.Sprint(key)) + ":")
+				if err = yamlValue(buf, dec, indent+1, true); err != nil {
+					return err
+				}
+			}
+		case '[':
+			if !dec.More() {
+				dec.Token()
+				buf.WriteString(" []\n")
+				return nil
+			}
+			if inline {
+				buf.WriteString("\n")
+			}
+			for dec.More() {
+				buf.WriteString(pad + "-")
+				if err = yamlValue(buf, dec, indent+1, true); err != nil {
+					return err
+				}
+			}
+		}
+		_, err = dec.Token() // closing delimiter
+		return err
+	case string:
+		buf.WriteString(" " + yamlString(t) + "\n")
+	case json.Number:
+		buf.WriteString(" " + t.String() + "\n")
+	case bool:
+		buf.WriteString(" " + strconv.FormatBool(t) + "\n")
+	case nil:
+		buf.WriteString(" null\n")
+	}
+	return nil
+}
+
+// yamlString - quotes s if it would not be read back as the same string.
+func yamlString(s string) string {
+	if s == "" {
+		return `""`
+	}
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~":
+		return strconv.Quote(s)
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return strconv.Quote(s)
+	}
+	if strings.ContainsAny(s, ":#{}[],&*!|>'\"%@`\n\t") || s[0] == '-' || s[0] == '?' ||
+		s[0] == ' ' || s[len(s)-1] == ' ' {
+		return strconv.Quote(s)
+	}
+	return s
+}
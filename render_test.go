@@ -0,0 +1,94 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestRender(t *testing.T) {
+	testCases := []struct {
+		format   OutputFormat
+		v        interface{}
+		expected string
+	}{
+		{
+			format: FormatTable,
+			v: map[string]UserInfo{
+				"bob":   {Status: AccountDisabled},
+				"alice": {PolicyName: "readwrite", Status: AccountEnabled, MemberOf: []string{"dev", "ops"}},
+			},
+			expected: "NAME   POLICY     STATUS    GROUPS\n" +
+				"alice  readwrite  enabled   dev,ops\n" +
+				"bob               disabled  \n",
+		},
+		{
+			format: FormatTable,
+			v: StorageInfo{Disks: []Disk{
+				{Endpoint: "http://node1:9000/data", State: "ok", TotalSpace: 10 << 30, UsedSpace: 1536, AvailableSpace: 512},
+			}},
+			expected: "ENDPOINT                PATH  HEALING  STATE  TOTAL   USED     AVAILABLE\n" +
+				"http://node1:9000/data        false    ok     10 GiB  1.5 KiB  512 B\n",
+		},
+		{
+			format: FormatTable,
+			v: HealTaskStatus{
+				Summary:   "finished",
+				StartTime: time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC),
+				Items:     []HealResultItem{{Type: HealItemObject, Bucket: "photos", Object: "a.jpg"}},
+			},
+			expected: "SUMMARY   DETAIL  STARTED\n" +
+				"finished          2021-05-01T10:00:00Z\n",
+		},
+		{
+			format: FormatTable,
+			v: []HealResultItem{
+				{Type: HealItemBucket, Bucket: "photos"},
+				{Type: HealItemObject, Bucket: "photos", Object: "a.jpg", VersionID: "v1", ObjectSize: 2048},
+			},
+			expected: "TYPE    BUCKET  OBJECT  VERSION  DETAIL  SIZE\n" +
+				"bucket  photos                           0 B\n" +
+				"object  photos  a.jpg   v1               2 KiB\n",
+		},
+		{
+			format:   FormatYAML,
+			v:        UserInfo{PolicyName: "readwrite", Status: AccountEnabled, MemberOf: []string{"dev", "true"}},
+			expected: "policyName: readwrite\nstatus: enabled\nmemberOf:\n  - dev\n  - \"true\"\n",
+		},
+		{
+			format:   FormatYAML,
+			v:        []SetStatus{{ID: "0-1", Disks: []Disk{}}},
+			expected: "-\n  id: 0-1\n  pool_index: 0\n  set_index: 0\n  heal_status: \"\"\n  heal_priority: \"\"\n  disks: []\n",
+		},
+	}
+
+	for i, testCase := range testCases {
+		var buf bytes.Buffer
+		if err := Render(&buf, testCase.format, testCase.v); err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+		if buf.String() != testCase.expected {
+			t.Errorf("case %d: expected\n%q\ngot\n%q", i, testCase.expected, buf.String())
+		}
+	}
+
+	if _, err := ParseOutputFormat("xml"); err == nil {
+		t.Error("expected unknown format to be rejected")
+	}
+}
@@ -0,0 +1,130 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"errors"
+	"io"
+	"net/http"
+)
+
+// ErrResponseTooLarge is matched by errors.Is for every
+// ResponseTooLargeError.
+var ErrResponseTooLarge = errors.New("response exceeds the maximum size")
+
+// ResponseTooLargeError - returned when the response of an endpoint
+// with an unbounded payload exceeds the limit set with
+// SetMaxResponseSize.
+type ResponseTooLargeError struct {
+	Endpoint string
+	Limit    ByteSize
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return "response of " + e.Endpoint + " exceeds the maximum size of " + e.Limit.String()
+}
+
+// Is - reports whether target is ErrResponseTooLarge.
+func (e *ResponseTooLargeError) Is(target error) bool {
+	return target == ErrResponseTooLarge
+}
+
+// SetMaxResponseSize - limits the responses of endpoints that may
+// return unbounded payloads, i.e. health info, profiling data and logs.
+// Responses announcing a larger Content-Length are rejected right away,
+// others are aborted once the limit is read. Long-lived streams like
+// logs and speedtest results are limited per message instead. Zero
+// removes the limit.
+func (adm *AdminClient) SetMaxResponseSize(limit ByteSize) {
+	adm.maxResponseSize = limit
+}
+
+// limitedResponseBody - fails reads past the limit and closes the
+// underlying body.
+type limitedResponseBody struct {
+	io.ReadCloser
+	remaining int64
+	tooLarge  error
+	err       error
+	// message is the limit of every newline delimited message, if set.
+	message int64
+}
+
+func (r *limitedResponseBody) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	if r.message > 0 {
+		return r.readMessages(p)
+	}
+	// Read one byte past the limit to detect oversized responses.
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+	n, err := r.ReadCloser.Read(p)
+	if int64(n) > r.remaining {
+		n = int(r.remaining)
+		r.remaining = 0
+		r.ReadCloser.Close()
+		r.err = r.tooLarge
+		return n, r.err
+	}
+	r.remaining -= int64(n)
+	return n, err
+}
+
+// readMessages - reads p, failing once a message exceeds its limit.
+func (r *limitedResponseBody) readMessages(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	for i, b := range p[:n] {
+		if b == '\n' {
+			r.remaining = r.message
+			continue
+		}
+		if r.remaining == 0 {
+			r.ReadCloser.Close()
+			r.err = r.tooLarge
+			return i, r.err
+		}
+		r.remaining--
+	}
+	return n, err
+}
+
+// limitResponse - applies the maximum response size to resp if the
+// request is marked as having an unbounded payload.
+func (adm AdminClient) limitResponse(reqData requestData, resp *http.Response) error {
+	if !reqData.unboundedResponse || adm.maxResponseSize == 0 || resp.Body == nil {
+		return nil
+	}
+	tooLarge := &ResponseTooLargeError{Endpoint: statsEndpoint(reqData.relPath), Limit: adm.maxResponseSize}
+	if reqData.messageStream {
+		resp.Body = &limitedResponseBody{
+			ReadCloser: resp.Body,
+			remaining:  int64(adm.maxResponseSize),
+			tooLarge:   tooLarge,
+			message:    int64(adm.maxResponseSize),
+		}
+		return nil
+	}
+	if resp.ContentLength > int64(adm.maxResponseSize) {
+		closeResponse(resp)
+		return tooLarge
+	}
+	resp.Body = &limitedResponseBody{ReadCloser: resp.Body, remaining: int64(adm.maxResponseSize), tooLarge: tooLarge}
+	return nil
+}
@@ -0,0 +1,140 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestMaxResponseSize(t *testing.T) {
+	payload := bytes.Repeat([]byte("x"), 100)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("chunked") == "" {
+			w.Header().Set("Content-Length", "100")
+		}
+		w.Write(payload[:50])
+		w.(http.Flusher).Flush()
+		w.Write(payload[50:])
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Without a limit the whole payload is returned.
+	body, err := adm.DownloadProfilingData(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(body)
+	body.Close()
+	if err != nil || len(data) != 100 {
+		t.Fatalf("expected 100 bytes, got %d: %v", len(data), err)
+	}
+
+	// Announced sizes above the limit are rejected right away.
+	adm.SetMaxResponseSize(64)
+	if _, err = adm.DownloadProfilingData(context.Background()); !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge, got %v", err)
+	}
+	var tooLarge *ResponseTooLargeError
+	if !errors.As(err, &tooLarge) || tooLarge.Endpoint != "/profiling/download" || tooLarge.Limit != 64 {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
+	// Streamed responses are aborted once the limit is read.
+	resp, err := adm.executeMethod(context.Background(), http.MethodGet, requestData{
+		relPath:           adminAPIPrefix + "/profiling/download",
+		queryValues:       url.Values{"chunked": []string{"true"}},
+		unboundedResponse: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = ioutil.ReadAll(resp.Body)
+	closeResponse(resp)
+	if !errors.Is(err, ErrResponseTooLarge) || len(data) != 64 {
+		t.Fatalf("expected 64 bytes and ErrResponseTooLarge, got %d: %v", len(data), err)
+	}
+
+	// Streams are limited per message.
+	resp, err = adm.executeMethod(context.Background(), http.MethodGet, requestData{
+		relPath:           adminAPIPrefix + "/log",
+		queryValues:       url.Values{"chunked": []string{"true"}},
+		unboundedResponse: true,
+		messageStream:     true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = ioutil.ReadAll(resp.Body)
+	closeResponse(resp)
+	if !errors.Is(err, ErrResponseTooLarge) || len(data) != 64 {
+		t.Fatalf("expected 64 bytes and ErrResponseTooLarge, got %d: %v", len(data), err)
+	}
+
+	// Bounded endpoints are not limited.
+	resp, err = adm.executeMethod(context.Background(), http.MethodGet, requestData{relPath: adminAPIPrefix + "/info"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = ioutil.ReadAll(resp.Body)
+	closeResponse(resp)
+	if err != nil || len(data) != 100 {
+		t.Fatalf("expected 100 bytes, got %d: %v", len(data), err)
+	}
+}
+
+func TestMaxResponseSizeLogStream(t *testing.T) {
+	line := `{"ConsoleMsg":"` + strings.Repeat("x", 40) + `"}` + "\n"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Far more than the limit in total, but every message fits.
+		for i := 0; i < 100; i++ {
+			io.WriteString(w, line)
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm.SetMaxResponseSize(ByteSize(len(line)))
+
+	s := adm.LogStream(context.Background(), "", 10, "")
+	defer s.Close()
+	var n int
+	for n < 100 && s.Next() {
+		n++
+	}
+	if n != 100 || s.Err() != nil {
+		t.Fatalf("expected 100 messages, got %d: %v", n, s.Err())
+	}
+}
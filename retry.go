@@ -18,9 +18,12 @@ package madmin
 
 import (
 	"context"
+	"errors"
 	"math/rand"
 	"net/http"
+	"strconv"
 	"sync"
+	"syscall"
 	"time"
 )
 
@@ -63,53 +66,133 @@ func (r *lockedRandSource) Seed(seed int64) {
 	r.lk.Unlock()
 }
 
-// newRetryTimer creates a timer with exponentially increasing
-// delays until the maximum retry attempts are reached.
-func (adm AdminClient) newRetryTimer(ctx context.Context, maxRetry int, unit time.Duration, cap time.Duration, jitter float64) <-chan int {
-	attemptCh := make(chan int)
-
-	// computes the exponential backoff duration according to
-	// https://www.awsarchitectureblog.com/2015/03/backoff.html
-	exponentialBackoffWait := func(attempt int) time.Duration {
-		// normalize jitter to the range [0, 1.0]
-		if jitter < NoJitter {
-			jitter = NoJitter
-		}
-		if jitter > MaxJitter {
-			jitter = MaxJitter
+// RetryPolicy - how the client retries requests failing with network
+// errors or retryable error responses. Zero fields are the defaults.
+type RetryPolicy struct {
+	// MaxRetry is the maximum number of attempts, MaxRetry by default,
+	// 1 disables retries.
+	MaxRetry int
+	// Unit and Cap bound the exponential backoff, the n-th retry waits
+	// up to Unit * 2^n but never longer than Cap.
+	Unit time.Duration
+	Cap  time.Duration
+	// DisableJitter waits the full backoff instead of a random share.
+	DisableJitter bool
+	// RetryableStatusCodes replace the default retryable statuses, 408,
+	// 429, 500, 502, 503 and 504, and the retryable S3 error codes
+	// like SlowDown.
+	RetryableStatusCodes []int
+	// RetryableError decides whether a network error is retried, by
+	// default all but refused connections are.
+	RetryableError func(err error) bool
+}
+
+// SetRetryPolicy - sets how the client retries failed requests.
+// WithMaxRetry overrides MaxRetry of the policy for single calls.
+func (adm *AdminClient) SetRetryPolicy(policy RetryPolicy) {
+	adm.retryPolicy = policy
+}
+
+// retryPolicyFor - returns the policy of a request, the policy of its
+// requestData, if set, or of the client with the overrides of ctx.
+func (adm AdminClient) retryPolicyFor(ctx context.Context, reqData requestData) RetryPolicy {
+	policy := adm.retryPolicy
+	if maxRetry := callOptionsFrom(ctx).maxRetry; maxRetry > 0 {
+		policy.MaxRetry = maxRetry
+	}
+	if reqData.retryPolicy != nil {
+		policy = *reqData.retryPolicy
+	}
+	if policy.MaxRetry <= 0 {
+		policy.MaxRetry = MaxRetry
+	}
+	if policy.Unit <= 0 {
+		policy.Unit = DefaultRetryUnit
+	}
+	if policy.Cap <= 0 {
+		policy.Cap = DefaultRetryCap
+	}
+	return policy
+}
+
+// retryableError - returns true if the network error err is retried.
+func (p RetryPolicy) retryableError(err error) bool {
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return false
+	}
+	if p.RetryableError != nil {
+		return p.RetryableError(err)
+	}
+	return !errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// retryableResponse - returns true if error responses with status and
+// S3 error code are retried.
+func (p RetryPolicy) retryableResponse(status int, code string) bool {
+	if p.RetryableStatusCodes == nil {
+		return isS3CodeRetryable(code) || isHTTPStatusRetryable(status)
+	}
+	for _, s := range p.RetryableStatusCodes {
+		if s == status {
+			return true
 		}
+	}
+	return false
+}
 
-		//sleep = random_between(0, min(cap, base * 2 ** attempt))
-		sleep := unit * 1 << uint(attempt)
-		if sleep > cap {
-			sleep = cap
+// backoff - returns the wait before retry attempt, starting at 0,
+// computed according to
+// https://www.awsarchitectureblog.com/2015/03/backoff.html
+func (p RetryPolicy) backoff(random *rand.Rand, attempt int) time.Duration {
+	//sleep = random_between(0, min(cap, base * 2 ** attempt))
+	sleep := p.Cap
+	if attempt < 32 {
+		if s := p.Unit * 1 << uint(attempt); s > 0 && s < p.Cap {
+			sleep = s
 		}
-		if jitter > NoJitter {
-			sleep -= time.Duration(adm.random.Float64() * float64(sleep) * jitter)
+	}
+	if !p.DisableJitter {
+		sleep -= time.Duration(random.Float64() * float64(sleep) * MaxJitter)
+	}
+	return sleep
+}
+
+// wait - waits before retry attempt, at least for the Retry-After of
+// the previous response but no longer than Cap.
+func (p RetryPolicy) wait(ctx context.Context, random *rand.Rand, attempt int, retryAfter time.Duration) error {
+	sleep := p.backoff(random, attempt)
+	if retryAfter > sleep {
+		sleep = retryAfter
+		if sleep > p.Cap {
+			sleep = p.Cap
 		}
-		return sleep
-	}
-
-	go func() {
-		defer close(attemptCh)
-		for i := 0; i < maxRetry; i++ {
-			// Attempts start from 1.
-			select {
-			case attemptCh <- i + 1:
-			case <-ctx.Done():
-				// Stop the routine.
-				return
-			}
-
-			select {
-			case <-time.After(exponentialBackoffWait(i)):
-			case <-ctx.Done():
-				// Stop the routine.
-				return
-			}
+	}
+	timer := time.NewTimer(sleep)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// parseRetryAfter - parses a Retry-After header, in seconds or an HTTP
+// date, zero if absent or invalid.
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0
 		}
-	}()
-	return attemptCh
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
 }
 
 // List of AWS S3 error codes which are retryable.
@@ -138,6 +221,7 @@ var retryableHTTPStatusCodes = map[int]struct{}{
 	http.StatusInternalServerError: {},
 	http.StatusBadGateway:          {},
 	http.StatusServiceUnavailable:  {},
+	http.StatusGatewayTimeout:      {},
 	// Add more HTTP status codes here.
 }
 
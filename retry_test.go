@@ -0,0 +1,131 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		switch r.URL.Query().Get("fail") {
+		case "teapot":
+			w.WriteHeader(http.StatusTeapot)
+			return
+		case "slowdown":
+			if n < 3 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Write([]byte(`{"Code":"SlowDown","Message":"Please reduce your request rate."}`))
+				return
+			}
+		case "busy":
+			if n < 3 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm.SetRetryPolicy(RetryPolicy{
+		MaxRetry:             3,
+		Unit:                 time.Millisecond,
+		Cap:                  200 * time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable, http.StatusTeapot},
+	})
+	call := func(ctx context.Context, fail string, policy *RetryPolicy) (int, error) {
+		atomic.StoreInt32(&calls, 0)
+		resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{
+			relPath:     adminAPIPrefix + "/retry",
+			queryValues: url.Values{"fail": []string{fail}},
+			retryPolicy: policy,
+		})
+		closeResponse(resp)
+		return int(atomic.LoadInt32(&calls)), err
+	}
+
+	start := time.Now()
+	if n, err := call(context.Background(), "busy", nil); err != nil || n != 3 {
+		t.Fatalf("expected success on the third attempt, got %d attempts: %v", n, err)
+	}
+	// Retry-After of 1s is bounded by the cap of 200ms.
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond || elapsed > 2*time.Second {
+		t.Errorf("expected two waits of the capped Retry-After, took %v", elapsed)
+	}
+
+	if n, _ := call(context.Background(), "teapot", nil); n != 3 {
+		t.Errorf("expected 3 attempts for a retryable status, got %d", n)
+	}
+	if n, _ := call(WithMaxRetry(context.Background(), 2), "teapot", nil); n != 2 {
+		t.Errorf("expected 2 attempts with WithMaxRetry, got %d", n)
+	}
+	if n, _ := call(context.Background(), "teapot", &RetryPolicy{MaxRetry: 1}); n != 1 {
+		t.Errorf("expected a single attempt with the request policy, got %d", n)
+	}
+	if n, _ := call(context.Background(), "slowdown", &RetryPolicy{MaxRetry: 3, RetryableStatusCodes: []int{http.StatusTeapot}}); n != 1 {
+		t.Errorf("expected no retries of an S3 error code with custom statuses, got %d", n)
+	}
+	adm.SetRetryPolicy(RetryPolicy{MaxRetry: 3, Unit: time.Millisecond})
+	if n, _ := call(context.Background(), "teapot", nil); n != 1 {
+		t.Errorf("expected no retries of a status which is not retryable by default, got %d", n)
+	}
+
+	// Retryable S3 error codes wait for Retry-After as well.
+	adm.SetRetryPolicy(RetryPolicy{MaxRetry: 3, Unit: time.Millisecond, Cap: 200 * time.Millisecond})
+	start = time.Now()
+	if n, err := call(context.Background(), "slowdown", nil); err != nil || n != 3 {
+		t.Fatalf("expected success on the third attempt, got %d attempts: %v", n, err)
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond || elapsed > 2*time.Second {
+		t.Errorf("expected two waits of the capped Retry-After, took %v", elapsed)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		header   string
+		expected time.Duration
+	}{
+		{"", 0},
+		{"5", 5 * time.Second},
+		{"-1", 0},
+		{"Fri, 01 Oct 2021 12:00:30 GMT", 30 * time.Second},
+		{"Fri, 01 Oct 2021 11:00:00 GMT", 0},
+		{"soon", 0},
+	} {
+		if got := parseRetryAfter(test.header, now); got != test.expected {
+			t.Errorf("expected %v for %q, got %v", test.expected, test.header, got)
+		}
+	}
+}
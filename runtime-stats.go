@@ -0,0 +1,157 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"runtime"
+	"time"
+)
+
+// RuntimeHeapStats - heap statistics of the Go runtime.
+type RuntimeHeapStats struct {
+	Alloc      ByteSize `json:"alloc"`
+	TotalAlloc ByteSize `json:"totalAlloc"`
+	Sys        ByteSize `json:"sys"`
+	Idle       ByteSize `json:"idle"`
+	InUse      ByteSize `json:"inUse"`
+	Released   ByteSize `json:"released"`
+	Objects    uint64   `json:"objects"`
+	Mallocs    uint64   `json:"mallocs"`
+	Frees      uint64   `json:"frees"`
+	// NextGC is the heap size the next collection is triggered at.
+	NextGC ByteSize `json:"nextGC"`
+}
+
+// RuntimeGCStats - garbage collector statistics of the Go runtime.
+type RuntimeGCStats struct {
+	NumGC       uint32    `json:"numGC"`
+	NumForcedGC uint32    `json:"numForcedGC"`
+	LastGC      time.Time `json:"lastGC"`
+	PauseTotal  Duration  `json:"pauseTotal"`
+	// RecentPauses of the last collections, most recent first.
+	RecentPauses []Duration `json:"recentPauses,omitempty"`
+	// CPUFraction of the available CPU time used by the collector
+	// since the process started.
+	CPUFraction float64 `json:"cpuFraction"`
+}
+
+// RuntimeLatency - percentiles of a latency distribution.
+type RuntimeLatency struct {
+	P50 Duration `json:"p50"`
+	P90 Duration `json:"p90"`
+	P99 Duration `json:"p99"`
+	Max Duration `json:"max"`
+}
+
+// RuntimeStats - Go runtime metrics of a server process, complementing
+// the process level ProcInfo.
+type RuntimeStats struct {
+	Addr  string `json:"addr"`
+	Error string `json:"error,omitempty"`
+
+	CollectedAt time.Time `json:"collectedAt"`
+	GoVersion   string    `json:"goVersion"`
+	GOMAXPROCS  int       `json:"gomaxprocs"`
+	NumCPU      int       `json:"numCPU"`
+	Goroutines  int       `json:"goroutines"`
+	CgoCalls    int64     `json:"cgoCalls"`
+
+	Heap RuntimeHeapStats `json:"heap"`
+	GC   RuntimeGCStats   `json:"gc"`
+	// SchedLatency is the time goroutines spent runnable before
+	// running, empty if the Go version of the server does not
+	// report it.
+	SchedLatency RuntimeLatency `json:"schedLatency"`
+}
+
+// maxRecentGCPauses is the number of recent GC pauses reported.
+const maxRecentGCPauses = 16
+
+// GetRuntimeStats returns the Go runtime metrics of the current process,
+// servers call it to answer GetRuntimeStats requests.
+func GetRuntimeStats(addr string) RuntimeStats {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+
+	stats := RuntimeStats{
+		Addr:        addr,
+		CollectedAt: time.Now().UTC(),
+		GoVersion:   runtime.Version(),
+		GOMAXPROCS:  runtime.GOMAXPROCS(0),
+		NumCPU:      runtime.NumCPU(),
+		Goroutines:  runtime.NumGoroutine(),
+		CgoCalls:    runtime.NumCgoCall(),
+		Heap: RuntimeHeapStats{
+			Alloc:      ByteSize(ms.HeapAlloc),
+			TotalAlloc: ByteSize(ms.TotalAlloc),
+			Sys:        ByteSize(ms.HeapSys),
+			Idle:       ByteSize(ms.HeapIdle),
+			InUse:      ByteSize(ms.HeapInuse),
+			Released:   ByteSize(ms.HeapReleased),
+			Objects:    ms.HeapObjects,
+			Mallocs:    ms.Mallocs,
+			Frees:      ms.Frees,
+			NextGC:     ByteSize(ms.NextGC),
+		},
+		GC: RuntimeGCStats{
+			NumGC:       ms.NumGC,
+			NumForcedGC: ms.NumForcedGC,
+			PauseTotal:  Duration(ms.PauseTotalNs),
+			CPUFraction: ms.GCCPUFraction,
+		},
+	}
+	if ms.LastGC > 0 {
+		stats.GC.LastGC = time.Unix(0, int64(ms.LastGC)).UTC()
+	}
+	// PauseNs is a circular buffer, the latest pause is at (NumGC+255)%256.
+	for i := uint32(0); i < ms.NumGC && i < maxRecentGCPauses; i++ {
+		stats.GC.RecentPauses = append(stats.GC.RecentPauses, Duration(ms.PauseNs[(ms.NumGC-1-i)%uint32(len(ms.PauseNs))]))
+	}
+
+	stats.SchedLatency = readSchedLatency()
+	return stats
+}
+
+// GetRuntimeStats - returns the Go runtime metrics of a server node,
+// the node serving the request if node is empty.
+func (adm *AdminClient) GetRuntimeStats(ctx context.Context, node string) (RuntimeStats, error) {
+	v := url.Values{}
+	if node != "" {
+		v.Set("node", node)
+	}
+	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{
+		relPath:     adminAPIPrefix + "/runtime-stats",
+		queryValues: v,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return RuntimeStats{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return RuntimeStats{}, httpRespToErrorResponse(resp)
+	}
+
+	var stats RuntimeStats
+	if err = adm.decodeResponse(resp, &stats); err != nil {
+		return RuntimeStats{}, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,75 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//go:build go1.17
+// +build go1.17
+
+package madmin
+
+import (
+	"math"
+	"runtime/metrics"
+	"time"
+)
+
+// readSchedLatency - returns the scheduling latency percentiles of the
+// current process, /sched/latencies:seconds exists from Go 1.17 on.
+func readSchedLatency() RuntimeLatency {
+	sample := []metrics.Sample{{Name: "/sched/latencies:seconds"}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindFloat64Histogram {
+		return RuntimeLatency{}
+	}
+	return histogramLatency(sample[0].Value.Float64Histogram())
+}
+
+// histogramLatency - returns the percentiles of a histogram in seconds,
+// using the upper bound of the bucket a percentile falls in.
+func histogramLatency(h *metrics.Float64Histogram) RuntimeLatency {
+	var total uint64
+	for _, c := range h.Counts {
+		total += c
+	}
+	if total == 0 {
+		return RuntimeLatency{}
+	}
+	bound := func(i int) Duration {
+		b := h.Buckets[i+1]
+		if math.IsInf(b, 1) {
+			b = h.Buckets[i]
+		}
+		return Duration(b * float64(time.Second))
+	}
+	percentile := func(p float64) Duration {
+		rank := uint64(math.Ceil(p * float64(total)))
+		var seen uint64
+		for i, c := range h.Counts {
+			if seen += c; seen >= rank {
+				return bound(i)
+			}
+		}
+		return 0
+	}
+	var latency RuntimeLatency
+	latency.P50, latency.P90, latency.P99 = percentile(0.5), percentile(0.9), percentile(0.99)
+	for i := len(h.Counts) - 1; i >= 0; i-- {
+		if h.Counts[i] > 0 {
+			latency.Max = bound(i)
+			break
+		}
+	}
+	return latency
+}
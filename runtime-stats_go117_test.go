@@ -0,0 +1,63 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//go:build go1.17
+// +build go1.17
+
+package madmin
+
+import (
+	"math"
+	"runtime"
+	"runtime/metrics"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHistogramLatency(t *testing.T) {
+	h := &metrics.Float64Histogram{
+		Counts:  []uint64{50, 40, 9, 1},
+		Buckets: []float64{0, 0.001, 0.01, 0.1, math.Inf(1)},
+	}
+	latency := histogramLatency(h)
+	expected := RuntimeLatency{
+		P50: Duration(time.Millisecond),
+		P90: Duration(10 * time.Millisecond),
+		P99: Duration(100 * time.Millisecond),
+		Max: Duration(100 * time.Millisecond),
+	}
+	if latency != expected {
+		t.Fatalf("expected %+v, got %+v", expected, latency)
+	}
+}
+
+func TestReadSchedLatency(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			time.Sleep(time.Millisecond)
+		}()
+	}
+	wg.Wait()
+
+	latency := GetRuntimeStats("").SchedLatency
+	if latency.Max == 0 || latency.P50 > latency.Max {
+		t.Fatalf("expected scheduling latencies on %s, got %+v", runtime.Version(), latency)
+	}
+}
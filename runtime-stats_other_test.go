@@ -0,0 +1,31 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//go:build !go1.17
+// +build !go1.17
+
+package madmin
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestReadSchedLatency(t *testing.T) {
+	if latency := GetRuntimeStats("").SchedLatency; latency != (RuntimeLatency{}) {
+		t.Fatalf("expected no scheduling latencies on %s, got %+v", runtime.Version(), latency)
+	}
+}
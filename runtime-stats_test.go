@@ -0,0 +1,54 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"runtime"
+	"testing"
+)
+
+func TestGetRuntimeStats(t *testing.T) {
+	runtime.GC()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("node") != "node1:9000" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		json.NewEncoder(w).Encode(GetRuntimeStats("node1:9000"))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm.readOnly = true
+	stats, err := adm.GetRuntimeStats(context.Background(), "node1:9000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Addr != "node1:9000" || stats.GoVersion != runtime.Version() || stats.Goroutines == 0 ||
+		stats.Heap.Alloc == 0 || stats.GC.NumGC == 0 || len(stats.GC.RecentPauses) == 0 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
@@ -0,0 +1,205 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Rules of SecretFinding.
+const (
+	SecretRuleAWSAccessKey   = "aws-access-key"
+	SecretRulePrivateKey     = "private-key"
+	SecretRuleJWT            = "jwt"
+	SecretRuleURLCredentials = "url-credentials"
+	SecretRuleSlackToken     = "slack-token"
+	SecretRuleHighEntropy    = "high-entropy"
+)
+
+var (
+	secretPatterns = []struct {
+		rule string
+		re   *regexp.Regexp
+	}{
+		{SecretRuleAWSAccessKey, regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+		{SecretRulePrivateKey, regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)},
+		{SecretRuleJWT, regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`)},
+		{SecretRuleURLCredentials, regexp.MustCompile(`\b[a-zA-Z][a-zA-Z0-9+.-]*://[^/\s:@]+:[^/\s@]+@`)},
+		{SecretRuleSlackToken, regexp.MustCompile(`\bxox[abprs]-[0-9A-Za-z-]{10,}`)},
+	}
+
+	// secretKeyRegex matches names of keys expected to hold secrets,
+	// their values are not reported.
+	secretKeyRegex = regexp.MustCompile(`(?i)secret|password|passwd|token|credential|private|(^|_)key$|api_?key`)
+
+	secretTokenRegex = regexp.MustCompile(`[A-Za-z0-9+/=_-]{20,}`)
+	configKVRegex    = regexp.MustCompile(`(\w+)=("[^"]*"|\S*)`)
+)
+
+// SecretFinding - a likely credential outside of a key meant for
+// secrets.
+type SecretFinding struct {
+	// Location is "line N" for configurations and environments and a
+	// JSON path like "$.minio.config" for health reports.
+	Location string `json:"location"`
+	Key      string `json:"key,omitempty"`
+	Rule     string `json:"rule"`
+	// Match is the masked secret.
+	Match string `json:"match"`
+}
+
+// maskSecret - keeps the first characters of a secret for reference.
+func maskSecret(s string) string {
+	if len(s) <= 8 {
+		return "****"
+	}
+	return s[:4] + "****"
+}
+
+// shannonEntropy - returns the entropy of s in bits per character.
+func shannonEntropy(s string) float64 {
+	counts := make(map[rune]int)
+	for _, r := range s {
+		counts[r]++
+	}
+	var entropy float64
+	n := float64(len(s))
+	for _, c := range counts {
+		p := float64(c) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// looksRandom - returns true for tokens like generated keys. Hex
+// strings like commit IDs and checksums are not reported.
+func looksRandom(token string) bool {
+	var upper, lower, digit bool
+	for _, r := range token {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= '0' && r <= '9':
+			digit = true
+		}
+	}
+	return upper && lower && digit && shannonEntropy(token) >= 4
+}
+
+// scanSecrets - returns the findings in a value of key.
+func scanSecrets(location, key, value string) []SecretFinding {
+	if key != "" && secretKeyRegex.MatchString(key) {
+		return nil
+	}
+	var findings []SecretFinding
+	matched := make(map[string]struct{})
+	for _, p := range secretPatterns {
+		for _, m := range p.re.FindAllString(value, -1) {
+			matched[m] = struct{}{}
+			findings = append(findings, SecretFinding{Location: location, Key: key, Rule: p.rule, Match: maskSecret(m)})
+		}
+	}
+	for _, token := range secretTokenRegex.FindAllString(value, -1) {
+		if _, ok := matched[token]; ok || !looksRandom(token) {
+			continue
+		}
+		reported := false
+		for m := range matched {
+			reported = reported || strings.Contains(m, token) || strings.Contains(token, m)
+		}
+		if !reported {
+			findings = append(findings, SecretFinding{Location: location, Key: key, Rule: SecretRuleHighEntropy, Match: maskSecret(token)})
+		}
+	}
+	return findings
+}
+
+// ScanConfigSecrets - scans a server configuration, as returned by
+// GetConfig, for credentials in keys not meant for secrets and in
+// comments.
+func ScanConfigSecrets(config []byte) []SecretFinding {
+	var findings []SecretFinding
+	scanner := bufio.NewScanner(bytes.NewReader(config))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		location := "line " + strconv.Itoa(n)
+		if strings.HasPrefix(line, KvComment) {
+			findings = append(findings, scanSecrets(location, "", line)...)
+			continue
+		}
+		for _, kv := range configKVRegex.FindAllStringSubmatch(line, -1) {
+			findings = append(findings, scanSecrets(location, kv[1], strings.Trim(kv[2], `"`))...)
+		}
+	}
+	return findings
+}
+
+// ScanEnvSecrets - scans an environment capture of KEY=VALUE entries,
+// like os.Environ, for credentials in variables not meant for secrets.
+func ScanEnvSecrets(env []string) []SecretFinding {
+	var findings []SecretFinding
+	for i, entry := range env {
+		kv := strings.SplitN(entry, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		findings = append(findings, scanSecrets("line "+strconv.Itoa(i+1), kv[0], kv[1])...)
+	}
+	return findings
+}
+
+// ScanHealthInfoSecrets - scans all strings of a health report for
+// credentials before it is uploaded.
+func ScanHealthInfoSecrets(info HealthInfo) ([]SecretFinding, error) {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	var tree interface{}
+	if err = json.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+
+	var findings []SecretFinding
+	var walk func(path, key string, v interface{})
+	walk = func(path, key string, v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, child := range v {
+				walk(path+"."+k, k, child)
+			}
+		case []interface{}:
+			for i, child := range v {
+				walk(path+"["+strconv.Itoa(i)+"]", key, child)
+			}
+		case string:
+			findings = append(findings, scanSecrets(path, key, v)...)
+		}
+	}
+	walk("$", "", tree)
+	sort.Slice(findings, func(i, j int) bool { return findings[i].Location < findings[j].Location })
+	return findings, nil
+}
@@ -18,7 +18,6 @@ package madmin
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -87,55 +86,63 @@ type ServiceTraceOpts struct {
 	Threshold  time.Duration
 }
 
+// TraceStream - trace events pulled from the server, reconnecting
+// when the server ends the response.
+type TraceStream struct {
+	responseStream
+	item TraceInfo
+}
+
+// Next - advances to the next trace event.
+func (s *TraceStream) Next() bool {
+	s.item = TraceInfo{}
+	return s.next(&s.item)
+}
+
+// Item - returns the current trace event.
+func (s *TraceStream) Item() TraceInfo {
+	return s.item
+}
+
+// TraceStream - returns a stream of trace events, which are only
+// received as fast as they are consumed. Close the stream when done.
+func (adm AdminClient) TraceStream(ctx context.Context, opts ServiceTraceOpts) *TraceStream {
+	urlValues := make(url.Values)
+	urlValues.Set("err", strconv.FormatBool(opts.OnlyErrors))
+	urlValues.Set("threshold", opts.Threshold.String())
+
+	if opts.All {
+		// Deprecated flag
+		urlValues.Set("all", "true")
+	} else {
+		urlValues.Set("s3", strconv.FormatBool(opts.S3))
+		urlValues.Set("internal", strconv.FormatBool(opts.Internal))
+		urlValues.Set("storage", strconv.FormatBool(opts.Storage))
+		urlValues.Set("os", strconv.FormatBool(opts.OS))
+	}
+	return &TraceStream{responseStream: newResponseStream(ctx, adm, requestData{
+		relPath:     adminAPIPrefix + "/trace",
+		queryValues: urlValues,
+	}, true)}
+}
+
 // ServiceTrace - listen on http trace notifications.
 func (adm AdminClient) ServiceTrace(ctx context.Context, opts ServiceTraceOpts) <-chan ServiceTraceInfo {
 	traceInfoCh := make(chan ServiceTraceInfo)
 	// Only success, start a routine to start reading line by line.
 	go func(traceInfoCh chan<- ServiceTraceInfo) {
 		defer close(traceInfoCh)
-		for {
-			urlValues := make(url.Values)
-			urlValues.Set("err", strconv.FormatBool(opts.OnlyErrors))
-			urlValues.Set("threshold", opts.Threshold.String())
-
-			if opts.All {
-				// Deprecated flag
-				urlValues.Set("all", "true")
-			} else {
-				urlValues.Set("s3", strconv.FormatBool(opts.S3))
-				urlValues.Set("internal", strconv.FormatBool(opts.Internal))
-				urlValues.Set("storage", strconv.FormatBool(opts.Storage))
-				urlValues.Set("os", strconv.FormatBool(opts.OS))
-			}
-			reqData := requestData{
-				relPath:     adminAPIPrefix + "/trace",
-				queryValues: urlValues,
-			}
-			// Execute GET to call trace handler
-			resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
-			if err != nil {
-				closeResponse(resp)
-				traceInfoCh <- ServiceTraceInfo{Err: err}
-				return
-			}
-
-			if resp.StatusCode != http.StatusOK {
-				traceInfoCh <- ServiceTraceInfo{Err: httpRespToErrorResponse(resp)}
+		s := adm.TraceStream(ctx, opts)
+		defer s.Close()
+		for s.Next() {
+			select {
+			case <-ctx.Done():
 				return
+			case traceInfoCh <- ServiceTraceInfo{Trace: s.Item()}:
 			}
-
-			dec := json.NewDecoder(resp.Body)
-			for {
-				var info TraceInfo
-				if err = dec.Decode(&info); err != nil {
-					break
-				}
-				select {
-				case <-ctx.Done():
-					return
-				case traceInfoCh <- ServiceTraceInfo{Trace: info}:
-				}
-			}
+		}
+		if ctx.Err() == nil {
+			traceInfoCh <- ServiceTraceInfo{Err: s.Err()}
 		}
 	}(traceInfoCh)
 
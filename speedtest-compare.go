@@ -0,0 +1,178 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// SpeedtestRecordVersion is the current version of SpeedtestRecord.
+const SpeedtestRecordVersion = "1"
+
+// DefaultSpeedtestRegressionThreshold - a metric of CompareSpeedtest
+// regressed if it is more than 10% worse.
+const DefaultSpeedtestRegressionThreshold = 0.1
+
+// SpeedtestRecord - a speedtest result with the metadata needed to
+// compare it with later runs, e.g. before and after an upgrade.
+type SpeedtestRecord struct {
+	RecordVersion string    `json:"recordVersion"`
+	Time          time.Time `json:"time"`
+	// ServerVersion is the MinIO release the test ran against.
+	ServerVersion string `json:"serverVersion,omitempty"`
+	Servers       int    `json:"servers"`
+	Drives        int    `json:"drives"`
+	Size          int    `json:"size"`
+	Concurrency   int    `json:"concurrency"`
+	// Labels are free form, e.g. the pipeline run of the test.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	Result SpeedTestResult `json:"result"`
+}
+
+// NewSpeedtestRecord - returns a record of the final result of a
+// speedtest, with the metadata reported by the servers. Time,
+// ServerVersion and Labels are left to the caller.
+func NewSpeedtestRecord(result SpeedTestResult) SpeedtestRecord {
+	return SpeedtestRecord{
+		RecordVersion: SpeedtestRecordVersion,
+		Servers:       result.Servers,
+		Drives:        result.Disks,
+		Size:          result.Size,
+		Concurrency:   result.Concurrent,
+		Result:        result,
+	}
+}
+
+// SaveSpeedtestRecord - writes record as JSON to w.
+func SaveSpeedtestRecord(w io.Writer, record SpeedtestRecord) error {
+	if record.RecordVersion == "" {
+		record.RecordVersion = SpeedtestRecordVersion
+	}
+	return json.NewEncoder(w).Encode(record)
+}
+
+// LoadSpeedtestRecord - reads a record written by SaveSpeedtestRecord.
+func LoadSpeedtestRecord(r io.Reader) (SpeedtestRecord, error) {
+	var record SpeedtestRecord
+	if err := json.NewDecoder(r).Decode(&record); err != nil {
+		return SpeedtestRecord{}, err
+	}
+	if record.RecordVersion != SpeedtestRecordVersion {
+		return SpeedtestRecord{}, fmt.Errorf("unsupported speedtest record version %q", record.RecordVersion)
+	}
+	return record, nil
+}
+
+// SpeedtestMetricDelta - change of a metric between two speedtests.
+type SpeedtestMetricDelta struct {
+	// Metric is e.g. "put.throughputPerSec" or "get.ttfb.p99".
+	Metric string  `json:"metric"`
+	Before float64 `json:"before"`
+	After  float64 `json:"after"`
+	// Change is relative to Before and positive if After is better,
+	// i.e. a higher throughput or a lower response time.
+	Change    float64 `json:"change"`
+	Regressed bool    `json:"regressed"`
+}
+
+// SpeedtestComparison - result of CompareSpeedtest.
+type SpeedtestComparison struct {
+	Threshold float64                `json:"threshold"`
+	Metrics   []SpeedtestMetricDelta `json:"metrics"`
+	// Mismatches are the differences of the test setups, e.g. of the
+	// drive count, which make the comparison unreliable.
+	Mismatches []string `json:"mismatches,omitempty"`
+}
+
+// Regressed - returns true if any metric regressed.
+func (c SpeedtestComparison) Regressed() bool {
+	for _, m := range c.Metrics {
+		if m.Regressed {
+			return true
+		}
+	}
+	return false
+}
+
+// Regressions - returns the metrics which regressed.
+func (c SpeedtestComparison) Regressions() []SpeedtestMetricDelta {
+	var regressions []SpeedtestMetricDelta
+	for _, m := range c.Metrics {
+		if m.Regressed {
+			regressions = append(regressions, m)
+		}
+	}
+	return regressions
+}
+
+// CompareSpeedtest - compares the throughput and response times of two
+// speedtests, flagging metrics which are worse by more than threshold,
+// a fraction of the before value, DefaultSpeedtestRegressionThreshold
+// if not positive. Metrics which are zero before, i.e. not reported,
+// are skipped.
+func CompareSpeedtest(before, after SpeedtestRecord, threshold float64) SpeedtestComparison {
+	if threshold <= 0 {
+		threshold = DefaultSpeedtestRegressionThreshold
+	}
+	c := SpeedtestComparison{Threshold: threshold}
+
+	mismatch := func(what string, b, a interface{}) {
+		if b != a {
+			c.Mismatches = append(c.Mismatches, fmt.Sprintf("%s: %v != %v", what, b, a))
+		}
+	}
+	mismatch("servers", before.Servers, after.Servers)
+	mismatch("drives", before.Drives, after.Drives)
+	mismatch("size", before.Size, after.Size)
+	mismatch("concurrency", before.Concurrency, after.Concurrency)
+	mismatch("error", before.Result.Error, after.Result.Error)
+
+	add := func(metric string, b, a float64, higherIsBetter bool) {
+		if b == 0 {
+			return
+		}
+		change := (a - b) / b
+		if !higherIsBetter {
+			change = -change
+		}
+		c.Metrics = append(c.Metrics, SpeedtestMetricDelta{
+			Metric:    metric,
+			Before:    b,
+			After:     a,
+			Change:    change,
+			Regressed: change < -threshold,
+		})
+	}
+	for _, op := range []struct {
+		name          string
+		before, after SpeedTestStats
+	}{
+		{"put", before.Result.PUTStats, after.Result.PUTStats},
+		{"get", before.Result.GETStats, after.Result.GETStats},
+	} {
+		add(op.name+".throughputPerSec", float64(op.before.ThroughputPerSec), float64(op.after.ThroughputPerSec), true)
+		add(op.name+".objectsPerSec", float64(op.before.ObjectsPerSec), float64(op.after.ObjectsPerSec), true)
+		add(op.name+".responseTime.p50", op.before.Response.P50.Seconds(), op.after.Response.P50.Seconds(), false)
+		add(op.name+".responseTime.p99", op.before.Response.P99.Seconds(), op.after.Response.P99.Seconds(), false)
+		add(op.name+".ttfb.p99", op.before.TTFB.P99.Seconds(), op.after.TTFB.P99.Seconds(), false)
+	}
+	return c
+}
@@ -0,0 +1,78 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompareSpeedtest(t *testing.T) {
+	before := NewSpeedtestRecord(SpeedTestResult{
+		Version: "1", Servers: 4, Disks: 16, Size: 64 << 20, Concurrent: 32,
+		PUTStats: SpeedTestStats{ThroughputPerSec: 1000, ObjectsPerSec: 100, Response: Timings{P50: 100 * time.Millisecond, P99: time.Second}},
+		GETStats: SpeedTestStats{ThroughputPerSec: 2000, ObjectsPerSec: 200},
+	})
+	before.ServerVersion = "RELEASE.2021-10-13T00-23-17Z"
+	before.Labels = map[string]string{"pipeline": "42"}
+
+	var buf bytes.Buffer
+	if err := SaveSpeedtestRecord(&buf, before); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadSpeedtestRecord(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(loaded, before) {
+		t.Errorf("expected %+v, got %+v", before, loaded)
+	}
+	if _, err = LoadSpeedtestRecord(strings.NewReader(`{"recordVersion":"2"}`)); err == nil {
+		t.Error("expected an error for an unknown record version")
+	}
+
+	after := before
+	after.Drives = 12
+	after.Result.PUTStats = SpeedTestStats{ThroughputPerSec: 950, ObjectsPerSec: 80, Response: Timings{P50: 90 * time.Millisecond, P99: 1500 * time.Millisecond}}
+	after.Result.GETStats = SpeedTestStats{ThroughputPerSec: 2500, ObjectsPerSec: 250}
+
+	c := CompareSpeedtest(before, after, 0)
+	if c.Threshold != DefaultSpeedtestRegressionThreshold {
+		t.Errorf("expected the default threshold, got %v", c.Threshold)
+	}
+	var regressed []string
+	for _, m := range c.Regressions() {
+		regressed = append(regressed, m.Metric)
+	}
+	expected := []string{"put.objectsPerSec", "put.responseTime.p99"}
+	if !c.Regressed() || !reflect.DeepEqual(regressed, expected) {
+		t.Errorf("expected regressions %v, got %v", expected, regressed)
+	}
+	if len(c.Metrics) != 6 {
+		t.Errorf("expected 6 compared metrics, got %+v", c.Metrics)
+	}
+	if len(c.Mismatches) != 1 || !strings.HasPrefix(c.Mismatches[0], "drives") {
+		t.Errorf("expected a drive count mismatch, got %v", c.Mismatches)
+	}
+
+	if c = CompareSpeedtest(before, after, 0.5); c.Regressed() {
+		t.Errorf("expected no regressions with a 50%% threshold, got %+v", c.Regressions())
+	}
+}
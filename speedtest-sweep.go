@@ -0,0 +1,141 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// DefaultSpeedtestSweepSizes are the object sizes of a sweep, from 4KiB
+// to 64MiB.
+var DefaultSpeedtestSweepSizes = []int{
+	4 << 10, 64 << 10, 256 << 10,
+	1 << 20, 4 << 20, 16 << 20, 64 << 20,
+}
+
+// SpeedtestSweepOpts - options of SpeedtestSweep.
+type SpeedtestSweepOpts struct {
+	// Sizes are the object sizes in bytes, DefaultSpeedtestSweepSizes
+	// if empty.
+	Sizes []int
+	// Concurrency, Duration, Bucket and Target apply to every size.
+	Concurrency int
+	Duration    time.Duration
+	Bucket      string
+	Target      *SpeedtestTarget
+}
+
+// SpeedtestSizeClass - result of a sweep for one object size.
+type SpeedtestSizeClass struct {
+	Size  int    `json:"size"`
+	Error string `json:"error,omitempty"`
+
+	PUTThroughputPerSec uint64  `json:"putThroughputPerSec"`
+	PUTObjectsPerSec    uint64  `json:"putObjectsPerSec"`
+	GETThroughputPerSec uint64  `json:"getThroughputPerSec"`
+	GETObjectsPerSec    uint64  `json:"getObjectsPerSec"`
+	PUTResponse         Timings `json:"putResponseTime"`
+	GETResponse         Timings `json:"getResponseTime"`
+	GETTTFB             Timings `json:"getTTFB"`
+}
+
+// SpeedtestSweepResult - performance characterization curve of a
+// cluster, one entry per object size in ascending order.
+type SpeedtestSweepResult struct {
+	Concurrency int                  `json:"concurrency"`
+	Classes     []SpeedtestSizeClass `json:"classes"`
+}
+
+var errSpeedtestNoResult = errors.New("speedtest ended without a result")
+
+// ErrSpeedtestTargetUnsupported - the server ran a speedtest on the
+// whole cluster instead of the requested pool or erasure set.
+var ErrSpeedtestTargetUnsupported = errors.New("server does not support speedtest placement hints")
+
+// SpeedtestSweep - runs one speedtest per object size, one after the
+// other. A failed size is reported in its class and does not stop the
+// sweep, the sweep stops if ctx is canceled.
+func (adm *AdminClient) SpeedtestSweep(ctx context.Context, opts SpeedtestSweepOpts) (SpeedtestSweepResult, error) {
+	sizes := opts.Sizes
+	if len(sizes) == 0 {
+		sizes = DefaultSpeedtestSweepSizes
+	}
+	for i, size := range sizes {
+		if size <= 0 {
+			return SpeedtestSweepResult{}, ErrInvalidArgument("Sizes must be greater than 0 bytes.")
+		}
+		if i > 0 && size <= sizes[i-1] {
+			return SpeedtestSweepResult{}, ErrInvalidArgument("Sizes must be in ascending order.")
+		}
+	}
+
+	result := SpeedtestSweepResult{Concurrency: opts.Concurrency}
+	for _, size := range sizes {
+		class := SpeedtestSizeClass{Size: size}
+		final, err := adm.speedtestFinal(ctx, SpeedtestOpts{
+			Size:        size,
+			Concurrency: opts.Concurrency,
+			Duration:    opts.Duration,
+			Bucket:      opts.Bucket,
+			Target:      opts.Target,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return result, ctx.Err()
+			}
+			class.Error = err.Error()
+		} else {
+			class.PUTThroughputPerSec = final.PUTStats.ThroughputPerSec
+			class.PUTObjectsPerSec = final.PUTStats.ObjectsPerSec
+			class.GETThroughputPerSec = final.GETStats.ThroughputPerSec
+			class.GETObjectsPerSec = final.GETStats.ObjectsPerSec
+			class.PUTResponse = final.PUTStats.Response
+			class.GETResponse = final.GETStats.Response
+			class.GETTTFB = final.GETStats.TTFB
+		}
+		result.Classes = append(result.Classes, class)
+	}
+	return result, nil
+}
+
+// speedtestFinal - runs a speedtest and returns its final result.
+func (adm *AdminClient) speedtestFinal(ctx context.Context, opts SpeedtestOpts) (SpeedTestResult, error) {
+	ch, err := adm.Speedtest(ctx, opts)
+	if err != nil {
+		return SpeedTestResult{}, err
+	}
+	var final SpeedTestResult
+	var ok bool
+	for r := range ch {
+		final, ok = r, true
+	}
+	if err = ctx.Err(); err != nil {
+		return SpeedTestResult{}, err
+	}
+	if !ok {
+		return SpeedTestResult{}, errSpeedtestNoResult
+	}
+	if final.Error != "" {
+		return SpeedTestResult{}, errors.New(final.Error)
+	}
+	if opts.Target != nil && final.Target == nil {
+		return SpeedTestResult{}, ErrSpeedtestTargetUnsupported
+	}
+	return final, nil
+}
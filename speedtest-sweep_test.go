@@ -0,0 +1,79 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSpeedtestSweep(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		if size == 1<<20 {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"Code":"AccessDenied","Message":"Access Denied."}`))
+			return
+		}
+		enc := json.NewEncoder(w)
+		// A progress update followed by the final result.
+		enc.Encode(SpeedTestResult{Size: size, PUTStats: SpeedTestStats{ThroughputPerSec: 1}})
+		enc.Encode(SpeedTestResult{
+			Size:     size,
+			PUTStats: SpeedTestStats{ThroughputPerSec: uint64(size) * 10, ObjectsPerSec: 10},
+			GETStats: SpeedTestStats{ThroughputPerSec: uint64(size) * 20, ObjectsPerSec: 20, TTFB: Timings{P99: time.Millisecond}},
+		})
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := adm.SpeedtestSweep(context.Background(), SpeedtestSweepOpts{
+		Sizes:       []int{4 << 10, 1 << 20, 64 << 20},
+		Concurrency: 8,
+		Duration:    10 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Classes) != 3 {
+		t.Fatalf("expected 3 size classes, got %d", len(result.Classes))
+	}
+	small := result.Classes[0]
+	if small.Size != 4<<10 || small.PUTThroughputPerSec != 40<<10 || small.GETTTFB.P99 != time.Millisecond || small.Error != "" {
+		t.Errorf("unexpected result %+v", small)
+	}
+	if result.Classes[1].Error == "" {
+		t.Error("expected an error for the denied size")
+	}
+	if result.Classes[2].GETObjectsPerSec != 20 {
+		t.Errorf("unexpected result %+v", result.Classes[2])
+	}
+
+	if _, err = adm.SpeedtestSweep(context.Background(), SpeedtestSweepOpts{Sizes: []int{1 << 20, 4 << 10}}); err == nil {
+		t.Error("expected an error for unordered sizes")
+	}
+}
@@ -0,0 +1,175 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Timings - response time statistics of a speedtest.
+type Timings struct {
+	Avg  time.Duration `json:"avg"`
+	P50  time.Duration `json:"p50"`
+	P75  time.Duration `json:"p75"`
+	P95  time.Duration `json:"p95"`
+	P99  time.Duration `json:"p99"`
+	P999 time.Duration `json:"p999"`
+	Min  time.Duration `json:"min"`
+	Max  time.Duration `json:"max"`
+}
+
+// SpeedTestStatServer - speedtest stats of a server.
+type SpeedTestStatServer struct {
+	Endpoint         string `json:"endpoint"`
+	ThroughputPerSec uint64 `json:"throughputPerSec"`
+	ObjectsPerSec    uint64 `json:"objectsPerSec"`
+	Err              string `json:"err"`
+}
+
+// SpeedTestStats - speedtest stats of all servers.
+type SpeedTestStats struct {
+	ThroughputPerSec uint64                `json:"throughputPerSec"`
+	ObjectsPerSec    uint64                `json:"objectsPerSec"`
+	Response         Timings               `json:"responseTime"`
+	TTFB             Timings               `json:"ttfb,omitempty"`
+	Servers          []SpeedTestStatServer `json:"servers"`
+}
+
+// SpeedTestResult - progress or final result of a speedtest.
+type SpeedTestResult struct {
+	Version    string `json:"version"`
+	Servers    int    `json:"servers"`
+	Disks      int    `json:"disks"`
+	Size       int    `json:"size"`
+	Concurrent int    `json:"concurrent"`
+	// Target is set by servers which honored SpeedtestOpts.Target.
+	Target   *SpeedtestTarget `json:"target,omitempty"`
+	PUTStats SpeedTestStats
+	GETStats SpeedTestStats
+	// Error is set if the test failed on the server or its stream
+	// broke, it is the last result sent.
+	Error string `json:"error,omitempty"`
+}
+
+// SpeedtestTarget - pool and erasure set a speedtest places its
+// objects on.
+type SpeedtestTarget struct {
+	Pool int `json:"pool"`
+	// Set is the erasure set index within Pool, -1 for all sets of it.
+	Set int `json:"set"`
+}
+
+// SpeedtestOpts - options of Speedtest.
+type SpeedtestOpts struct {
+	Size        int           // Object size in bytes
+	Concurrency int           // Concurrent requests per server
+	Duration    time.Duration // Duration of the test
+	Autotune    bool          // Increase the concurrency until throughput stops improving
+	Bucket      string        // Bucket to run the test in, a temporary one by default
+	// Target restricts the test to a pool or erasure set. Servers
+	// without placement hints ignore it and test the whole cluster,
+	// which is detected by a result without Target.
+	Target *SpeedtestTarget
+}
+
+// Speedtest - runs the object PUT/GET benchmark of the servers, sending
+// its progress on the returned channel which is closed once the final
+// result is sent. A result with Error set ends a failed test.
+func (adm *AdminClient) Speedtest(ctx context.Context, opts SpeedtestOpts) (chan SpeedTestResult, error) {
+	if !opts.Autotune {
+		if opts.Duration <= time.Second {
+			return nil, ErrInvalidArgument("Duration must be greater than a second.")
+		}
+		if opts.Size <= 0 {
+			return nil, ErrInvalidArgument("Size must be greater than 0 bytes.")
+		}
+		if opts.Concurrency <= 0 {
+			return nil, ErrInvalidArgument("Concurrency must be greater than 0.")
+		}
+	}
+
+	queryValues := url.Values{}
+	if opts.Size > 0 {
+		queryValues.Set("size", strconv.Itoa(opts.Size))
+	}
+	if opts.Duration > 0 {
+		queryValues.Set("duration", opts.Duration.String())
+	}
+	if opts.Concurrency > 0 {
+		queryValues.Set("concurrent", strconv.Itoa(opts.Concurrency))
+	}
+	if opts.Bucket != "" {
+		queryValues.Set("bucket", opts.Bucket)
+	}
+	if opts.Autotune {
+		queryValues.Set("autotune", "true")
+	}
+	if t := opts.Target; t != nil {
+		if t.Pool < 0 || t.Set < -1 {
+			return nil, ErrInvalidArgument("Invalid speedtest target pool or set.")
+		}
+		queryValues.Set("pool", strconv.Itoa(t.Pool))
+		if t.Set >= 0 {
+			queryValues.Set("set", strconv.Itoa(t.Set))
+		}
+	}
+
+	resp, err := adm.executeMethod(ctx, http.MethodPost, requestData{
+		relPath:           adminAPIPrefix + "/speedtest",
+		queryValues:       queryValues,
+		unboundedResponse: true,
+		messageStream:     true,
+	})
+	if err != nil {
+		closeResponse(resp)
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer closeResponse(resp)
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	ch := make(chan SpeedTestResult)
+	go func() {
+		defer closeResponse(resp)
+		defer close(ch)
+		dec := adm.newResponseDecoder(resp)
+		for {
+			var result SpeedTestResult
+			if err := dec.Decode(&result); err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					select {
+					case ch <- SpeedTestResult{Error: err.Error()}:
+					case <-ctx.Done():
+					}
+				}
+				return
+			}
+			select {
+			case ch <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
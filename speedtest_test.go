@@ -0,0 +1,115 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSpeedtestTarget(t *testing.T) {
+	hints := true
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result := SpeedTestResult{Size: 1 << 20}
+		q := r.URL.Query()
+		if hints && q.Get("pool") != "" {
+			pool, _ := strconv.Atoi(q.Get("pool"))
+			set := -1
+			if q.Get("set") != "" {
+				set, _ = strconv.Atoi(q.Get("set"))
+			}
+			result.Target = &SpeedtestTarget{Pool: pool, Set: set}
+		}
+		json.NewEncoder(w).Encode(result)
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := SpeedtestOpts{
+		Size:        1 << 20,
+		Concurrency: 4,
+		Duration:    5 * time.Second,
+		Target:      &SpeedtestTarget{Pool: 1, Set: 3},
+	}
+	result, err := adm.speedtestFinal(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Target == nil || *result.Target != *opts.Target {
+		t.Errorf("unexpected target %v", result.Target)
+	}
+
+	opts.Target = &SpeedtestTarget{Pool: 0, Set: -1}
+	if result, err = adm.speedtestFinal(context.Background(), opts); err != nil || result.Target.Set != -1 {
+		t.Errorf("unexpected result %v %v", result.Target, err)
+	}
+
+	hints = false
+	if _, err = adm.speedtestFinal(context.Background(), opts); err != ErrSpeedtestTargetUnsupported {
+		t.Errorf("expected %v, got %v", ErrSpeedtestTargetUnsupported, err)
+	}
+
+	opts.Target = &SpeedtestTarget{Pool: -1}
+	if _, err = adm.Speedtest(context.Background(), opts); err == nil {
+		t.Error("expected an error for an invalid target")
+	}
+}
+
+func TestSpeedtestError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(SpeedTestResult{Size: 1 << 20})
+		if r.URL.Query().Get("size") == "1" {
+			w.Write([]byte(`{"size":`)) // Truncated stream.
+			return
+		}
+		json.NewEncoder(w).Encode(SpeedTestResult{Error: "bucket not found"})
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := SpeedtestOpts{Size: 1 << 20, Concurrency: 4, Duration: 5 * time.Second}
+	if _, err = adm.speedtestFinal(context.Background(), opts); err == nil || err.Error() != "bucket not found" {
+		t.Errorf("expected the server error, got %v", err)
+	}
+
+	opts.Size = 1
+	ch, err := adm.Speedtest(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []SpeedTestResult
+	for r := range ch {
+		results = append(results, r)
+	}
+	if len(results) != 2 || results[0].Error != "" || results[1].Error == "" {
+		t.Errorf("expected a final error result for a truncated stream, got %+v", results)
+	}
+}
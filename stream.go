@@ -0,0 +1,147 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// Stream - a pull-based iterator over a server stream, which holds
+// only the current item in memory. Streams add an Item method
+// returning the current item, e.g. TraceStream.Item:
+//
+//	for s.Next() {
+//		process(s.Item())
+//	}
+//	if err := s.Err(); err != nil {
+//		...
+//	}
+//	s.Close()
+type Stream interface {
+	// Next advances to the next item, false at the end of the stream
+	// or on error.
+	Next() bool
+	// Err returns the error which ended the stream, if any.
+	Err() error
+	// Close releases the connection, Next returns false afterwards.
+	Close() error
+}
+
+// responseStream - decodes the JSON values of a streamed response,
+// optionally reconnecting when the server ends the response.
+type responseStream struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	adm       AdminClient
+	reqData   requestData
+	reconnect bool
+
+	resp *http.Response
+	dec  responseDecoder
+	// reconnects since the last decoded value.
+	reconnects int
+	err        error
+	closed     bool
+}
+
+// newResponseStream - returns a stream of the responses to reqData,
+// Close cancels the requests of the stream.
+func newResponseStream(ctx context.Context, adm AdminClient, reqData requestData, reconnect bool) responseStream {
+	ctx, cancel := context.WithCancel(ctx)
+	return responseStream{
+		ctx:       ctx,
+		cancel:    cancel,
+		adm:       adm,
+		reqData:   reqData,
+		reconnect: reconnect,
+	}
+}
+
+// next - decodes the next value into v.
+func (s *responseStream) next(v interface{}) bool {
+	for s.err == nil && !s.closed {
+		if err := s.ctx.Err(); err != nil {
+			s.err = err
+			break
+		}
+		if s.resp == nil {
+			resp, err := s.adm.executeMethod(s.ctx, http.MethodGet, s.reqData)
+			if err != nil {
+				closeResponse(resp)
+				s.err = err
+				break
+			}
+			if resp.StatusCode != http.StatusOK {
+				s.err = httpRespToErrorResponse(resp)
+				closeResponse(resp)
+				break
+			}
+			s.resp, s.dec = resp, s.adm.newResponseDecoder(resp)
+		}
+
+		err := s.dec.Decode(v)
+		if err == nil {
+			s.reconnects = 0
+			return true
+		}
+		// The rest of the body is of no use, close it without
+		// draining a stream which may never end.
+		s.resp.Body.Close()
+		s.resp = nil
+		if s.closed {
+			break
+		}
+		if err != io.EOF && err != io.ErrUnexpectedEOF {
+			s.err = err
+			break
+		}
+		if !s.reconnect {
+			if err != io.EOF {
+				s.err = err
+			}
+			s.closed = true
+			break
+		}
+		// Back off like retried requests, so that a server ending
+		// every response right away is not hammered.
+		policy := s.adm.retryPolicyFor(s.ctx, s.reqData)
+		if err = policy.wait(s.ctx, s.adm.random, s.reconnects, 0); err != nil {
+			s.err = err
+			break
+		}
+		s.reconnects++
+	}
+	return false
+}
+
+func (s *responseStream) Err() error {
+	return s.err
+}
+
+func (s *responseStream) Close() error {
+	s.closed = true
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.resp != nil {
+		s.resp.Body.Close()
+		s.resp = nil
+	}
+	return nil
+}
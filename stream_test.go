@@ -0,0 +1,213 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTraceStream(t *testing.T) {
+	var connections int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connections++
+		for i := 0; i < 3; i++ {
+			fmt.Fprintf(w, `{"type":0,"funcname":"s3.GetObject","nodename":"node%d"}`+"\n", connections)
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := adm.TraceStream(context.Background(), ServiceTraceOpts{S3: true})
+	var nodes []string
+	for len(nodes) < 5 && s.Next() {
+		nodes = append(nodes, s.Item().NodeName)
+	}
+	if err = s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if s.Err() != nil || len(nodes) != 5 || nodes[2] != "node1" || nodes[3] != "node2" {
+		t.Fatalf("unexpected trace events %v, %v", nodes, s.Err())
+	}
+	if s.Next() {
+		t.Fatal("expected no events after Close")
+	}
+}
+
+func TestLogStreamError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`<Error><Code>AccessDenied</Code><Message>Access Denied.</Message></Error>`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var errs int
+	for info := range adm.GetLogs(context.Background(), "", 10, "") {
+		if info.Err == nil {
+			t.Fatalf("unexpected log message %+v", info)
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Fatalf("expected one error, got %d", errs)
+	}
+}
+
+func TestHealResultStream(t *testing.T) {
+	defer func(d time.Duration) { healResultsPollInterval = d }(healResultsPollInterval)
+	healResultsPollInterval = time.Millisecond
+
+	statuses := []HealTaskStatus{
+		{Summary: healSummaryRunning, Items: []HealResultItem{{Object: "a"}, {Object: "b"}}},
+		{Summary: healSummaryRunning},
+		{Summary: healSummaryFinished, Items: []HealResultItem{{Object: "c"}}},
+	}
+	var stopped bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Get("forceStop") == "true":
+			stopped = true
+			json.NewEncoder(w).Encode(HealStartSuccess{})
+		case r.URL.Query().Get("clientToken") == "":
+			json.NewEncoder(w).Encode(HealStartSuccess{ClientToken: "token"})
+		default:
+			json.NewEncoder(w).Encode(statuses[0])
+			statuses = statuses[1:]
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := adm.HealResults(context.Background(), "data", "", HealOpts{Recursive: true}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var objects []string
+	for s.Next() {
+		objects = append(objects, s.Item().Object)
+	}
+	if err = s.Close(); err != nil || s.Err() != nil {
+		t.Fatal(err, s.Err())
+	}
+	if fmt.Sprint(objects) != "[a b c]" || s.Status().Summary != healSummaryFinished || stopped {
+		t.Fatalf("unexpected results %v, status %+v, stopped %v", objects, s.Status(), stopped)
+	}
+}
+
+func TestTraceStreamClose(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"type":0,"funcname":"s3.GetObject","nodename":"node1"}`)
+		w.(http.Flusher).Flush()
+		// Like a trace, the response never ends on its own.
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := adm.TraceStream(context.Background(), ServiceTraceOpts{S3: true})
+	if !s.Next() {
+		t.Fatal(s.Err())
+	}
+	closed := make(chan error, 1)
+	go func() { closed <- s.Close() }()
+	select {
+	case err = <-closed:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected Close not to wait for the end of the response")
+	}
+}
+
+func TestTraceStreamReconnect(t *testing.T) {
+	var connections int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Every response ends right away.
+		atomic.AddInt32(&connections, 1)
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm.SetRetryPolicy(RetryPolicy{Unit: 50 * time.Millisecond, Cap: 50 * time.Millisecond, DisableJitter: true})
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	s := adm.TraceStream(ctx, ServiceTraceOpts{S3: true})
+	if s.Next() {
+		t.Fatal("expected no events")
+	}
+	s.Close()
+	if s.Err() != context.DeadlineExceeded {
+		t.Fatalf("expected the deadline to end the stream, got %v", s.Err())
+	}
+	if n := atomic.LoadInt32(&connections); n < 2 || n > 10 {
+		t.Fatalf("expected reconnects to back off, got %d connections", n)
+	}
+}
+
+func TestTraceStreamDecodeError(t *testing.T) {
+	var connections int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&connections, 1)
+		w.Write([]byte("garbage"))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := adm.TraceStream(context.Background(), ServiceTraceOpts{S3: true})
+	if s.Next() {
+		t.Fatal("expected no events")
+	}
+	s.Close()
+	var syntaxErr *json.SyntaxError
+	if !errors.As(s.Err(), &syntaxErr) || atomic.LoadInt32(&connections) != 1 {
+		t.Fatalf("expected a syntax error without reconnecting, got %v after %d connections", s.Err(), connections)
+	}
+}
@@ -0,0 +1,105 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// StrictDecodeError - a response field unknown to the client, returned
+// in strict decode mode.
+type StrictDecodeError struct {
+	// Endpoint is the method and path of the request, e.g.
+	// "GET /minio/admin/v3/info".
+	Endpoint string
+	Field    string
+}
+
+func (e StrictDecodeError) Error() string {
+	return "unknown field " + strconv.Quote(e.Field) + " in response of " + e.Endpoint
+}
+
+// SetStrictDecoding - enables or disables rejecting response fields
+// the client does not know, so that integration tests catch schema
+// drift between server and client. Disabled by default.
+func (adm *AdminClient) SetStrictDecoding(enabled bool) {
+	adm.strictDecoding = enabled
+}
+
+// responseDecoder - decodes the JSON values of a response body.
+type responseDecoder struct {
+	*json.Decoder
+	endpoint string
+}
+
+// Decode - decodes the next value, returning a StrictDecodeError for
+// unknown fields.
+func (d responseDecoder) Decode(v interface{}) error {
+	err := d.Decoder.Decode(v)
+	if err != nil {
+		const prefix = "json: unknown field "
+		if msg := err.Error(); strings.HasPrefix(msg, prefix) {
+			field, uerr := strconv.Unquote(strings.TrimPrefix(msg, prefix))
+			if uerr != nil {
+				field = strings.TrimPrefix(msg, prefix)
+			}
+			return StrictDecodeError{Endpoint: d.endpoint, Field: field}
+		}
+	}
+	return err
+}
+
+// newDecoder - returns a decoder of r, a body of the response resp,
+// rejecting unknown fields in strict mode.
+func (adm AdminClient) newDecoder(resp *http.Response, r io.Reader) responseDecoder {
+	d := responseDecoder{Decoder: json.NewDecoder(r)}
+	if adm.strictDecoding {
+		d.DisallowUnknownFields()
+	}
+	if resp != nil && resp.Request != nil {
+		d.endpoint = resp.Request.Method + " " + resp.Request.URL.Path
+	}
+	return d
+}
+
+// newResponseDecoder - returns a decoder of the body of resp.
+func (adm AdminClient) newResponseDecoder(resp *http.Response) responseDecoder {
+	return adm.newDecoder(resp, resp.Body)
+}
+
+// decodeResponse - decodes the JSON body of resp into v.
+func (adm AdminClient) decodeResponse(resp *http.Response, v interface{}) error {
+	return adm.newResponseDecoder(resp).Decode(v)
+}
+
+// unmarshalResponse - unmarshals data, the decrypted or read body of
+// resp, into v.
+func (adm AdminClient) unmarshalResponse(resp *http.Response, data []byte, v interface{}) error {
+	if !adm.strictDecoding {
+		return json.Unmarshal(data, v)
+	}
+	if err := json.Unmarshal(data, new(json.RawMessage)); err != nil {
+		// Invalid JSON or trailing data, like json.Unmarshal.
+		return err
+	}
+	return adm.newDecoder(resp, bytes.NewReader(data)).Decode(v)
+}
@@ -0,0 +1,117 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestStrictDecoding(t *testing.T) {
+	healthVersion := HealthInfoVersion
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/minio/admin/v3/info":
+			w.Write([]byte(`{"mode":"online","newField":1}`))
+		case "/minio/admin/v3/get-bucket-quota":
+			w.Write([]byte(`{"quota":1024,"quotatype":"hard","burst":10}`))
+		case "/minio/admin/v3/healthinfo":
+			w.Write([]byte(`{"version":"` + healthVersion + `","timestamp":"2021-05-01T00:00:00Z","newField":1}`))
+		case "/minio/admin/v3/help-config-kv":
+			w.Write([]byte(`{"subSys":"region","newField":1}`))
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info, err := adm.ServerInfo(context.Background()); err != nil || info.Mode != "online" {
+		t.Fatalf("expected unknown fields to be ignored, got %v, %v", info, err)
+	}
+	if _, err = adm.GetBucketQuota(context.Background(), "photos"); err != nil {
+		t.Fatalf("expected unknown fields to be ignored, got %v", err)
+	}
+	resp, _, err := adm.ServerHealthInfo(context.Background(), nil, time.Minute)
+	if err != nil {
+		t.Fatalf("expected unknown fields to be ignored, got %v", err)
+	}
+	closeResponse(resp)
+
+	// Help is always decoded strictly.
+	var strictErr StrictDecodeError
+	_, err = adm.HelpConfigKV(context.Background(), "region", "", false)
+	if !errors.As(err, &strictErr) || strictErr.Field != "newField" || strictErr.Endpoint != "GET /minio/admin/v3/help-config-kv" {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	adm.SetStrictDecoding(true)
+	_, err = adm.ServerInfo(context.Background())
+	if !errors.As(err, &strictErr) || strictErr.Field != "newField" || strictErr.Endpoint != "GET /minio/admin/v3/info" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	_, err = adm.GetBucketQuota(context.Background(), "photos")
+	if !errors.As(err, &strictErr) || strictErr.Field != "burst" || strictErr.Endpoint != "GET /minio/admin/v3/get-bucket-quota" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	_, _, err = adm.ServerHealthInfo(context.Background(), nil, time.Minute)
+	if !errors.As(err, &strictErr) || strictErr.Field != "newField" || strictErr.Endpoint != "GET /minio/admin/v3/healthinfo" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// Reports of newer versions are refused before their fields are checked.
+	healthVersion = "99"
+	_, _, err = adm.ServerHealthInfo(context.Background(), nil, time.Minute)
+	if err == nil || err.Error() != "Upgrade Minio Client to support health info version 99" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestHealthInfoNotStrict(t *testing.T) {
+	const report = `{"version":"` + HealthInfoVersion + `","timestamp":"yesterday"}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(report))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The report is only checked for its version, the timestamp
+	// failing to decode is left to the caller.
+	resp, raw, _, err := adm.healthInfoStream(context.Background(), nil, HealthInfoOpts{Deadline: time.Minute}, "")
+	if err != nil {
+		t.Fatalf("expected the report to be accepted, got %v", err)
+	}
+	closeResponse(resp)
+	if string(raw) != report {
+		t.Fatalf("expected %s, got %s", report, raw)
+	}
+
+	adm.SetStrictDecoding(true)
+	if _, _, err = adm.ServerHealthInfo(context.Background(), nil, time.Minute); err == nil {
+		t.Fatal("expected the report to be rejected in strict mode")
+	}
+}
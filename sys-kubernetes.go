@@ -0,0 +1,189 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+var (
+	// cgroupRoot is replaced in tests.
+	cgroupRoot = "/sys/fs/cgroup"
+	// kubernetesServiceAccountDir is mounted into pods with a service
+	// account, it is replaced in tests.
+	kubernetesServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+)
+
+// cgroupUnlimited - cgroup v1 reports no memory limit as the largest
+// page aligned int64, anything above is treated as unlimited.
+const cgroupUnlimited = 1 << 62
+
+// SysKubernetes - the Kubernetes pod a node runs in and the cgroup
+// limits of the server process, which apply instead of the host totals
+// reported by MemInfo and CPUs.
+type SysKubernetes struct {
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	InKubernetes bool   `json:"in_kubernetes" yaml:"in_kubernetes"`
+	PodName      string `json:"pod_name,omitempty" yaml:"pod_name,omitempty"`
+	Namespace    string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	NodeName     string `json:"node_name,omitempty" yaml:"node_name,omitempty"`
+
+	// Resources of the container, as passed by the downward API in the
+	// CPU_REQUEST, CPU_LIMIT, MEMORY_REQUEST and MEMORY_LIMIT
+	// environment variables. Empty if not passed.
+	CPURequest    string `json:"cpu_request,omitempty" yaml:"cpu_request,omitempty"`
+	CPULimit      string `json:"cpu_limit,omitempty" yaml:"cpu_limit,omitempty"`
+	MemoryRequest string `json:"memory_request,omitempty" yaml:"memory_request,omitempty"`
+	MemoryLimit   string `json:"memory_limit,omitempty" yaml:"memory_limit,omitempty"`
+
+	// Limits enforced by the cgroup of the process, zero if unlimited.
+	CgroupVersion     int      `json:"cgroup_version,omitempty" yaml:"cgroup_version,omitempty"`
+	CgroupMemoryLimit ByteSize `json:"cgroup_memory_limit,omitempty" yaml:"cgroup_memory_limit,omitempty"`
+	CgroupCPULimit    float64  `json:"cgroup_cpu_limit,omitempty" yaml:"cgroup_cpu_limit,omitempty"`
+}
+
+// GetSysKubernetes returns the Kubernetes pod of a node running linux
+// and the cgroup limits of the process. Pod and node names are read
+// from the POD_NAME, POD_NAMESPACE and NODE_NAME environment variables,
+// falling back to the hostname and the service account namespace.
+func GetSysKubernetes(ctx context.Context, addr string) SysKubernetes {
+	if runtime.GOOS != "linux" {
+		return SysKubernetes{
+			Addr:  addr,
+			Error: "unsupported operating system " + runtime.GOOS,
+		}
+	}
+
+	info := SysKubernetes{Addr: addr}
+	_, err := os.Stat(kubernetesServiceAccountDir)
+	info.InKubernetes = os.Getenv("KUBERNETES_SERVICE_HOST") != "" || err == nil
+	if info.InKubernetes {
+		info.PodName = os.Getenv("POD_NAME")
+		if info.PodName == "" {
+			info.PodName, _ = os.Hostname()
+		}
+		info.Namespace = os.Getenv("POD_NAMESPACE")
+		if info.Namespace == "" {
+			ns, _ := ioutil.ReadFile(filepath.Join(kubernetesServiceAccountDir, "namespace"))
+			info.Namespace = strings.TrimSpace(string(ns))
+		}
+		info.NodeName = os.Getenv("NODE_NAME")
+		info.CPURequest = os.Getenv("CPU_REQUEST")
+		info.CPULimit = os.Getenv("CPU_LIMIT")
+		info.MemoryRequest = os.Getenv("MEMORY_REQUEST")
+		info.MemoryLimit = os.Getenv("MEMORY_LIMIT")
+	}
+
+	if err = readCgroupLimits(&info); err != nil {
+		info.Error = err.Error()
+	}
+	return info
+}
+
+// readCgroupLimits - reads the memory and CPU limits of the cgroup
+// namespace root, which is the container cgroup in pods.
+func readCgroupLimits(info *SysKubernetes) error {
+	if memMax, err := ioutil.ReadFile(filepath.Join(cgroupRoot, "memory.max")); err == nil {
+		info.CgroupVersion = 2
+		if info.CgroupMemoryLimit, err = parseCgroupLimit(string(memMax)); err != nil {
+			return err
+		}
+		cpuMax, err := ioutil.ReadFile(filepath.Join(cgroupRoot, "cpu.max"))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		fields := strings.Fields(string(cpuMax))
+		if len(fields) != 2 {
+			return ErrInvalidArgument("invalid cpu.max " + strings.TrimSpace(string(cpuMax)))
+		}
+		info.CgroupCPULimit, err = cgroupCPULimit(fields[0], fields[1])
+		return err
+	}
+
+	memLimit, err := ioutil.ReadFile(filepath.Join(cgroupRoot, "memory", "memory.limit_in_bytes"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			// No cgroup filesystem, no limits.
+			return nil
+		}
+		return err
+	}
+	info.CgroupVersion = 1
+	if info.CgroupMemoryLimit, err = parseCgroupLimit(string(memLimit)); err != nil {
+		return err
+	}
+	quota, err := ioutil.ReadFile(filepath.Join(cgroupRoot, "cpu", "cpu.cfs_quota_us"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	period, err := ioutil.ReadFile(filepath.Join(cgroupRoot, "cpu", "cpu.cfs_period_us"))
+	if err != nil {
+		return err
+	}
+	info.CgroupCPULimit, err = cgroupCPULimit(strings.TrimSpace(string(quota)), strings.TrimSpace(string(period)))
+	return err
+}
+
+// parseCgroupLimit - parses a limit in bytes, zero if unlimited.
+func parseCgroupLimit(s string) (ByteSize, error) {
+	s = strings.TrimSpace(s)
+	if s == "max" {
+		return 0, nil
+	}
+	limit, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if limit >= cgroupUnlimited {
+		return 0, nil
+	}
+	return ByteSize(limit), nil
+}
+
+// cgroupCPULimit - returns the CPUs a quota per period allows, zero if
+// the quota is max (v2) or -1 (v1).
+func cgroupCPULimit(quota, period string) (float64, error) {
+	if quota == "max" || quota == "-1" {
+		return 0, nil
+	}
+	q, err := strconv.ParseFloat(quota, 64)
+	if err != nil {
+		return 0, err
+	}
+	p, err := strconv.ParseFloat(period, 64)
+	if err != nil {
+		return 0, err
+	}
+	if p <= 0 {
+		return 0, ErrInvalidArgument("invalid cgroup cpu period " + period)
+	}
+	return q / p, nil
+}
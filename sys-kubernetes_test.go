@@ -0,0 +1,112 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestReadCgroupLimits(t *testing.T) {
+	defer func(old string) { cgroupRoot = old }(cgroupRoot)
+	for _, test := range []struct {
+		name  string
+		files map[string]string
+		want  SysKubernetes
+	}{
+		{"none", nil, SysKubernetes{}},
+		{"v2", map[string]string{
+			"memory.max": "4294967296\n",
+			"cpu.max":    "150000 100000\n",
+		}, SysKubernetes{CgroupVersion: 2, CgroupMemoryLimit: 4294967296, CgroupCPULimit: 1.5}},
+		{"v2-unlimited", map[string]string{
+			"memory.max": "max\n",
+			"cpu.max":    "max 100000\n",
+		}, SysKubernetes{CgroupVersion: 2}},
+		{"v1", map[string]string{
+			"memory/memory.limit_in_bytes": "2147483648\n",
+			"cpu/cpu.cfs_quota_us":         "200000\n",
+			"cpu/cpu.cfs_period_us":        "100000\n",
+		}, SysKubernetes{CgroupVersion: 1, CgroupMemoryLimit: 2147483648, CgroupCPULimit: 2}},
+		{"v1-unlimited", map[string]string{
+			"memory/memory.limit_in_bytes": "9223372036854771712\n",
+			"cpu/cpu.cfs_quota_us":         "-1\n",
+			"cpu/cpu.cfs_period_us":        "100000\n",
+		}, SysKubernetes{CgroupVersion: 1}},
+	} {
+		root, err := ioutil.TempDir("", "cgroup")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(root)
+		for path, content := range test.files {
+			path = filepath.Join(root, path)
+			if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err = ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		cgroupRoot = root
+
+		var info SysKubernetes
+		if err = readCgroupLimits(&info); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+		if info != test.want {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.want, info)
+		}
+	}
+}
+
+func TestGetSysKubernetes(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("linux only")
+	}
+	dir, err := ioutil.TempDir("", "serviceaccount")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err = ioutil.WriteFile(filepath.Join(dir, "namespace"), []byte("tenant-1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer func(old string) { kubernetesServiceAccountDir = old }(kubernetesServiceAccountDir)
+	kubernetesServiceAccountDir = dir
+	for k, v := range map[string]string{"POD_NAME": "minio-0", "NODE_NAME": "worker-3", "MEMORY_LIMIT": "8589934592"} {
+		defer os.Unsetenv(k)
+		os.Setenv(k, v)
+	}
+
+	info := GetSysKubernetes(context.Background(), "node1:9000")
+	if !info.InKubernetes || info.PodName != "minio-0" || info.Namespace != "tenant-1" ||
+		info.NodeName != "worker-3" || info.MemoryLimit != "8589934592" {
+		t.Errorf("unexpected info %+v", info)
+	}
+
+	kubernetesServiceAccountDir = filepath.Join(dir, "missing")
+	if os.Getenv("KUBERNETES_SERVICE_HOST") == "" {
+		if info = GetSysKubernetes(context.Background(), "node1:9000"); info.InKubernetes || info.PodName != "" {
+			t.Errorf("unexpected info outside Kubernetes %+v", info)
+		}
+	}
+}
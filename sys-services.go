@@ -0,0 +1,160 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Services reported by GetSysServices.
+const (
+	SysServiceNTP        = "ntp"
+	SysServiceFirewall   = "firewall"
+	SysServiceIRQBalance = "irqbalance"
+)
+
+// States of a SysService.
+const (
+	SysServiceActive   = "active"
+	SysServiceInactive = "inactive"
+	SysServiceFailed   = "failed"
+	SysServiceNotFound = "not-found"
+)
+
+// sysServiceUnits - systemd units which provide a service, in order of
+// preference.
+var sysServiceUnits = []struct {
+	name  string
+	units []string
+}{
+	{SysServiceNTP, []string{"chronyd", "chrony", "ntpd", "ntp", "systemd-timesyncd"}},
+	{SysServiceFirewall, []string{"firewalld", "ufw", "nftables", "iptables"}},
+	{SysServiceIRQBalance, []string{"irqbalance"}},
+}
+
+// SysService - state of a service relevant to MinIO performance.
+type SysService struct {
+	Name string `json:"name" yaml:"name"`
+	// Unit is the systemd unit providing the service, empty if none
+	// is installed.
+	Unit    string `json:"unit,omitempty" yaml:"unit,omitempty"`
+	State   string `json:"state" yaml:"state"`
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+	Error   string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// SysServices - services of a node and its SELinux mode.
+type SysServices struct {
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Services []SysService `json:"services,omitempty" yaml:"services,omitempty"`
+	// SELinux is enforcing, permissive or disabled.
+	SELinux string `json:"selinux,omitempty" yaml:"selinux,omitempty"`
+}
+
+// GetSysServices returns the state of the time synchronization,
+// firewall and IRQ balancing services and the SELinux mode of a node
+// running linux with systemd.
+func GetSysServices(ctx context.Context, addr string) SysServices {
+	if runtime.GOOS != "linux" {
+		return SysServices{
+			Addr:  addr,
+			Error: "unsupported operating system " + runtime.GOOS,
+		}
+	}
+
+	systemctl, err := exec.LookPath("systemctl")
+	if err != nil {
+		return SysServices{
+			Addr:  addr,
+			Error: err.Error(),
+		}
+	}
+
+	info := SysServices{Addr: addr, SELinux: selinuxMode()}
+	for _, s := range sysServiceUnits {
+		service := SysService{Name: s.name, State: SysServiceNotFound}
+		for _, unit := range s.units {
+			out, err := exec.CommandContext(ctx, systemctl, "show",
+				"--property=LoadState,ActiveState,UnitFileState", unit+".service").Output()
+			if err != nil {
+				service.Error = err.Error()
+				break
+			}
+			props := parseSystemctlShow(string(out))
+			if props["LoadState"] != "loaded" {
+				continue
+			}
+			if service.Unit == "" || props["ActiveState"] == SysServiceActive {
+				service.Unit = unit
+				service.State = sysServiceState(props["ActiveState"])
+				service.Enabled = props["UnitFileState"] == "enabled"
+			}
+			if service.State == SysServiceActive {
+				break
+			}
+		}
+		info.Services = append(info.Services, service)
+	}
+	return info
+}
+
+// parseSystemctlShow - parses the key=value lines of systemctl show.
+func parseSystemctlShow(out string) map[string]string {
+	props := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		if i := strings.IndexByte(scanner.Text(), '='); i > 0 {
+			props[scanner.Text()[:i]] = strings.TrimSpace(scanner.Text()[i+1:])
+		}
+	}
+	return props
+}
+
+// sysServiceState - maps a systemd ActiveState to a service state,
+// transitional states are reported as inactive.
+func sysServiceState(activeState string) string {
+	switch activeState {
+	case "active", "reloading":
+		return SysServiceActive
+	case "failed":
+		return SysServiceFailed
+	}
+	return SysServiceInactive
+}
+
+// selinuxMode - reads the SELinux mode from selinuxfs.
+func selinuxMode() string {
+	enforce, err := ioutil.ReadFile("/sys/fs/selinux/enforce")
+	if os.IsNotExist(err) {
+		return "disabled"
+	}
+	if err != nil {
+		return ""
+	}
+	if strings.TrimSpace(string(enforce)) == "1" {
+		return "enforcing"
+	}
+	return "permissive"
+}
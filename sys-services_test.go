@@ -0,0 +1,40 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import "testing"
+
+func TestParseSystemctlShow(t *testing.T) {
+	props := parseSystemctlShow("LoadState=loaded\nActiveState=failed\nUnitFileState=enabled\n\nbogus\n")
+	if props["LoadState"] != "loaded" || props["UnitFileState"] != "enabled" || len(props) != 3 {
+		t.Fatalf("unexpected properties %v", props)
+	}
+	if state := sysServiceState(props["ActiveState"]); state != SysServiceFailed {
+		t.Errorf("expected %s, got %s", SysServiceFailed, state)
+	}
+	for activeState, state := range map[string]string{
+		"active":       SysServiceActive,
+		"reloading":    SysServiceActive,
+		"activating":   SysServiceInactive,
+		"inactive":     SysServiceInactive,
+		"deactivating": SysServiceInactive,
+	} {
+		if got := sysServiceState(activeState); got != state {
+			t.Errorf("%s: expected %s, got %s", activeState, state, got)
+		}
+	}
+}
@@ -0,0 +1,300 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SyslogNetwork - transport used to reach the syslog receiver.
+type SyslogNetwork string
+
+// Supported syslog transports.
+const (
+	SyslogUDP SyslogNetwork = "udp"
+	SyslogTCP SyslogNetwork = "tcp"
+	SyslogTLS SyslogNetwork = "tls"
+)
+
+// SyslogFacility - syslog facility code as defined by RFC 5424.
+type SyslogFacility int
+
+// Commonly used syslog facilities.
+const (
+	SyslogFacilityUser   SyslogFacility = 1
+	SyslogFacilityDaemon SyslogFacility = 3
+	SyslogFacilityAuth   SyslogFacility = 4
+	SyslogFacilityLocal0 SyslogFacility = 16
+	SyslogFacilityLocal7 SyslogFacility = 23
+)
+
+// syslogSDID is the structured data element ID carrying MinIO log fields,
+// 32473 is the enterprise number reserved for documentation by RFC 5612.
+const syslogSDID = "minio@32473"
+
+// SyslogOptions - options for NewSyslogForwarder.
+type SyslogOptions struct {
+	// Network is one of udp, tcp or tls, defaults to udp.
+	Network SyslogNetwork
+	// Addr is the host:port of the syslog receiver.
+	Addr string
+	// TLSConfig is used only when Network is tls.
+	TLSConfig *tls.Config
+	// Facility defaults to SyslogFacilityUser.
+	Facility SyslogFacility
+	// AppName defaults to "minio".
+	AppName string
+	// Hostname is used when a log entry carries no node name,
+	// defaults to the local hostname.
+	Hostname string
+	// DialTimeout defaults to 5 seconds.
+	DialTimeout time.Duration
+	// Redaction is applied to every message before it is sent.
+	Redaction *RedactionPolicy
+}
+
+// SyslogForwarder writes console log messages received from
+// GetLogs as RFC 5424 syslog messages.
+type SyslogForwarder struct {
+	opts SyslogOptions
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewSyslogForwarder - returns a new syslog forwarder, the connection
+// to the receiver is established lazily upon the first write.
+func NewSyslogForwarder(opts SyslogOptions) (*SyslogForwarder, error) {
+	if opts.Addr == "" {
+		return nil, ErrInvalidArgument("Syslog address cannot be empty.")
+	}
+	switch opts.Network {
+	case "":
+		opts.Network = SyslogUDP
+	case SyslogUDP, SyslogTCP, SyslogTLS:
+	default:
+		return nil, ErrInvalidArgument("Unsupported syslog network " + string(opts.Network))
+	}
+	if opts.Facility == 0 {
+		opts.Facility = SyslogFacilityUser
+	}
+	if opts.AppName == "" {
+		opts.AppName = "minio"
+	}
+	if opts.Hostname == "" {
+		opts.Hostname, _ = os.Hostname()
+	}
+	if opts.DialTimeout == 0 {
+		opts.DialTimeout = 5 * time.Second
+	}
+	return &SyslogForwarder{opts: opts}, nil
+}
+
+// Forward - reads log messages from logCh and writes them to the syslog
+// receiver until logCh is closed or ctx is canceled.
+func (f *SyslogForwarder) Forward(ctx context.Context, logCh <-chan LogInfo) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case info, ok := <-logCh:
+			if !ok {
+				return nil
+			}
+			if info.Err != nil {
+				return info.Err
+			}
+			if err := f.Write(info); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Write - sends a single log message, stream connections are
+// re-established once if the write fails.
+func (f *SyslogForwarder) Write(info LogInfo) error {
+	redacted, err := f.opts.Redaction.Redact(info)
+	if err != nil {
+		return err
+	}
+	msg := f.format(redacted.(LogInfo), time.Now().UTC())
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := 0; i < 2; i++ {
+		if f.conn == nil {
+			if f.conn, err = f.dial(); err != nil {
+				return err
+			}
+		}
+		if err = f.writeFrame(msg); err == nil {
+			return nil
+		}
+		f.conn.Close()
+		f.conn = nil
+	}
+	return err
+}
+
+// Close - closes the connection to the syslog receiver.
+func (f *SyslogForwarder) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.conn == nil {
+		return nil
+	}
+	err := f.conn.Close()
+	f.conn = nil
+	return err
+}
+
+func (f *SyslogForwarder) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: f.opts.DialTimeout}
+	if f.opts.Network == SyslogTLS {
+		return tls.DialWithDialer(dialer, "tcp", f.opts.Addr, f.opts.TLSConfig)
+	}
+	return dialer.Dial(string(f.opts.Network), f.opts.Addr)
+}
+
+// writeFrame - UDP carries one message per datagram, stream transports
+// use octet counting framing as described in RFC 6587 and RFC 5425.
+func (f *SyslogForwarder) writeFrame(msg string) error {
+	if f.opts.Network != SyslogUDP {
+		msg = strconv.Itoa(len(msg)) + " " + msg
+	}
+	n, err := f.conn.Write([]byte(msg))
+	if err != nil {
+		return err
+	}
+	if n != len(msg) {
+		return errors.New("short write to syslog receiver")
+	}
+	return nil
+}
+
+// syslogSeverity - maps MinIO log levels to syslog severities.
+func syslogSeverity(level string) int {
+	switch strings.ToUpper(level) {
+	case "FATAL":
+		return 2 // critical
+	case "ERROR":
+		return 3
+	case "WARNING", "WARN":
+		return 4
+	case "DEBUG":
+		return 7
+	default:
+		return 6 // informational
+	}
+}
+
+// format - renders a log message in RFC 5424 format.
+func (f *SyslogForwarder) format(info LogInfo, now time.Time) string {
+	pri := int(f.opts.Facility)*8 + syslogSeverity(info.Level)
+
+	ts := now
+	if t, err := time.Parse(time.RFC3339Nano, info.Time); err == nil {
+		ts = t.UTC()
+	}
+
+	hostname := info.NodeName
+	if hostname == "" {
+		hostname = f.opts.Hostname
+	}
+
+	msg := info.ConsoleMsg
+	if msg == "" {
+		msg = info.Message
+	}
+	if msg == "" && info.Trace != nil {
+		msg = info.Trace.Message
+	}
+
+	var b strings.Builder
+	b.WriteString("<" + strconv.Itoa(pri) + ">1 ")
+	b.WriteString(ts.Format("2006-01-02T15:04:05.000000Z07:00") + " ")
+	b.WriteString(syslogHeaderField(hostname, 255) + " ")
+	b.WriteString(syslogHeaderField(f.opts.AppName, 48) + " ")
+	b.WriteString("- ") // PROCID
+	b.WriteString(syslogHeaderField(info.LogKind, 32) + " ")
+	b.WriteString(syslogStructuredData(info))
+	if msg != "" {
+		b.WriteString(" " + strings.TrimRight(msg, "\r\n"))
+	}
+	return b.String()
+}
+
+// syslogHeaderField - header fields are printable US-ASCII without
+// spaces, an empty value is represented by the NILVALUE "-".
+func syslogHeaderField(s string, maxLen int) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+		return r
+	}, s)
+	if len(s) > maxLen {
+		s = s[:maxLen]
+	}
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// syslogStructuredData - preserves the structured log entry fields as
+// SD-PARAMs of a single SD-ELEMENT.
+func syslogStructuredData(info LogInfo) string {
+	var params []string
+	add := func(name, value string) {
+		if value == "" {
+			return
+		}
+		r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+		params = append(params, name+`="`+r.Replace(value)+`"`)
+	}
+	add("deploymentid", info.DeploymentID)
+	add("level", info.Level)
+	add("requestID", info.RequestID)
+	add("remotehost", info.RemoteHost)
+	add("host", info.Host)
+	add("userAgent", info.UserAgent)
+	if info.API != nil {
+		add("api", info.API.Name)
+		if info.API.Args != nil {
+			add("bucket", info.API.Args.Bucket)
+			add("object", info.API.Args.Object)
+		}
+	}
+	if info.Trace != nil && len(info.Trace.Source) > 0 {
+		add("source", info.Trace.Source[0])
+	}
+	if len(params) == 0 {
+		return "-"
+	}
+	return "[" + syslogSDID + " " + strings.Join(params, " ") + "]"
+}
@@ -0,0 +1,69 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSyslogFormat(t *testing.T) {
+	f, err := NewSyslogForwarder(SyslogOptions{Addr: "localhost:514", Hostname: "local"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		info     LogInfo
+		expected string
+	}{
+		{
+			info:     LogInfo{ConsoleMsg: "Status: 4 Online, 0 Offline.\n"},
+			expected: `<14>1 2021-05-01T10:00:00.000000Z local minio - - - Status: 4 Online, 0 Offline.`,
+		},
+		{
+			info: LogInfo{
+				logEntry: logEntry{
+					DeploymentID: "abc",
+					Level:        "ERROR",
+					LogKind:      "MINIO",
+					Time:         "2021-05-01T09:00:00.5Z",
+					API:          &logAPI{Name: "PutObject", Args: &logArgs{Bucket: "b]1", Object: `o"1`}},
+					Trace:        &logTrace{Message: "disk not found"},
+				},
+				NodeName: "node 1",
+			},
+			expected: `<11>1 2021-05-01T09:00:00.500000Z node1 minio - MINIO [minio@32473 deploymentid="abc" level="ERROR" api="PutObject" bucket="b\]1" object="o\"1"] disk not found`,
+		},
+	}
+
+	for i, testCase := range testCases {
+		if got := f.format(testCase.info, now); got != testCase.expected {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestSyslogInvalidOptions(t *testing.T) {
+	if _, err := NewSyslogForwarder(SyslogOptions{}); err == nil {
+		t.Fatal("expected error for empty address")
+	}
+	if _, err := NewSyslogForwarder(SyslogOptions{Addr: "localhost:514", Network: "quic"}); err == nil {
+		t.Fatal("expected error for unsupported network")
+	}
+}
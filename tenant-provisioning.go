@@ -0,0 +1,269 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrTenantResourceExists is returned by ProvisionTenant when a policy or
+// the user of the tenant already exists, which provisioning would
+// overwrite and a rollback remove.
+var ErrTenantResourceExists = errors.New("tenant resource already exists")
+
+// TenantBucketStore creates and removes buckets, buckets are managed
+// through the S3 API which is not part of the admin client, e.g. an
+// adapter around a minio-go client.
+type TenantBucketStore interface {
+	MakeBucket(ctx context.Context, bucket string) error
+	RemoveBucket(ctx context.Context, bucket string) error
+}
+
+// TenantSpec - resources to create for a new tenant.
+type TenantSpec struct {
+	// Prefix, if set, every bucket, policy and user name must start
+	// with, policies may then only allow S3 actions on these buckets.
+	Prefix string
+
+	// Buckets are created through BucketStore, which is mandatory
+	// when buckets are requested.
+	Buckets     []string
+	BucketStore TenantBucketStore
+	// Quota applied to every bucket, if set.
+	Quota *BucketQuota
+
+	// Policies to create, keyed by policy name, all of them are
+	// attached to the tenant user.
+	Policies map[string]json.RawMessage
+
+	// AccessKey and SecretKey of the tenant user.
+	AccessKey string
+	SecretKey string
+
+	// ServiceAccount, if set, is created for the tenant user.
+	ServiceAccount *AddServiceAccountReq
+
+	// Replication, if set, is configured as remote target of
+	// Replication.SourceBucket which must be one of Buckets.
+	Replication *BucketTarget
+}
+
+// TenantProvisionResult - resources created by ProvisionTenant.
+type TenantProvisionResult struct {
+	Buckets        []string
+	Policies       []string
+	User           string
+	ServiceAccount *Credentials
+	ReplicationARN string
+}
+
+// TenantProvisionError - returned when provisioning fails, resources
+// created before the failing step are removed again; failures to
+// remove them are reported in RollbackErrs.
+type TenantProvisionError struct {
+	Step         string
+	Err          error
+	RollbackErrs []error
+}
+
+func (e TenantProvisionError) Error() string {
+	msg := fmt.Sprintf("tenant provisioning failed at %s: %v", e.Step, e.Err)
+	if len(e.RollbackErrs) > 0 {
+		errs := make([]string, len(e.RollbackErrs))
+		for i, err := range e.RollbackErrs {
+			errs[i] = err.Error()
+		}
+		msg += fmt.Sprintf(" (rollback incomplete: %s)", strings.Join(errs, "; "))
+	}
+	return msg
+}
+
+// Unwrap - returns the error of the failing step.
+func (e TenantProvisionError) Unwrap() error {
+	return e.Err
+}
+
+// validate - checks the spec before anything is created.
+func (spec TenantSpec) validate() error {
+	if spec.AccessKey == "" || spec.SecretKey == "" {
+		return ErrInvalidArgument("Tenant access key and secret key cannot be empty.")
+	}
+	if len(spec.Buckets) > 0 && spec.BucketStore == nil {
+		return ErrInvalidArgument("Tenant bucket store cannot be nil when buckets are requested.")
+	}
+	if spec.Replication != nil {
+		var found bool
+		for _, bucket := range spec.Buckets {
+			found = found || bucket == spec.Replication.SourceBucket
+		}
+		if !found {
+			return ErrInvalidArgument("Replication source bucket must be one of the tenant buckets.")
+		}
+	}
+	if spec.Prefix == "" {
+		return nil
+	}
+	scope := TenantScopedClient{prefix: spec.Prefix}
+	if err := scope.checkScope("bucket", spec.Buckets...); err != nil {
+		return err
+	}
+	for name, policy := range spec.Policies {
+		if err := scope.checkScope("policy", name); err != nil {
+			return err
+		}
+		if err := scope.checkPolicy(policy); err != nil {
+			return err
+		}
+	}
+	return scope.checkScope("user", spec.AccessKey)
+}
+
+// checkConflicts - fails if a policy or the user of spec already exists.
+func (spec TenantSpec) checkConflicts(ctx context.Context, adm *AdminClient) error {
+	for name := range spec.Policies {
+		_, err := adm.InfoCannedPolicy(ctx, name)
+		if err == nil {
+			return fmt.Errorf("%w: policy %q", ErrTenantResourceExists, name)
+		}
+		if ToErrorResponse(err).Code != string(ErrCodeNoSuchPolicy) {
+			return err
+		}
+	}
+	_, err := adm.GetUserInfo(ctx, spec.AccessKey)
+	if err == nil {
+		return fmt.Errorf("%w: user %q", ErrTenantResourceExists, spec.AccessKey)
+	}
+	if ToErrorResponse(err).Code != string(ErrCodeNoSuchUser) {
+		return err
+	}
+	return nil
+}
+
+// tenantRollbackTimeout bounds each step undone by a failed ProvisionTenant.
+const tenantRollbackTimeout = 30 * time.Second
+
+// ProvisionTenant - creates the buckets, quotas, policies, user, service
+// account and replication target of a tenant. Existing policies or an
+// existing user are never overwritten, ErrTenantResourceExists is
+// returned before anything is created instead. Provisioning stops at
+// the first failure and everything created until then is removed in
+// reverse order, so a failed call leaves no partial tenant behind.
+// Rollback is not bound to ctx so that it also runs after ctx is canceled,
+// each of its steps is bound to tenantRollbackTimeout instead.
+func (adm *AdminClient) ProvisionTenant(ctx context.Context, spec TenantSpec) (TenantProvisionResult, error) {
+	var result TenantProvisionResult
+	if err := spec.validate(); err != nil {
+		return result, err
+	}
+	if err := spec.checkConflicts(ctx, adm); err != nil {
+		return result, err
+	}
+
+	var undo []func(context.Context) error
+	fail := func(step string, err error) (TenantProvisionResult, error) {
+		perr := TenantProvisionError{Step: step, Err: err}
+		for i := len(undo) - 1; i >= 0; i-- {
+			// Every step is bounded on its own, so that one hung call
+			// does not keep the others from being undone.
+			rctx, cancel := context.WithTimeout(context.Background(), tenantRollbackTimeout)
+			if rerr := undo[i](rctx); rerr != nil {
+				perr.RollbackErrs = append(perr.RollbackErrs, rerr)
+			}
+			cancel()
+		}
+		return TenantProvisionResult{}, perr
+	}
+
+	for _, bucket := range spec.Buckets {
+		bucket := bucket
+		if err := spec.BucketStore.MakeBucket(ctx, bucket); err != nil {
+			return fail("bucket "+bucket, err)
+		}
+		undo = append(undo, func(ctx context.Context) error {
+			return spec.BucketStore.RemoveBucket(ctx, bucket)
+		})
+		result.Buckets = append(result.Buckets, bucket)
+
+		if spec.Quota != nil {
+			if err := adm.SetBucketQuota(ctx, bucket, spec.Quota); err != nil {
+				return fail("quota "+bucket, err)
+			}
+			undo = append(undo, func(ctx context.Context) error {
+				return adm.SetBucketQuota(ctx, bucket, &BucketQuota{})
+			})
+		}
+	}
+
+	policies := make([]string, 0, len(spec.Policies))
+	for name := range spec.Policies {
+		policies = append(policies, name)
+	}
+	sort.Strings(policies)
+	for _, name := range policies {
+		name := name
+		if err := adm.AddCannedPolicy(ctx, name, spec.Policies[name]); err != nil {
+			return fail("policy "+name, err)
+		}
+		undo = append(undo, func(ctx context.Context) error {
+			return adm.RemoveCannedPolicy(ctx, name)
+		})
+		result.Policies = append(result.Policies, name)
+	}
+
+	if err := adm.AddUser(ctx, spec.AccessKey, spec.SecretKey); err != nil {
+		return fail("user "+spec.AccessKey, err)
+	}
+	undo = append(undo, func(ctx context.Context) error {
+		return adm.RemoveUser(ctx, spec.AccessKey)
+	})
+	result.User = spec.AccessKey
+
+	if len(policies) > 0 {
+		if err := adm.SetPolicy(ctx, strings.Join(policies, ","), spec.AccessKey, false); err != nil {
+			return fail("policy attachment", err)
+		}
+	}
+
+	if spec.ServiceAccount != nil {
+		req := *spec.ServiceAccount
+		req.TargetUser = spec.AccessKey
+		creds, err := adm.AddServiceAccount(ctx, req)
+		if err != nil {
+			return fail("service account", err)
+		}
+		undo = append(undo, func(ctx context.Context) error {
+			return adm.DeleteServiceAccount(ctx, creds.AccessKey)
+		})
+		result.ServiceAccount = &creds
+	}
+
+	if spec.Replication != nil {
+		arn, err := adm.SetRemoteTarget(ctx, spec.Replication.SourceBucket, spec.Replication)
+		if err != nil {
+			return fail("replication target", err)
+		}
+		result.ReplicationARN = arn
+	}
+
+	return result, nil
+}
@@ -0,0 +1,147 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"reflect"
+	"testing"
+)
+
+type testBucketStore struct {
+	calls []string
+}
+
+func (s *testBucketStore) MakeBucket(ctx context.Context, bucket string) error {
+	s.calls = append(s.calls, "make "+bucket)
+	return nil
+}
+
+func (s *testBucketStore) RemoveBucket(ctx context.Context, bucket string) error {
+	s.calls = append(s.calls, "remove "+bucket)
+	return nil
+}
+
+func TestProvisionTenantRollback(t *testing.T) {
+	var calls []string
+	existing := make(map[string]bool)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		api := path.Base(r.URL.Path)
+		calls = append(calls, r.Method+" "+api)
+		switch {
+		case api == "add-service-account":
+			w.WriteHeader(http.StatusForbidden)
+		case api == "info-canned-policy" && !existing[r.URL.Query().Get("name")]:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"Code":"XMinioAdminNoSuchPolicy"}`))
+		case api == "user-info" && !existing[r.URL.Query().Get("accessKey")]:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"Code":"XMinioAdminNoSuchUser"}`))
+		case api == "info-canned-policy" || api == "user-info":
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := &testBucketStore{}
+	spec := TenantSpec{
+		Prefix:         "acme-",
+		Buckets:        []string{"acme-data"},
+		BucketStore:    store,
+		Quota:          &BucketQuota{Quota: 1 << 30, Type: HardQuota},
+		Policies:       map[string]json.RawMessage{"acme-rw": json.RawMessage(`{}`)},
+		AccessKey:      "acme-admin",
+		SecretKey:      "acme-secret",
+		ServiceAccount: &AddServiceAccountReq{},
+	}
+
+	bad := spec
+	bad.AccessKey = "admin"
+	if _, err = adm.ProvisionTenant(context.Background(), bad); !errors.Is(err, ErrOutsideTenantScope) {
+		t.Fatalf("expected ErrOutsideTenantScope, got %v", err)
+	}
+	bad = spec
+	bad.Policies = map[string]json.RawMessage{"acme-rw": json.RawMessage(`{"Statement":[{"Effect":"Allow","Action":["admin:*"],"Resource":["arn:aws:s3:::acme-data"]}]}`)}
+	if _, err = adm.ProvisionTenant(context.Background(), bad); !errors.Is(err, ErrOutsideTenantScope) {
+		t.Fatalf("expected ErrOutsideTenantScope for an admin policy, got %v", err)
+	}
+	bad.Policies = map[string]json.RawMessage{"acme-rw": json.RawMessage(`{"Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::other/*"]}]}`)}
+	if _, err = adm.ProvisionTenant(context.Background(), bad); !errors.Is(err, ErrOutsideTenantScope) {
+		t.Fatalf("expected ErrOutsideTenantScope for a cross-tenant policy, got %v", err)
+	}
+	if len(calls) != 0 || len(store.calls) != 0 {
+		t.Fatal("invalid spec must not create any resources")
+	}
+
+	_, err = adm.ProvisionTenant(context.Background(), spec)
+	var perr TenantProvisionError
+	if !errors.As(err, &perr) || perr.Step != "service account" || len(perr.RollbackErrs) != 0 {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	expected := []string{
+		"GET info-canned-policy",
+		"GET user-info",
+		"PUT set-bucket-quota",
+		"PUT add-canned-policy",
+		"PUT add-user",
+		"PUT set-user-or-group-policy",
+		"PUT add-service-account",
+		"DELETE remove-user",
+		"DELETE remove-canned-policy",
+		"PUT set-bucket-quota",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, calls)
+	}
+	if !reflect.DeepEqual(store.calls, []string{"make acme-data", "remove acme-data"}) {
+		t.Errorf("unexpected bucket calls %v", store.calls)
+	}
+
+	// Existing objects are neither overwritten nor removed.
+	for _, name := range []string{"acme-rw", "acme-admin"} {
+		existing = map[string]bool{name: true}
+		calls, store.calls = nil, nil
+		if _, err = adm.ProvisionTenant(context.Background(), spec); !errors.Is(err, ErrTenantResourceExists) {
+			t.Fatalf("expected ErrTenantResourceExists for %s, got %v", name, err)
+		}
+		for _, call := range calls {
+			if call[:4] != "GET " {
+				t.Errorf("expected no changes for existing %s, got %v", name, calls)
+				break
+			}
+		}
+		if len(store.calls) != 0 {
+			t.Errorf("expected no buckets for existing %s, got %v", name, store.calls)
+		}
+	}
+}
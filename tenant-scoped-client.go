@@ -0,0 +1,278 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrOutsideTenantScope is returned when a TenantScopedClient is asked
+// to operate on a user, group, policy or bucket of another tenant.
+var ErrOutsideTenantScope = errors.New("outside of tenant scope")
+
+// TenantScopedClient - wraps an AdminClient and restricts IAM and quota
+// operations to names starting with the tenant prefix, e.g. "acme-".
+// Every call is validated before it is sent, so a TenantScopedClient
+// may be handed to per-tenant automation.
+type TenantScopedClient struct {
+	adm    *AdminClient
+	prefix string
+}
+
+// NewTenantScopedClient - returns a client restricted to names with prefix.
+func NewTenantScopedClient(adm *AdminClient, prefix string) (*TenantScopedClient, error) {
+	if adm == nil {
+		return nil, ErrInvalidArgument("Admin client cannot be nil.")
+	}
+	if strings.TrimSpace(prefix) == "" {
+		return nil, ErrInvalidArgument("Tenant prefix cannot be empty.")
+	}
+	return &TenantScopedClient{adm: adm, prefix: prefix}, nil
+}
+
+// Prefix - returns the tenant prefix.
+func (t *TenantScopedClient) Prefix() string {
+	return t.prefix
+}
+
+// InScope - returns true if name belongs to the tenant.
+func (t *TenantScopedClient) InScope(name string) bool {
+	return len(name) > len(t.prefix) && strings.HasPrefix(name, t.prefix)
+}
+
+func (t *TenantScopedClient) checkScope(kind string, names ...string) error {
+	for _, name := range names {
+		if !t.InScope(name) {
+			return fmt.Errorf("%w: %s %q does not start with %q", ErrOutsideTenantScope, kind, name, t.prefix)
+		}
+	}
+	return nil
+}
+
+// AddUser - adds a tenant user.
+func (t *TenantScopedClient) AddUser(ctx context.Context, accessKey, secretKey string) error {
+	if err := t.checkScope("user", accessKey); err != nil {
+		return err
+	}
+	return t.adm.AddUser(ctx, accessKey, secretKey)
+}
+
+// SetUserStatus - enables or disables a tenant user.
+func (t *TenantScopedClient) SetUserStatus(ctx context.Context, accessKey string, status AccountStatus) error {
+	if err := t.checkScope("user", accessKey); err != nil {
+		return err
+	}
+	return t.adm.SetUserStatus(ctx, accessKey, status)
+}
+
+// RemoveUser - removes a tenant user.
+func (t *TenantScopedClient) RemoveUser(ctx context.Context, accessKey string) error {
+	if err := t.checkScope("user", accessKey); err != nil {
+		return err
+	}
+	return t.adm.RemoveUser(ctx, accessKey)
+}
+
+// GetUserInfo - returns info of a tenant user.
+func (t *TenantScopedClient) GetUserInfo(ctx context.Context, name string) (UserInfo, error) {
+	if err := t.checkScope("user", name); err != nil {
+		return UserInfo{}, err
+	}
+	return t.adm.GetUserInfo(ctx, name)
+}
+
+// ListUsers - lists the users of the tenant only.
+func (t *TenantScopedClient) ListUsers(ctx context.Context) (map[string]UserInfo, error) {
+	users, err := t.adm.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for name := range users {
+		if !t.InScope(name) {
+			delete(users, name)
+		}
+	}
+	return users, nil
+}
+
+// UpdateGroupMembers - adds/removes tenant users to/from a tenant group.
+func (t *TenantScopedClient) UpdateGroupMembers(ctx context.Context, g GroupAddRemove) error {
+	if err := t.checkScope("group", g.Group); err != nil {
+		return err
+	}
+	if err := t.checkScope("user", g.Members...); err != nil {
+		return err
+	}
+	return t.adm.UpdateGroupMembers(ctx, g)
+}
+
+// GetGroupDescription - returns the description of a tenant group.
+func (t *TenantScopedClient) GetGroupDescription(ctx context.Context, group string) (*GroupDesc, error) {
+	if err := t.checkScope("group", group); err != nil {
+		return nil, err
+	}
+	return t.adm.GetGroupDescription(ctx, group)
+}
+
+// SetGroupStatus - enables or disables a tenant group.
+func (t *TenantScopedClient) SetGroupStatus(ctx context.Context, group string, status GroupStatus) error {
+	if err := t.checkScope("group", group); err != nil {
+		return err
+	}
+	return t.adm.SetGroupStatus(ctx, group, status)
+}
+
+// ListGroups - lists the groups of the tenant only.
+func (t *TenantScopedClient) ListGroups(ctx context.Context) ([]string, error) {
+	groups, err := t.adm.ListGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scoped := groups[:0]
+	for _, group := range groups {
+		if t.InScope(group) {
+			scoped = append(scoped, group)
+		}
+	}
+	return scoped, nil
+}
+
+// AddCannedPolicy - adds a tenant policy, which may only allow S3
+// actions on the buckets of the tenant.
+func (t *TenantScopedClient) AddCannedPolicy(ctx context.Context, policyName string, policy []byte) error {
+	if err := t.checkScope("policy", policyName); err != nil {
+		return err
+	}
+	if err := t.checkPolicy(policy); err != nil {
+		return err
+	}
+	return t.adm.AddCannedPolicy(ctx, policyName, policy)
+}
+
+// checkPolicy - rejects policies allowing admin or other non-S3 actions,
+// or access to resources outside of the buckets of the tenant. NotAction
+// and NotResource are rejected in allow statements as they grant
+// everything but the listed actions or resources.
+func (t *TenantScopedClient) checkPolicy(policy []byte) error {
+	var doc struct {
+		Statement []struct {
+			Effect      string
+			Action      policyActionSet
+			Resource    policyActionSet
+			NotAction   json.RawMessage
+			NotResource json.RawMessage
+		}
+	}
+	if err := json.Unmarshal(policy, &doc); err != nil {
+		return ErrInvalidArgument("Invalid policy document: " + err.Error())
+	}
+	for _, st := range doc.Statement {
+		if !strings.EqualFold(st.Effect, "Allow") {
+			continue
+		}
+		if len(st.NotAction) > 0 || len(st.NotResource) > 0 {
+			return fmt.Errorf("%w: policy allows NotAction or NotResource", ErrOutsideTenantScope)
+		}
+		for _, action := range st.Action {
+			if !strings.HasPrefix(strings.ToLower(action), "s3:") {
+				return fmt.Errorf("%w: policy allows action %q", ErrOutsideTenantScope, action)
+			}
+		}
+		if len(st.Resource) == 0 {
+			return fmt.Errorf("%w: policy allows actions on all resources", ErrOutsideTenantScope)
+		}
+		for _, resource := range st.Resource {
+			bucket := strings.TrimPrefix(resource, "arn:aws:s3:::")
+			if i := strings.IndexByte(bucket, '/'); i >= 0 {
+				bucket = bucket[:i]
+			}
+			// Wildcards are only allowed after the prefix.
+			if bucket == resource || !t.InScope(bucket) {
+				return fmt.Errorf("%w: policy allows access to %q outside of buckets starting with %q", ErrOutsideTenantScope, resource, t.prefix)
+			}
+		}
+	}
+	return nil
+}
+
+// RemoveCannedPolicy - removes a tenant policy.
+func (t *TenantScopedClient) RemoveCannedPolicy(ctx context.Context, policyName string) error {
+	if err := t.checkScope("policy", policyName); err != nil {
+		return err
+	}
+	return t.adm.RemoveCannedPolicy(ctx, policyName)
+}
+
+// InfoCannedPolicy - returns a tenant policy document.
+func (t *TenantScopedClient) InfoCannedPolicy(ctx context.Context, policyName string) ([]byte, error) {
+	if err := t.checkScope("policy", policyName); err != nil {
+		return nil, err
+	}
+	return t.adm.InfoCannedPolicy(ctx, policyName)
+}
+
+// ListCannedPolicies - lists the policies of the tenant only.
+func (t *TenantScopedClient) ListCannedPolicies(ctx context.Context) (map[string]json.RawMessage, error) {
+	policies, err := t.adm.ListCannedPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for name := range policies {
+		if !t.InScope(name) {
+			delete(policies, name)
+		}
+	}
+	return policies, nil
+}
+
+// SetPolicy - attaches tenant policies to a tenant user or group,
+// policyName may be a comma separated list of policies.
+func (t *TenantScopedClient) SetPolicy(ctx context.Context, policyName, entityName string, isGroup bool) error {
+	entity := "user"
+	if isGroup {
+		entity = "group"
+	}
+	if err := t.checkScope(entity, entityName); err != nil {
+		return err
+	}
+	if policyName != "" {
+		if err := t.checkScope("policy", strings.Split(policyName, ",")...); err != nil {
+			return err
+		}
+	}
+	return t.adm.SetPolicy(ctx, policyName, entityName, isGroup)
+}
+
+// GetBucketQuota - returns the quota of a tenant bucket.
+func (t *TenantScopedClient) GetBucketQuota(ctx context.Context, bucket string) (BucketQuota, error) {
+	if err := t.checkScope("bucket", bucket); err != nil {
+		return BucketQuota{}, err
+	}
+	return t.adm.GetBucketQuota(ctx, bucket)
+}
+
+// SetBucketQuota - sets the quota of a tenant bucket.
+func (t *TenantScopedClient) SetBucketQuota(ctx context.Context, bucket string, quota *BucketQuota) error {
+	if err := t.checkScope("bucket", bucket); err != nil {
+		return err
+	}
+	return t.adm.SetBucketQuota(ctx, bucket, quota)
+}
@@ -0,0 +1,86 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestTenantScopedClient(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewTenantScopedClient(adm, ""); err == nil {
+		t.Fatal("expected error for empty prefix")
+	}
+	tenant, err := NewTenantScopedClient(adm, "acme-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	denied := []error{
+		tenant.RemoveUser(ctx, "globex-bob"),
+		tenant.RemoveUser(ctx, "acme-"),
+		tenant.SetPolicy(ctx, "acme-rw,readwrite", "acme-bob", false),
+		tenant.SetPolicy(ctx, "acme-rw", "admins", true),
+		tenant.UpdateGroupMembers(ctx, GroupAddRemove{Group: "acme-dev", Members: []string{"acme-bob", "eve"}}),
+		tenant.SetBucketQuota(ctx, "globex-data", &BucketQuota{}),
+		tenant.AddCannedPolicy(ctx, "acme-evil", []byte(`{"Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`)),
+		tenant.AddCannedPolicy(ctx, "acme-evil", []byte(`{"Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::acme*/*"]}]}`)),
+		tenant.AddCannedPolicy(ctx, "acme-evil", []byte(`{"Statement":[{"Effect":"Allow","Action":"admin:*","Resource":"arn:aws:s3:::acme-data"}]}`)),
+		tenant.AddCannedPolicy(ctx, "acme-evil", []byte(`{"Statement":[{"Effect":"Allow","Action":"*","Resource":"arn:aws:s3:::acme-data"}]}`)),
+		tenant.AddCannedPolicy(ctx, "acme-evil", []byte(`{"Statement":[{"Effect":"Allow","Action":"s3:*","NotResource":"arn:aws:s3:::acme-data"}]}`)),
+	}
+	for i, err := range denied {
+		if !errors.Is(err, ErrOutsideTenantScope) {
+			t.Errorf("case %d: expected ErrOutsideTenantScope, got %v", i, err)
+		}
+	}
+	if calls != 0 {
+		t.Fatalf("out of scope calls reached the server %d times", calls)
+	}
+
+	if err = tenant.SetPolicy(ctx, "acme-rw,acme-ro", "acme-dev", true); err != nil {
+		t.Fatal(err)
+	}
+	policy := `{"Version":"2012-10-17","Statement":[
+		{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":["arn:aws:s3:::acme-*/*"]},
+		{"Effect":"Deny","Action":["admin:*"],"Resource":["arn:aws:s3:::*"]}]}`
+	if err = tenant.AddCannedPolicy(ctx, "acme-rw", []byte(policy)); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected in scope calls to reach the server, got %d calls", calls)
+	}
+}
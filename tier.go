@@ -34,7 +34,7 @@ func (adm *AdminClient) AddTier(ctx context.Context, cfg *TierConfig) error {
 		return err
 	}
 
-	encData, err := EncryptData(adm.getSecretKey(), data)
+	encData, err := adm.encryptData(data)
 	if err != nil {
 		return err
 	}
@@ -80,7 +80,7 @@ func (adm *AdminClient) ListTiers(ctx context.Context) ([]*TierConfig, error) {
 		return tiers, err
 	}
 
-	err = json.Unmarshal(b, &tiers)
+	err = adm.unmarshalResponse(resp, b, &tiers)
 	if err != nil {
 		return tiers, err
 	}
@@ -103,7 +103,7 @@ func (adm *AdminClient) EditTier(ctx context.Context, tierName string, creds Tie
 	}
 
 	var encData []byte
-	encData, err = EncryptData(adm.getSecretKey(), data)
+	encData, err = adm.encryptData(data)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,115 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// MaxClockSkew - the largest clock difference between nodes, or clients
+// and nodes, at which request signatures are still accepted.
+const MaxClockSkew = 15 * time.Minute
+
+// TimePeer - clock difference of a node to a peer, measured with the
+// Date header of an HTTP request, so Offset has a resolution of one
+// second.
+type TimePeer struct {
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+	// Offset is the peer clock minus the local clock in seconds.
+	Offset float64 `json:"offset" yaml:"offset"`
+	// RTT is the round-trip time of the request in seconds.
+	RTT float64 `json:"rtt" yaml:"rtt"`
+}
+
+// TimeInfo - time synchronization of a node.
+type TimeInfo struct {
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	// Time is the local time when the information was collected.
+	Time time.Time `json:"time" yaml:"time"`
+	// NTPSynchronized is true if the kernel clock is disciplined by
+	// an NTP daemon.
+	NTPSynchronized bool `json:"ntp_synchronized" yaml:"ntp_synchronized"`
+	// NTPOffset is the offset of the kernel clock to its time source
+	// and NTPMaxError the maximum error, both in seconds.
+	NTPOffset   float64 `json:"ntp_offset" yaml:"ntp_offset"`
+	NTPMaxError float64 `json:"ntp_max_error" yaml:"ntp_max_error"`
+
+	Peers []TimePeer `json:"peers,omitempty" yaml:"peers,omitempty"`
+}
+
+// MaxSkew - returns the largest absolute clock offset to a peer.
+func (t TimeInfo) MaxSkew() time.Duration {
+	var skew float64
+	for _, p := range t.Peers {
+		if p.Error == "" {
+			skew = math.Max(skew, math.Abs(p.Offset))
+		}
+	}
+	return time.Duration(skew * float64(time.Second))
+}
+
+// GetTimeInfo returns the NTP synchronization status of a node and its
+// clock offset to peers, given as URLs like http://node2:9000.
+func GetTimeInfo(ctx context.Context, addr string, peers []string) TimeInfo {
+	info := TimeInfo{Addr: addr, Time: time.Now().UTC()}
+	var err error
+	info.NTPSynchronized, info.NTPOffset, info.NTPMaxError, err = kernelNTPStatus()
+	if err != nil {
+		info.Error = err.Error()
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	for _, peer := range peers {
+		info.Peers = append(info.Peers, measurePeerTime(ctx, client, peer))
+	}
+	return info
+}
+
+// measurePeerTime - estimates the offset of a peer by comparing its
+// Date header to the local time in the middle of the request.
+func measurePeerTime(ctx context.Context, client *http.Client, peer string) TimePeer {
+	p := TimePeer{Addr: peer}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, strings.TrimSuffix(peer, "/")+"/minio/health/live", nil)
+	if err != nil {
+		p.Error = err.Error()
+		return p
+	}
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		p.Error = err.Error()
+		return p
+	}
+	rtt := time.Since(start)
+	resp.Body.Close()
+
+	date, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		p.Error = "invalid Date header: " + err.Error()
+		return p
+	}
+	p.RTT = rtt.Seconds()
+	p.Offset = date.Sub(start.Add(rtt / 2)).Seconds()
+	return p
+}
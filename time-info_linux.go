@@ -0,0 +1,44 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build linux
+
+package madmin
+
+import "syscall"
+
+// Kernel clock status flags, see adjtimex(2).
+const (
+	staUnsync = 0x0040
+	staNano   = 0x2000
+	timeError = 5
+)
+
+// kernelNTPStatus - reads the kernel clock discipline with a read-only
+// adjtimex call.
+func kernelNTPStatus() (synced bool, offset, maxError float64, err error) {
+	var tx syscall.Timex
+	state, err := syscall.Adjtimex(&tx)
+	if err != nil {
+		return false, 0, 0, err
+	}
+	synced = state != timeError && tx.Status&staUnsync == 0
+	offset = float64(tx.Offset) / 1e6
+	if tx.Status&staNano != 0 {
+		offset = float64(tx.Offset) / 1e9
+	}
+	return synced, offset, float64(tx.Maxerror) / 1e6, nil
+}
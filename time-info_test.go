@@ -0,0 +1,58 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetTimeInfo(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/minio/health/live" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Date", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	}))
+	defer ts.Close()
+
+	info := GetTimeInfo(context.Background(), "node1:9000", []string{ts.URL, "http://127.0.0.1:1"})
+	if len(info.Peers) != 2 {
+		t.Fatalf("unexpected peers %+v", info.Peers)
+	}
+	if p := info.Peers[0]; p.Error != "" || math.Abs(p.Offset-3600) > 2 || p.RTT <= 0 {
+		t.Errorf("unexpected peer %+v", p)
+	}
+	if info.Peers[1].Error == "" {
+		t.Error("expected an error for an unreachable peer")
+	}
+	if skew := info.MaxSkew(); skew < 59*time.Minute || skew > 61*time.Minute {
+		t.Errorf("unexpected skew %s", skew)
+	}
+
+	var health HealthInfo
+	health.Sys.TimeInfo = []TimeInfo{info}
+	s := health.Summarize()
+	if s.Status != HealthStatusRed || len(s.Reasons) == 0 || !strings.Contains(s.Reasons[0], "node1:9000") {
+		t.Errorf("expected red status for clock skew, got %+v", s)
+	}
+}
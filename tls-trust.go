@@ -0,0 +1,292 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// tlsTrustProbeTimeout bounds the handshake with every peer.
+const tlsTrustProbeTimeout = 10 * time.Second
+
+// TLSCertInfo - a certificate of a chain or CA bundle.
+type TLSCertInfo struct {
+	Subject   string    `json:"subject" yaml:"subject"`
+	Issuer    string    `json:"issuer" yaml:"issuer"`
+	NotBefore time.Time `json:"not_before" yaml:"not_before"`
+	NotAfter  time.Time `json:"not_after" yaml:"not_after"`
+	IsCA      bool      `json:"is_ca" yaml:"is_ca"`
+	// Fingerprint is the hex encoded SHA-256 of the certificate.
+	Fingerprint string `json:"fingerprint" yaml:"fingerprint"`
+}
+
+// validAt - returns true if t is within the validity of the certificate.
+func (c TLSCertInfo) validAt(t time.Time) bool {
+	return !t.Before(c.NotBefore) && !t.After(c.NotAfter)
+}
+
+func newTLSCertInfo(cert *x509.Certificate) TLSCertInfo {
+	sum := sha256.Sum256(cert.Raw)
+	return TLSCertInfo{
+		Subject:     cert.Subject.String(),
+		Issuer:      cert.Issuer.String(),
+		NotBefore:   cert.NotBefore,
+		NotAfter:    cert.NotAfter,
+		IsCA:        cert.IsCA,
+		Fingerprint: hex.EncodeToString(sum[:]),
+	}
+}
+
+// TLSPeerTrust - whether a node trusts the certificate chain presented
+// by a peer.
+type TLSPeerTrust struct {
+	Addr string `json:"addr" yaml:"addr"`
+	// Error is set if the handshake failed, e.g. because the peer
+	// rejected the client certificate of the node.
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+	// Chain is the chain presented by the peer, leaf first.
+	Chain   []TLSCertInfo `json:"chain,omitempty" yaml:"chain,omitempty"`
+	Trusted bool          `json:"trusted" yaml:"trusted"`
+	// VerifyError is why the chain is not trusted.
+	VerifyError string `json:"verify_error,omitempty" yaml:"verify_error,omitempty"`
+}
+
+// TLSTrustInfo - the internode TLS trust of a node, its CA bundle and
+// the verification of the chains of its peers against it.
+type TLSTrustInfo struct {
+	Addr  string `json:"addr" yaml:"addr"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+	// Time is when the chains were verified.
+	Time time.Time `json:"time" yaml:"time"`
+	// BundleFingerprint identifies the CA bundle, nodes with equal
+	// bundles have equal fingerprints. Empty if the node trusts the
+	// system roots.
+	BundleFingerprint string         `json:"bundle_fingerprint,omitempty" yaml:"bundle_fingerprint,omitempty"`
+	CABundle          []TLSCertInfo  `json:"ca_bundle,omitempty" yaml:"ca_bundle,omitempty"`
+	Peers             []TLSPeerTrust `json:"peers,omitempty" yaml:"peers,omitempty"`
+}
+
+// Problems - returns the peers the node cannot establish trusted
+// connections with and the expired certificates of its bundle and of
+// the chains of its peers.
+func (t TLSTrustInfo) Problems() []string {
+	var problems []string
+	for _, c := range t.CABundle {
+		if !c.validAt(t.Time) {
+			problems = append(problems, fmt.Sprintf("%s: CA certificate %q of the bundle is not valid at %s", t.Addr, c.Subject, t.Time.Format(time.RFC3339)))
+		}
+	}
+	for _, p := range t.Peers {
+		for i, c := range p.Chain {
+			if c.validAt(t.Time) {
+				continue
+			}
+			kind := "certificate"
+			if i > 0 {
+				kind = "intermediate certificate"
+			}
+			problems = append(problems, fmt.Sprintf("%s: %s %q presented by %s is only valid from %s to %s", t.Addr, kind, c.Subject, p.Addr,
+				c.NotBefore.Format(time.RFC3339), c.NotAfter.Format(time.RFC3339)))
+		}
+		switch {
+		case p.Error != "":
+			problems = append(problems, fmt.Sprintf("%s: TLS handshake with %s failed: %s", t.Addr, p.Addr, p.Error))
+		case !p.Trusted:
+			problems = append(problems, fmt.Sprintf("%s: does not trust the certificate chain of %s: %s", t.Addr, p.Addr, p.VerifyError))
+		}
+	}
+	return problems
+}
+
+// TLSBundleMismatches - returns a description of the groups of nodes
+// using different CA bundles, empty if all nodes use the same bundle.
+func TLSBundleMismatches(infos []TLSTrustInfo) string {
+	groups := make(map[string][]string)
+	for _, t := range infos {
+		if t.Error == "" {
+			groups[t.BundleFingerprint] = append(groups[t.BundleFingerprint], t.Addr)
+		}
+	}
+	if len(groups) < 2 {
+		return ""
+	}
+	list := make([]string, 0, len(groups))
+	for fingerprint, nodes := range groups {
+		if fingerprint == "" {
+			fingerprint = "system roots"
+		} else if len(fingerprint) > 12 {
+			fingerprint = fingerprint[:12]
+		}
+		sort.Strings(nodes)
+		list = append(list, fmt.Sprintf("%s (%s)", strings.Join(nodes, ", "), fingerprint))
+	}
+	sort.Strings(list)
+	return "nodes use different CA bundles: " + strings.Join(list, "; ")
+}
+
+// LoadCABundle - loads the PEM encoded certificates of the files in dir,
+// e.g. the CAs directory of the server certificates. Files without
+// certificates are skipped.
+func LoadCABundle(dir string) ([]*x509.Certificate, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var certs []*x509.Certificate
+	for _, fi := range files {
+		if fi.IsDir() {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for {
+			var block *pem.Block
+			block, data = pem.Decode(data)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", fi.Name(), err)
+			}
+			certs = append(certs, cert)
+		}
+	}
+	return certs, nil
+}
+
+// GetTLSTrustInfo returns the internode TLS trust of a node: every peer,
+// given as an https URL like https://node2:9000, is verified against
+// caBundle, the system roots if empty. clientCert, if set, is presented
+// to peers requiring mutual TLS.
+func GetTLSTrustInfo(ctx context.Context, addr string, caBundle []*x509.Certificate, clientCert *tls.Certificate, peers []string) TLSTrustInfo {
+	info := TLSTrustInfo{Addr: addr, Time: time.Now().UTC()}
+
+	var roots *x509.CertPool
+	if len(caBundle) == 0 {
+		var err error
+		if roots, err = x509.SystemCertPool(); err != nil {
+			info.Error = err.Error()
+			return info
+		}
+	} else {
+		roots = x509.NewCertPool()
+		fingerprints := make([]string, 0, len(caBundle))
+		for _, cert := range caBundle {
+			roots.AddCert(cert)
+			c := newTLSCertInfo(cert)
+			info.CABundle = append(info.CABundle, c)
+			fingerprints = append(fingerprints, c.Fingerprint)
+		}
+		sort.Strings(fingerprints)
+		sum := sha256.Sum256([]byte(strings.Join(fingerprints, "")))
+		info.BundleFingerprint = hex.EncodeToString(sum[:])
+	}
+
+	for _, peer := range peers {
+		info.Peers = append(info.Peers, verifyTLSPeer(ctx, roots, clientCert, peer, info.Time))
+	}
+	return info
+}
+
+// verifyTLSPeer - completes a handshake with peer and verifies the
+// chain it presents against roots at now.
+func verifyTLSPeer(ctx context.Context, roots *x509.CertPool, clientCert *tls.Certificate, peer string, now time.Time) TLSPeerTrust {
+	p := TLSPeerTrust{Addr: peer}
+	u, err := url.Parse(peer)
+	if err != nil {
+		p.Error = err.Error()
+		return p
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "443")
+	}
+
+	var chain []*x509.Certificate
+	cfg := &tls.Config{
+		ServerName: u.Hostname(),
+		// The chain is verified below to report why it is not trusted.
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			for _, raw := range rawCerts {
+				cert, err := x509.ParseCertificate(raw)
+				if err != nil {
+					return err
+				}
+				chain = append(chain, cert)
+			}
+			return nil
+		},
+	}
+	if clientCert != nil {
+		cfg.Certificates = []tls.Certificate{*clientCert}
+	}
+	ctx, cancel := context.WithTimeout(ctx, tlsTrustProbeTimeout)
+	defer cancel()
+	dialer := tls.Dialer{Config: cfg}
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		p.Error = err.Error()
+	} else {
+		conn.Close()
+	}
+	if len(chain) == 0 {
+		if p.Error == "" {
+			p.VerifyError = "no certificate presented"
+		}
+		return p
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain {
+		p.Chain = append(p.Chain, newTLSCertInfo(cert))
+	}
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = chain[0].Verify(x509.VerifyOptions{
+		DNSName:       u.Hostname(),
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+	})
+	if err != nil {
+		p.VerifyError = err.Error()
+	} else {
+		p.Trusted = true
+	}
+	return p
+}
@@ -0,0 +1,163 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, name string, parent *testCert, isCA bool, notAfter time.Time) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-2 * time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	if isCA {
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		tmpl.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	}
+	issuer, signer := tmpl, key
+	if parent != nil {
+		issuer, signer = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, issuer, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, key: key}
+}
+
+func TestGetTLSTrustInfo(t *testing.T) {
+	year := time.Now().Add(365 * 24 * time.Hour)
+	ca := newTestCert(t, "ca", nil, true, year)
+	otherCA := newTestCert(t, "other ca", nil, true, year)
+	intermediate := newTestCert(t, "intermediate", ca, true, year)
+	expired := newTestCert(t, "expired intermediate", ca, true, time.Now().Add(-time.Hour))
+
+	serve := func(chain ...*testCert) *httptest.Server {
+		cert := tls.Certificate{PrivateKey: chain[0].key}
+		for _, c := range chain {
+			cert.Certificate = append(cert.Certificate, c.cert.Raw)
+		}
+		ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		ts.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+		ts.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+		ts.StartTLS()
+		return ts
+	}
+	good := serve(newTestCert(t, "node2", intermediate, false, year), intermediate)
+	defer good.Close()
+	bad := serve(newTestCert(t, "node3", expired, false, year), expired)
+	defer bad.Close()
+
+	info := GetTLSTrustInfo(context.Background(), "node1:9000", []*x509.Certificate{ca.cert}, nil, []string{good.URL, bad.URL})
+	if info.Error != "" || info.BundleFingerprint == "" || len(info.CABundle) != 1 {
+		t.Fatalf("unexpected trust info %+v", info)
+	}
+	if len(info.Peers) != 2 {
+		t.Fatalf("expected two peers, got %+v", info.Peers)
+	}
+	if p := info.Peers[0]; !p.Trusted || p.Error != "" || len(p.Chain) != 2 || p.Chain[1].Subject != "CN=intermediate" {
+		t.Errorf("expected the chain of %s to be trusted, got %+v", p.Addr, p)
+	}
+	if p := info.Peers[1]; p.Trusted || p.VerifyError == "" {
+		t.Errorf("expected the chain of %s not to be trusted, got %+v", p.Addr, p)
+	}
+	problems := info.Problems()
+	if len(problems) != 2 || !strings.Contains(problems[0], `intermediate certificate "CN=expired intermediate"`) {
+		t.Errorf("expected the expired intermediate to be reported, got %v", problems)
+	}
+
+	other := GetTLSTrustInfo(context.Background(), "node2:9000", []*x509.Certificate{otherCA.cert}, nil, []string{good.URL})
+	if len(other.Peers) != 1 || other.Peers[0].Trusted {
+		t.Errorf("expected the chain not to verify against another bundle, got %+v", other.Peers)
+	}
+
+	var health HealthInfo
+	health.Sys.TLSTrust = []TLSTrustInfo{info, other}
+	s := health.Summarize()
+	if s.Status != HealthStatusRed || len(s.Reasons) != 4 {
+		t.Errorf("expected the untrusted chains to turn the status red, got %+v", s)
+	}
+	if len(s.ConfigWarnings) != 1 || !strings.HasPrefix(s.ConfigWarnings[0], "nodes use different CA bundles: node1:9000") {
+		t.Errorf("expected the different bundles to be reported, got %v", s.ConfigWarnings)
+	}
+}
+
+func TestLoadCABundle(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cas")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	year := time.Now().Add(365 * 24 * time.Hour)
+	var bundle []byte
+	for _, name := range []string{"ca1", "ca2"} {
+		bundle = append(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: newTestCert(t, name, nil, true, year).cert.Raw})...)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "bundle.crt"), bundle, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "README"), []byte("not a certificate"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	certs, err := LoadCABundle(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(certs) != 2 || certs[1].Subject.CommonName != "ca2" {
+		t.Errorf("expected two certificates, got %d", len(certs))
+	}
+}
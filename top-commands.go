@@ -18,7 +18,6 @@ package madmin
 
 import (
 	"context"
-	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -118,7 +117,7 @@ func (adm *AdminClient) TopLocksWithOpts(ctx context.Context, opts TopLockOpts)
 	}
 
 	var lockEntries LockEntries
-	err = json.Unmarshal(response, &lockEntries)
+	err = adm.unmarshalResponse(resp, response, &lockEntries)
 	return lockEntries, err
 }
 
@@ -0,0 +1,84 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// PrefixUsage - usage of a single prefix as collected by the scanner.
+type PrefixUsage struct {
+	Prefix        string `json:"prefix"`
+	Size          uint64 `json:"size"`
+	ObjectsCount  uint64 `json:"objectsCount"`
+	VersionsCount uint64 `json:"versionsCount,omitempty"`
+}
+
+// TopPrefixesInfo - largest prefixes of a bucket.
+type TopPrefixesInfo struct {
+	// LastUpdate is the time of the scanner cycle the data is from.
+	LastUpdate time.Time     `json:"lastUpdate"`
+	Bucket     string        `json:"bucket"`
+	Depth      int           `json:"depth"`
+	BySize     []PrefixUsage `json:"bySize"`
+	ByCount    []PrefixUsage `json:"byCount"`
+}
+
+// TopPrefixes - returns the largest prefixes of bucket by size and by
+// object count, prefixes are aggregated to depth path segments. Data
+// comes from the scanner, so it is as recent as the last scan cycle.
+func (adm *AdminClient) TopPrefixes(ctx context.Context, bucket string, depth int) (TopPrefixesInfo, error) {
+	if bucket == "" {
+		return TopPrefixesInfo{}, ErrInvalidArgument("Bucket name cannot be empty.")
+	}
+	if depth < 1 {
+		return TopPrefixesInfo{}, ErrInvalidArgument("Prefix depth must be at least 1.")
+	}
+
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+	queryValues.Set("depth", strconv.Itoa(depth))
+
+	// Execute GET on /minio/admin/v3/scanner/top-prefixes
+	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{
+		relPath:     adminAPIPrefix + "/scanner/top-prefixes",
+		queryValues: queryValues,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return TopPrefixesInfo{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return TopPrefixesInfo{}, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return TopPrefixesInfo{}, err
+	}
+	var info TopPrefixesInfo
+	if err = adm.unmarshalResponse(resp, b, &info); err != nil {
+		return TopPrefixesInfo{}, err
+	}
+	return info, nil
+}
@@ -0,0 +1,74 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestTopPrefixes(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		q := r.URL.Query()
+		if r.URL.Path != libraryAdminURLPrefix+adminAPIPrefix+"/scanner/top-prefixes" || q.Get("bucket") != "photos" || q.Get("depth") != "2" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"Code":"InvalidArgument","Message":"unexpected request"}`))
+			return
+		}
+		w.Write([]byte(`{"lastUpdate":"2021-05-01T00:00:00Z","bucket":"photos","depth":2,` +
+			`"bySize":[{"prefix":"2021/05/","size":4096,"objectsCount":2},{"prefix":"2021/04/","size":1024,"objectsCount":8}],` +
+			`"byCount":[{"prefix":"2021/04/","size":1024,"objectsCount":8,"versionsCount":10},{"prefix":"2021/05/","size":4096,"objectsCount":2}]}`))
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if _, err = adm.TopPrefixes(ctx, "", 2); err == nil {
+		t.Fatal("expected an empty bucket to be rejected")
+	}
+	if _, err = adm.TopPrefixes(ctx, "photos", 0); err == nil {
+		t.Fatal("expected a depth below 1 to be rejected")
+	}
+	if requests != 0 {
+		t.Fatal("expected invalid arguments not to be sent")
+	}
+
+	info, err := adm.TopPrefixes(ctx, "photos", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.LastUpdate.Equal(time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)) || info.Bucket != "photos" || info.Depth != 2 {
+		t.Fatalf("unexpected info %+v", info)
+	}
+	if len(info.BySize) != 2 || info.BySize[0].Prefix != "2021/05/" || info.BySize[0].Size != 4096 {
+		t.Fatalf("unexpected prefixes by size %+v", info.BySize)
+	}
+	if len(info.ByCount) != 2 || info.ByCount[0].ObjectsCount != 8 || info.ByCount[0].VersionsCount != 10 {
+		t.Fatalf("unexpected prefixes by count %+v", info.ByCount)
+	}
+}
@@ -0,0 +1,181 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Kinds of TopologyNode.
+const (
+	TopologyKindCluster = "cluster"
+	TopologyKindPool    = "pool"
+	TopologyKindSet     = "set"
+	TopologyKindServer  = "server"
+	TopologyKindDrive   = "drive"
+	TopologyKindRemote  = "remote"
+)
+
+// Kinds of TopologyEdge.
+const (
+	// TopologyEdgeContains links a cluster to its pools and a pool to
+	// its erasure sets.
+	TopologyEdgeContains = "contains"
+	// TopologyEdgeMember links an erasure set to its drives.
+	TopologyEdgeMember = "member"
+	// TopologyEdgeHosts links a server to its drives.
+	TopologyEdgeHosts = "hosts"
+	// TopologyEdgeReplicates links the cluster to a replication target,
+	// labeled with the source bucket.
+	TopologyEdgeReplicates = "replicates"
+)
+
+// TopologyNode - a vertex of a TopologyGraph.
+type TopologyNode struct {
+	ID    string            `json:"id"`
+	Kind  string            `json:"kind"`
+	Label string            `json:"label"`
+	Attrs map[string]string `json:"attrs,omitempty"`
+}
+
+// TopologyEdge - a directed edge of a TopologyGraph.
+type TopologyEdge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Kind  string `json:"kind"`
+	Label string `json:"label,omitempty"`
+}
+
+// TopologyGraph - cluster topology ready to be rendered, a tree of
+// cluster, pools, sets and drives, with servers hosting the drives and
+// replication targets of the cluster.
+type TopologyGraph struct {
+	Nodes []TopologyNode `json:"nodes"`
+	Edges []TopologyEdge `json:"edges"`
+}
+
+// ExportTopologyGraph - returns the topology of the cluster, built from
+// ServerInfo and the replication targets of all buckets.
+func (adm *AdminClient) ExportTopologyGraph(ctx context.Context) (TopologyGraph, error) {
+	info, err := adm.ServerInfo(ctx)
+	if err != nil {
+		return TopologyGraph{}, err
+	}
+	targets, err := adm.ListRemoteTargets(ctx, "", string(ReplicationService))
+	if err != nil {
+		return TopologyGraph{}, err
+	}
+	return NewTopologyGraph(info, targets), nil
+}
+
+// NewTopologyGraph - builds the topology graph of a cluster.
+func NewTopologyGraph(info InfoMessage, targets []BucketTarget) TopologyGraph {
+	var g TopologyGraph
+	seen := make(map[string]bool)
+	node := func(id, kind, label string, attrs map[string]string) {
+		if !seen[id] {
+			seen[id] = true
+			g.Nodes = append(g.Nodes, TopologyNode{ID: id, Kind: kind, Label: label, Attrs: attrs})
+		}
+	}
+	edge := func(from, to, kind, label string) {
+		g.Edges = append(g.Edges, TopologyEdge{From: from, To: to, Kind: kind, Label: label})
+	}
+
+	const cluster = "cluster"
+	node(cluster, TopologyKindCluster, "cluster", map[string]string{
+		"deploymentID": info.DeploymentID,
+		"mode":         info.Mode,
+	})
+
+	servers := append([]ServerProperties(nil), info.Servers...)
+	sort.Slice(servers, func(i, j int) bool { return servers[i].Endpoint < servers[j].Endpoint })
+	for _, s := range servers {
+		serverID := "server:" + s.Endpoint
+		node(serverID, TopologyKindServer, s.Endpoint, map[string]string{
+			"state":   s.State,
+			"version": s.Version,
+		})
+		for _, d := range s.Disks {
+			driveID := "drive:" + d.Endpoint
+			node(driveID, TopologyKindDrive, d.DrivePath, map[string]string{
+				"state":   d.State,
+				"healing": strconv.FormatBool(d.Healing),
+			})
+			edge(serverID, driveID, TopologyEdgeHosts, "")
+			if d.PoolIndex < 0 || d.SetIndex < 0 {
+				continue
+			}
+			poolID := "pool:" + strconv.Itoa(d.PoolIndex+1)
+			setID := poolID + "/set:" + strconv.Itoa(d.SetIndex+1)
+			if !seen[poolID] {
+				node(poolID, TopologyKindPool, "pool "+strconv.Itoa(d.PoolIndex+1), nil)
+				edge(cluster, poolID, TopologyEdgeContains, "")
+			}
+			if !seen[setID] {
+				node(setID, TopologyKindSet, "set "+strconv.Itoa(d.SetIndex+1), nil)
+				edge(poolID, setID, TopologyEdgeContains, "")
+			}
+			edge(setID, driveID, TopologyEdgeMember, "")
+		}
+	}
+
+	for _, t := range targets {
+		remoteID := "remote:" + t.Endpoint + "/" + t.TargetBucket
+		node(remoteID, TopologyKindRemote, t.Endpoint+"/"+t.TargetBucket, map[string]string{
+			"arn": t.Arn,
+		})
+		edge(cluster, remoteID, TopologyEdgeReplicates, t.SourceBucket)
+	}
+	return g
+}
+
+// DOT - returns the graph in the Graphviz DOT language.
+func (g TopologyGraph) DOT() string {
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+	}
+	shapes := map[string]string{
+		TopologyKindCluster: "doubleoctagon",
+		TopologyKindPool:    "folder",
+		TopologyKindSet:     "box3d",
+		TopologyKindServer:  "component",
+		TopologyKindDrive:   "cylinder",
+		TopologyKindRemote:  "cloud",
+	}
+	var b strings.Builder
+	b.WriteString("digraph topology {\n")
+	for _, n := range g.Nodes {
+		fmt.Fprintf(&b, "  %s [label=%s, shape=%s];\n", quote(n.ID), quote(n.Label), shapes[n.Kind])
+	}
+	for _, e := range g.Edges {
+		style := ""
+		switch e.Kind {
+		case TopologyEdgeHosts:
+			style = ", style=dotted"
+		case TopologyEdgeReplicates:
+			style = ", style=dashed"
+		}
+		fmt.Fprintf(&b, "  %s -> %s [label=%s%s];\n", quote(e.From), quote(e.To), quote(e.Label), style)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,104 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestExportTopologyGraph(t *testing.T) {
+	info := InfoMessage{
+		Mode:         "online",
+		DeploymentID: "d1",
+		Servers: []ServerProperties{
+			{Endpoint: "node2:9000", Disks: []Disk{
+				{Endpoint: "http://node2:9000/d1", DrivePath: "/d1", PoolIndex: 1, SetIndex: 0},
+			}},
+			{Endpoint: "node1:9000", Disks: []Disk{
+				{Endpoint: "http://node1:9000/d1", DrivePath: "/d1", PoolIndex: 0, SetIndex: 0},
+				{Endpoint: "http://node1:9000/d2", DrivePath: "/d2", PoolIndex: 0, SetIndex: 1},
+				{Endpoint: "http://node1:9000/d3", DrivePath: "/d3", PoolIndex: -1, SetIndex: -1},
+			}},
+		},
+	}
+	targets := []BucketTarget{{SourceBucket: "photos", Endpoint: "dr:9000", TargetBucket: "photos-dr", Arn: "arn:minio:replication::1:photos-dr"}}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/info"):
+			json.NewEncoder(w).Encode(info)
+		case strings.HasSuffix(r.URL.Path, "/list-remote-targets") && r.URL.Query().Get("type") == "replication":
+			json.NewEncoder(w).Encode(targets)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := adm.ExportTopologyGraph(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kinds := make(map[string]int)
+	for _, n := range g.Nodes {
+		kinds[n.Kind]++
+	}
+	for kind, count := range map[string]int{
+		TopologyKindCluster: 1,
+		TopologyKindServer:  2,
+		TopologyKindDrive:   4,
+		TopologyKindPool:    2,
+		TopologyKindSet:     3,
+		TopologyKindRemote:  1,
+	} {
+		if kinds[kind] != count {
+			t.Errorf("expected %d %s nodes, got %d", count, kind, kinds[kind])
+		}
+	}
+	if g.Nodes[1].ID != "server:node1:9000" {
+		t.Errorf("expected servers in order, got %s first", g.Nodes[1].ID)
+	}
+	edges := make(map[string]int)
+	for _, e := range g.Edges {
+		edges[e.Kind]++
+	}
+	if edges[TopologyEdgeHosts] != 4 || edges[TopologyEdgeMember] != 3 || edges[TopologyEdgeContains] != 5 || edges[TopologyEdgeReplicates] != 1 {
+		t.Errorf("unexpected edges %v", edges)
+	}
+
+	dot := g.DOT()
+	for _, s := range []string{
+		"digraph topology {",
+		`"pool:1" -> "pool:1/set:2" [label=""];`,
+		`"cluster" -> "remote:dr:9000/photos-dr" [label="photos", style=dashed];`,
+	} {
+		if !strings.Contains(dot, s) {
+			t.Errorf("expected %q in DOT output:\n%s", s, dot)
+		}
+	}
+}
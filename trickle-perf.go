@@ -0,0 +1,259 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Trickle probes of the S3 API, drive probes are named "drive:" followed
+// by the drive path.
+const (
+	TrickleProbePut = "put"
+	TrickleProbeGet = "get"
+)
+
+// trickleRecentSamples is the number of latest samples compared with
+// the baseline, a single slow sample is not a regression.
+const trickleRecentSamples = 5
+
+// TrickleProberOptions - options for NewTrickleProber.
+type TrickleProberOptions struct {
+	// Bucket the PUT and GET probes write a tiny object to, it must
+	// exist. The S3 probes are skipped if empty.
+	Bucket string
+	// DrivePaths are probed with small synchronous writes, which
+	// requires running on a node of the cluster.
+	DrivePaths []string
+	// ObjectSize of the S3 and drive probes, defaults to 4KiB.
+	ObjectSize int
+	// Interval between probe rounds, defaults to 10 seconds.
+	Interval time.Duration
+	// Window is the number of samples the baseline is computed from,
+	// defaults to 360, one hour at the default interval.
+	Window int
+	// RegressionFactor - a probe regressed if the median of its latest
+	// samples exceeds its baseline by this factor, defaults to 2.
+	RegressionFactor float64
+	// OnError is called with probe errors, if set.
+	OnError func(err error)
+}
+
+// TrickleBaseline - rolling latency baseline of a probe, latencies are
+// in seconds.
+type TrickleBaseline struct {
+	Probe   string `json:"probe"`
+	Samples int    `json:"samples"`
+	Errors  uint64 `json:"errors"`
+	// Baseline is the median latency of the window.
+	Baseline float64 `json:"baseline"`
+	P99      float64 `json:"p99"`
+	// Recent is the median latency of the latest samples.
+	Recent    float64   `json:"recent"`
+	Regressed bool      `json:"regressed"`
+	LastError string    `json:"last_error,omitempty"`
+	LastProbe time.Time `json:"last_probe"`
+}
+
+// trickleWindow - ring buffer of the latest latencies of a probe.
+type trickleWindow struct {
+	samples   []float64
+	next      int
+	errors    uint64
+	lastError string
+	lastProbe time.Time
+}
+
+func (w *trickleWindow) add(latency float64, size int) {
+	if len(w.samples) < size {
+		w.samples = append(w.samples, latency)
+	} else {
+		w.samples[w.next] = latency
+	}
+	w.next = (w.next + 1) % size
+}
+
+// recent - returns the latest n samples.
+func (w *trickleWindow) recent(n int) []float64 {
+	if n > len(w.samples) {
+		n = len(w.samples)
+	}
+	recent := make([]float64, 0, n)
+	for i := 1; i <= n; i++ {
+		recent = append(recent, w.samples[(w.next-i+len(w.samples))%len(w.samples)])
+	}
+	return recent
+}
+
+// TrickleProber continuously runs low-rate probes, tiny PUTs and GETs
+// and small drive writes, and keeps rolling latency baselines to detect
+// regressions without running disruptive benchmarks.
+type TrickleProber struct {
+	adm     *AdminClient
+	opts    TrickleProberOptions
+	payload []byte
+
+	mu      sync.Mutex
+	probes  map[string]*trickleWindow
+	order   []string
+	running sync.Mutex
+}
+
+// NewTrickleProber - returns a new trickle prober.
+func NewTrickleProber(adm *AdminClient, opts TrickleProberOptions) (*TrickleProber, error) {
+	if opts.Bucket == "" && len(opts.DrivePaths) == 0 {
+		return nil, ErrInvalidArgument("Bucket or drive paths to probe are required.")
+	}
+	if opts.ObjectSize <= 0 {
+		opts.ObjectSize = 4 << 10
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = 10 * time.Second
+	}
+	if opts.Window <= 0 {
+		opts.Window = 360
+	}
+	if opts.RegressionFactor <= 1 {
+		opts.RegressionFactor = 2
+	}
+	return &TrickleProber{
+		adm:     adm,
+		opts:    opts,
+		payload: bytes.Repeat([]byte{'m'}, opts.ObjectSize),
+		probes:  make(map[string]*trickleWindow),
+	}, nil
+}
+
+// Run - probes every interval until ctx is canceled.
+func (p *TrickleProber) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.opts.Interval)
+	defer ticker.Stop()
+	for {
+		p.RunOnce(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce - runs every probe once, one after the other.
+func (p *TrickleProber) RunOnce(ctx context.Context) {
+	p.running.Lock()
+	defer p.running.Unlock()
+
+	if p.opts.Bucket != "" {
+		object := p.opts.Bucket + "/.minio-trickle-probe"
+		p.probe(TrickleProbePut, func() error {
+			return p.adm.s3Request(ctx, http.MethodPut, object, "", nil, p.payload, nil)
+		})
+		p.probe(TrickleProbeGet, func() error {
+			return p.adm.s3Request(ctx, http.MethodGet, object, "", nil, nil, nil)
+		})
+	}
+	for _, path := range p.opts.DrivePaths {
+		path := path
+		p.probe("drive:"+path, func() error { return p.writeDrive(path) })
+	}
+}
+
+// writeDrive - writes and removes a small file with synchronous IO.
+func (p *TrickleProber) writeDrive(path string) error {
+	name := filepath.Join(path, ".minio-trickle-probe")
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC|os.O_SYNC, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(p.payload)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	os.Remove(name)
+	return err
+}
+
+// probe - times fn and records its latency, failed probes are counted
+// but do not affect the baseline.
+func (p *TrickleProber) probe(name string, fn func() error) {
+	start := time.Now()
+	err := fn()
+	latency := time.Since(start)
+
+	p.mu.Lock()
+	w, ok := p.probes[name]
+	if !ok {
+		w = &trickleWindow{samples: make([]float64, 0, p.opts.Window)}
+		p.probes[name] = w
+		p.order = append(p.order, name)
+	}
+	w.lastProbe = start
+	if err != nil {
+		w.errors++
+		w.lastError = err.Error()
+	} else {
+		w.add(latency.Seconds(), p.opts.Window)
+	}
+	p.mu.Unlock()
+
+	if err != nil && p.opts.OnError != nil {
+		p.opts.OnError(fmt.Errorf("trickle probe %s: %w", name, err))
+	}
+}
+
+// Baselines - returns the baselines of all probes, in the order they
+// first ran.
+func (p *TrickleProber) Baselines() []TrickleBaseline {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	baselines := make([]TrickleBaseline, 0, len(p.order))
+	for _, name := range p.order {
+		w := p.probes[name]
+		b := TrickleBaseline{
+			Probe:     name,
+			Samples:   len(w.samples),
+			Errors:    w.errors,
+			Baseline:  quantile(w.samples, 0.5),
+			P99:       quantile(w.samples, 0.99),
+			Recent:    quantile(w.recent(trickleRecentSamples), 0.5),
+			LastError: w.lastError,
+			LastProbe: w.lastProbe,
+		}
+		// Too few samples for a baseline to compare with.
+		b.Regressed = len(w.samples) >= 4*trickleRecentSamples && b.Recent > b.Baseline*p.opts.RegressionFactor
+		baselines = append(baselines, b)
+	}
+	return baselines
+}
+
+// Regressions - returns the baselines of the probes which regressed.
+func (p *TrickleProber) Regressions() []TrickleBaseline {
+	var regressed []TrickleBaseline
+	for _, b := range p.Baselines() {
+		if b.Regressed {
+			regressed = append(regressed, b)
+		}
+	}
+	return regressed
+}
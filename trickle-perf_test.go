@@ -0,0 +1,101 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"testing"
+)
+
+func TestTrickleProber(t *testing.T) {
+	var puts, gets int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bucket/.minio-trickle-probe" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			atomic.AddInt32(&puts, 1)
+		case http.MethodGet:
+			atomic.AddInt32(&gets, 1)
+		}
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "trickle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	u, _ := url.Parse(ts.URL)
+	adm, err := New(u.Host, "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewTrickleProber(adm, TrickleProberOptions{Bucket: "bucket", DrivePaths: []string{dir}, Window: 40})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		p.RunOnce(context.Background())
+	}
+	if puts != 3 || gets != 3 {
+		t.Errorf("expected 3 PUTs and GETs, got %d and %d", puts, gets)
+	}
+	baselines := p.Baselines()
+	if len(baselines) != 3 || baselines[0].Probe != TrickleProbePut || baselines[2].Probe != "drive:"+dir {
+		t.Fatalf("unexpected baselines %+v", baselines)
+	}
+	for _, b := range baselines {
+		if b.Samples != 3 || b.Errors != 0 || b.Regressed {
+			t.Errorf("unexpected baseline %+v", b)
+		}
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+		t.Errorf("drive probe left %d files behind", len(files))
+	}
+}
+
+func TestTrickleRegression(t *testing.T) {
+	p, err := NewTrickleProber(nil, TrickleProberOptions{Bucket: "bucket", Window: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &trickleWindow{samples: make([]float64, 0, 30)}
+	p.probes["put"], p.order = w, []string{"put"}
+	for i := 0; i < 50; i++ {
+		w.add(0.001, 30)
+	}
+	if len(p.Regressions()) != 0 {
+		t.Fatal("unexpected regression with steady latency")
+	}
+	for i := 0; i < trickleRecentSamples; i++ {
+		w.add(0.01, 30)
+	}
+	regressed := p.Regressions()
+	if len(regressed) != 1 || regressed[0].Recent != 0.01 || regressed[0].Baseline != 0.001 || regressed[0].Samples != 30 {
+		t.Errorf("unexpected regressions %+v", regressed)
+	}
+}
@@ -0,0 +1,182 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// The unit types below always marshal to JSON numbers, so the wire
+// format shared with servers and older clients is unchanged. They
+// unmarshal from numbers as well as human readable strings, and their
+// String methods return the human readable form.
+
+// ByteSize - a size or rate in bytes.
+type ByteSize uint64
+
+// Byte size units.
+const (
+	Byte ByteSize = 1
+	KiB           = 1024 * Byte
+	MiB           = 1024 * KiB
+	GiB           = 1024 * MiB
+	TiB           = 1024 * GiB
+	PiB           = 1024 * TiB
+)
+
+var byteSizeUnits = map[string]float64{
+	"":  1,
+	"b": 1,
+	"k": 1e3, "kb": 1e3, "ki": 1 << 10, "kib": 1 << 10,
+	"m": 1e6, "mb": 1e6, "mi": 1 << 20, "mib": 1 << 20,
+	"g": 1e9, "gb": 1e9, "gi": 1 << 30, "gib": 1 << 30,
+	"t": 1e12, "tb": 1e12, "ti": 1 << 40, "tib": 1 << 40,
+	"p": 1e15, "pb": 1e15, "pi": 1 << 50, "pib": 1 << 50,
+	// No single letter exabyte unit, "1e3" is not a size.
+	"eb": 1e18, "ei": 1 << 60, "eib": 1 << 60,
+}
+
+// ParseByteSize - parses sizes like "10 GiB", "1.5TB" or "4096", SI
+// units (KB, GB) are powers of 1000 and IEC units (KiB, GiB) powers
+// of 1024.
+func ParseByteSize(s string) (ByteSize, error) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return !unicode.IsDigit(r) && r != '.'
+	})
+	num, unit := s, ""
+	if i >= 0 {
+		num, unit = s[:i], strings.TrimSpace(s[i:])
+	}
+	multiplier, ok := byteSizeUnits[strings.ToLower(unit)]
+	if !ok || num == "" {
+		return 0, ErrInvalidArgument("Invalid byte size " + strconv.Quote(s))
+	}
+	f, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0, ErrInvalidArgument("Invalid byte size " + strconv.Quote(s))
+	}
+	f *= multiplier
+	if f >= math.MaxUint64 {
+		return 0, ErrInvalidArgument("Byte size " + strconv.Quote(s) + " overflows.")
+	}
+	return ByteSize(f), nil
+}
+
+// String - returns the size with IEC units, e.g. "10 GiB".
+func (b ByteSize) String() string {
+	return formatBytes(float64(b))
+}
+
+// UnmarshalJSON - accepts a number of bytes or a size string.
+func (b *ByteSize) UnmarshalJSON(data []byte) error {
+	if s, ok := jsonString(data); ok {
+		v, err := ParseByteSize(s)
+		if err != nil {
+			return err
+		}
+		*b = v
+		return nil
+	}
+	var v uint64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*b = ByteSize(v)
+	return nil
+}
+
+// UnixMillis - a point in time as milliseconds since the Unix epoch.
+type UnixMillis int64
+
+// NewUnixMillis - returns t as UnixMillis.
+func NewUnixMillis(t time.Time) UnixMillis {
+	return UnixMillis(t.UnixNano() / int64(time.Millisecond))
+}
+
+// Time - returns the time in UTC.
+func (m UnixMillis) Time() time.Time {
+	return time.Unix(0, int64(m)*int64(time.Millisecond)).UTC()
+}
+
+// String - returns the time in RFC3339 format.
+func (m UnixMillis) String() string {
+	return m.Time().Format(time.RFC3339Nano)
+}
+
+// UnmarshalJSON - accepts milliseconds or an RFC3339 time string.
+func (m *UnixMillis) UnmarshalJSON(data []byte) error {
+	if s, ok := jsonString(data); ok {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return err
+		}
+		*m = NewUnixMillis(t)
+		return nil
+	}
+	var v int64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = UnixMillis(v)
+	return nil
+}
+
+// Duration - a time.Duration, marshaled as nanoseconds.
+type Duration time.Duration
+
+// String - returns the duration like time.Duration, e.g. "1m30s".
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+// UnmarshalJSON - accepts nanoseconds or a duration string like "1m30s".
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if s, ok := jsonString(data); ok {
+		v, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		*d = Duration(v)
+		return nil
+	}
+	var v int64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// jsonString - returns the value of a JSON string.
+func jsonString(data []byte) (string, bool) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '"' {
+		return "", false
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", false
+	}
+	return s, true
+}
@@ -0,0 +1,89 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseByteSize(t *testing.T) {
+	testCases := []struct {
+		s        string
+		expected ByteSize
+		success  bool
+	}{
+		{"4096", 4096, true},
+		{"10 GiB", 10 * GiB, true},
+		{"10GiB", 10 * GiB, true},
+		{"10Gi", 10 * GiB, true},
+		{"10G", 10e9, true},
+		{"1.5 KiB", 1536, true},
+		{"2 kb", 2000, true},
+		{"", 0, false},
+		{"GiB", 0, false},
+		{"10 GB/s", 0, false},
+		{"1.2.3 B", 0, false},
+		{"1 EB", 1e18, true},
+		{"1e3", 0, false},
+		{"1e", 0, false},
+	}
+	for _, testCase := range testCases {
+		size, err := ParseByteSize(testCase.s)
+		if (err == nil) != testCase.success {
+			t.Errorf("%q: expected success %v, got %v", testCase.s, testCase.success, err)
+			continue
+		}
+		if size != testCase.expected {
+			t.Errorf("%q: expected %d, got %d", testCase.s, testCase.expected, size)
+		}
+	}
+	if s := (10 * GiB).String(); s != "10 GiB" {
+		t.Errorf("expected 10 GiB, got %s", s)
+	}
+}
+
+func TestUnitsJSON(t *testing.T) {
+	var v struct {
+		Size     ByteSize   `json:"size"`
+		Created  UnixMillis `json:"created"`
+		Duration Duration   `json:"duration"`
+	}
+	if err := json.Unmarshal([]byte(`{"size":"1 MiB","created":"2021-06-01T10:00:00Z","duration":"1m30s"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Size != MiB || v.Created.String() != "2021-06-01T10:00:00Z" || time.Duration(v.Duration) != 90*time.Second {
+		t.Fatalf("unexpected values %+v", v)
+	}
+
+	// Numbers are accepted and produced so the wire format is unchanged.
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"size":1048576,"created":1622541600000,"duration":90000000000}`; string(data) != expected {
+		t.Fatalf("expected %s, got %s", expected, data)
+	}
+	if err = json.Unmarshal(data, &v); err != nil || v.Size != MiB || v.Created != 1622541600000 {
+		t.Fatalf("unexpected round trip %+v: %v", v, err)
+	}
+
+	if err = json.Unmarshal([]byte(`{"size":"lots"}`), &v); err == nil {
+		t.Fatal("expected invalid size to be rejected")
+	}
+}
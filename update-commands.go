@@ -18,7 +18,6 @@ package madmin
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 	"net/url"
 )
@@ -51,7 +50,7 @@ func (adm *AdminClient) ServerUpdate(ctx context.Context, updateURL string) (us
 		return us, httpRespToErrorResponse(resp)
 	}
 
-	if err = json.NewDecoder(resp.Body).Decode(&us); err != nil {
+	if err = adm.decodeResponse(resp, &us); err != nil {
 		return us, err
 	}
 
@@ -69,7 +69,7 @@ func (adm *AdminClient) AccountInfo(ctx context.Context) (AccountInfo, error) {
 		return AccountInfo{}, err
 	}
 
-	err = json.Unmarshal(respBytes, &accountInfo)
+	err = adm.unmarshalResponse(resp, respBytes, &accountInfo)
 	if err != nil {
 		return AccountInfo{}, err
 	}
@@ -89,9 +89,9 @@ const (
 // UserInfo carries information about long term users.
 type UserInfo struct {
 	SecretKey  string        `json:"secretKey,omitempty"`
-	PolicyName string        `json:"policyName,omitempty"`
-	Status     AccountStatus `json:"status"`
-	MemberOf   []string      `json:"memberOf,omitempty"`
+	PolicyName string        `json:"policyName,omitempty" table:"POLICY"`
+	Status     AccountStatus `json:"status" table:"STATUS"`
+	MemberOf   []string      `json:"memberOf,omitempty" table:"GROUPS"`
 }
 
 // RemoveUser - remove a user.
@@ -143,7 +143,7 @@ func (adm *AdminClient) ListUsers(ctx context.Context) (map[string]UserInfo, err
 	}
 
 	var users = make(map[string]UserInfo)
-	if err = json.Unmarshal(data, &users); err != nil {
+	if err = adm.unmarshalResponse(resp, data, &users); err != nil {
 		return nil, err
 	}
 
@@ -177,7 +177,7 @@ func (adm *AdminClient) GetUserInfo(ctx context.Context, name string) (u UserInf
 		return u, err
 	}
 
-	if err = json.Unmarshal(b, &u); err != nil {
+	if err = adm.unmarshalResponse(resp, b, &u); err != nil {
 		return u, err
 	}
 
@@ -193,7 +193,7 @@ func (adm *AdminClient) SetUser(ctx context.Context, accessKey, secretKey string
 	if err != nil {
 		return err
 	}
-	econfigBytes, err := EncryptData(adm.getSecretKey(), data)
+	econfigBytes, err := adm.encryptData(data)
 	if err != nil {
 		return err
 	}
@@ -274,7 +274,7 @@ func (adm *AdminClient) AddServiceAccount(ctx context.Context, opts AddServiceAc
 		return Credentials{}, err
 	}
 
-	econfigBytes, err := EncryptData(adm.getSecretKey(), data)
+	econfigBytes, err := adm.encryptData(data)
 	if err != nil {
 		return Credentials{}, err
 	}
@@ -301,7 +301,7 @@ func (adm *AdminClient) AddServiceAccount(ctx context.Context, opts AddServiceAc
 	}
 
 	var serviceAccountResp AddServiceAccountResp
-	if err = json.Unmarshal(data, &serviceAccountResp); err != nil {
+	if err = adm.unmarshalResponse(resp, data, &serviceAccountResp); err != nil {
 		return Credentials{}, err
 	}
 	return serviceAccountResp.Credentials, nil
@@ -321,7 +321,7 @@ func (adm *AdminClient) UpdateServiceAccount(ctx context.Context, accessKey stri
 		return err
 	}
 
-	econfigBytes, err := EncryptData(adm.getSecretKey(), data)
+	econfigBytes, err := adm.encryptData(data)
 	if err != nil {
 		return err
 	}
@@ -381,7 +381,7 @@ func (adm *AdminClient) ListServiceAccounts(ctx context.Context, user string) (L
 	}
 
 	var listResp ListServiceAccountsResp
-	if err = json.Unmarshal(data, &listResp); err != nil {
+	if err = adm.unmarshalResponse(resp, data, &listResp); err != nil {
 		return ListServiceAccountsResp{}, err
 	}
 	return listResp, nil
@@ -422,7 +422,7 @@ func (adm *AdminClient) InfoServiceAccount(ctx context.Context, accessKey string
 	}
 
 	var infoResp InfoServiceAccountResp
-	if err = json.Unmarshal(data, &infoResp); err != nil {
+	if err = adm.unmarshalResponse(resp, data, &infoResp); err != nil {
 		return InfoServiceAccountResp{}, err
 	}
 	return infoResp, nil
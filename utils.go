@@ -33,6 +33,7 @@ const (
 	AdminAPIVersion   = "v3"
 	AdminAPIVersionV2 = "v2"
 	adminAPIPrefix    = "/" + AdminAPIVersion
+	adminAPIPrefixV2  = "/" + AdminAPIVersionV2
 )
 
 // jsonDecoder decode json to go type.
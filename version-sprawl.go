@@ -0,0 +1,186 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// VersionSprawlOpts - thresholds for AnalyzeVersionSprawl, zero values
+// select the defaults.
+type VersionSprawlOpts struct {
+	// MinNoncurrentRatio of noncurrent versions per current object
+	// for a bucket to be reported, defaults to 2.
+	MinNoncurrentRatio float64
+	// MinDeleteMarkers for a bucket to be reported, defaults to 10000.
+	MinDeleteMarkers uint64
+	// Top limits the number of reported buckets, defaults to 10.
+	Top int
+	// NoncurrentDays used in the suggested lifecycle rules, defaults to 30.
+	NoncurrentDays int
+}
+
+func (o VersionSprawlOpts) withDefaults() VersionSprawlOpts {
+	if o.MinNoncurrentRatio <= 0 {
+		o.MinNoncurrentRatio = 2
+	}
+	if o.MinDeleteMarkers == 0 {
+		o.MinDeleteMarkers = 10000
+	}
+	if o.Top <= 0 {
+		o.Top = 10
+	}
+	if o.NoncurrentDays <= 0 {
+		o.NoncurrentDays = 30
+	}
+	return o
+}
+
+// BucketVersionSprawl - version statistics of a reported bucket.
+type BucketVersionSprawl struct {
+	Bucket             string  `json:"bucket"`
+	Objects            uint64  `json:"objects"`
+	NoncurrentVersions uint64  `json:"noncurrentVersions"`
+	DeleteMarkers      uint64  `json:"deleteMarkers"`
+	NoncurrentBytes    uint64  `json:"noncurrentBytes"`
+	NoncurrentRatio    float64 `json:"noncurrentRatio"`
+	// SuggestedLifecycle is an ILM document expiring noncurrent
+	// versions and expired delete markers, ready to be applied with
+	// `mc ilm import`.
+	SuggestedLifecycle json.RawMessage `json:"suggestedLifecycle"`
+}
+
+// VersionSprawlReport - buckets with excessive noncurrent versions or
+// delete markers, largest noncurrent bytes first.
+type VersionSprawlReport struct {
+	Time            time.Time             `json:"time"`
+	NoncurrentBytes uint64                `json:"noncurrentBytes"`
+	DeleteMarkers   uint64                `json:"deleteMarkers"`
+	Offenders       []BucketVersionSprawl `json:"offenders"`
+}
+
+type lifecycleFilter struct {
+	Prefix string `json:"Prefix"`
+}
+
+type lifecycleNoncurrentExpiration struct {
+	NoncurrentDays int `json:"NoncurrentDays"`
+}
+
+type lifecycleExpiration struct {
+	ExpiredObjectDeleteMarker bool `json:"ExpiredObjectDeleteMarker"`
+}
+
+type lifecycleRule struct {
+	ID                          string                         `json:"ID"`
+	Status                      string                         `json:"Status"`
+	Filter                      lifecycleFilter                `json:"Filter"`
+	NoncurrentVersionExpiration *lifecycleNoncurrentExpiration `json:"NoncurrentVersionExpiration,omitempty"`
+	Expiration                  *lifecycleExpiration           `json:"Expiration,omitempty"`
+}
+
+type lifecycleConfig struct {
+	Rules []lifecycleRule `json:"Rules"`
+}
+
+// SuggestLifecycle - returns an ILM JSON document expiring noncurrent
+// versions after noncurrentDays and removing expired delete markers.
+func SuggestLifecycle(s BucketVersionSprawl, noncurrentDays int) ([]byte, error) {
+	if noncurrentDays <= 0 {
+		return nil, ErrInvalidArgument("Noncurrent days must be positive.")
+	}
+	var cfg lifecycleConfig
+	if s.NoncurrentVersions > 0 {
+		cfg.Rules = append(cfg.Rules, lifecycleRule{
+			ID:                          "expire-noncurrent-versions",
+			Status:                      "Enabled",
+			NoncurrentVersionExpiration: &lifecycleNoncurrentExpiration{NoncurrentDays: noncurrentDays},
+		})
+	}
+	if s.DeleteMarkers > 0 {
+		cfg.Rules = append(cfg.Rules, lifecycleRule{
+			ID:         "remove-expired-delete-markers",
+			Status:     "Enabled",
+			Expiration: &lifecycleExpiration{ExpiredObjectDeleteMarker: true},
+		})
+	}
+	return json.Marshal(cfg)
+}
+
+// AnalyzeVersionSprawl - finds buckets with excessive noncurrent versions
+// or delete markers in data usage info. Buckets are only reported if
+// the server includes version statistics in its data usage.
+func AnalyzeVersionSprawl(usage DataUsageInfo, opts VersionSprawlOpts) (VersionSprawlReport, error) {
+	opts = opts.withDefaults()
+	report := VersionSprawlReport{Time: usage.LastUpdate}
+	for bucket, u := range usage.BucketsUsage {
+		s := BucketVersionSprawl{
+			Bucket:          bucket,
+			Objects:         u.ObjectsCount,
+			DeleteMarkers:   u.DeleteMarkersCount,
+			NoncurrentBytes: u.NoncurrentVersionsSize,
+		}
+		if u.VersionsCount > u.ObjectsCount {
+			s.NoncurrentVersions = u.VersionsCount - u.ObjectsCount
+		}
+		if s.Objects > 0 {
+			s.NoncurrentRatio = float64(s.NoncurrentVersions) / float64(s.Objects)
+		} else {
+			s.NoncurrentRatio = float64(s.NoncurrentVersions)
+		}
+		report.NoncurrentBytes += s.NoncurrentBytes
+		report.DeleteMarkers += s.DeleteMarkers
+
+		if s.NoncurrentRatio < opts.MinNoncurrentRatio && s.DeleteMarkers < opts.MinDeleteMarkers {
+			continue
+		}
+		ilm, err := SuggestLifecycle(s, opts.NoncurrentDays)
+		if err != nil {
+			return VersionSprawlReport{}, err
+		}
+		s.SuggestedLifecycle = ilm
+		report.Offenders = append(report.Offenders, s)
+	}
+
+	sort.Slice(report.Offenders, func(i, j int) bool {
+		a, b := report.Offenders[i], report.Offenders[j]
+		if a.NoncurrentBytes != b.NoncurrentBytes {
+			return a.NoncurrentBytes > b.NoncurrentBytes
+		}
+		if a.DeleteMarkers != b.DeleteMarkers {
+			return a.DeleteMarkers > b.DeleteMarkers
+		}
+		return a.Bucket < b.Bucket
+	})
+	if len(report.Offenders) > opts.Top {
+		report.Offenders = report.Offenders[:opts.Top]
+	}
+	return report, nil
+}
+
+// VersionSprawl - analyzes the current data usage of the cluster for
+// version sprawl, see AnalyzeVersionSprawl.
+func (adm *AdminClient) VersionSprawl(ctx context.Context, opts VersionSprawlOpts) (VersionSprawlReport, error) {
+	usage, err := adm.DataUsageInfo(ctx)
+	if err != nil {
+		return VersionSprawlReport{}, err
+	}
+	return AnalyzeVersionSprawl(usage, opts)
+}
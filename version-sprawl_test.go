@@ -0,0 +1,61 @@
+//
+// MinIO Object Storage (c) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package madmin
+
+import "testing"
+
+func TestAnalyzeVersionSprawl(t *testing.T) {
+	usage := DataUsageInfo{
+		BucketsUsage: map[string]BucketUsageInfo{
+			"healthy":  {ObjectsCount: 100, VersionsCount: 120, NoncurrentVersionsSize: 1 << 20},
+			"versions": {ObjectsCount: 10, VersionsCount: 100, NoncurrentVersionsSize: 1 << 30},
+			"markers":  {ObjectsCount: 10, VersionsCount: 10, DeleteMarkersCount: 50000},
+			"empty":    {VersionsCount: 5, NoncurrentVersionsSize: 1 << 10},
+		},
+	}
+	report, err := AnalyzeVersionSprawl(usage, VersionSprawlOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.DeleteMarkers != 50000 || report.NoncurrentBytes != 1<<30+1<<20+1<<10 {
+		t.Errorf("unexpected totals %+v", report)
+	}
+	var buckets []string
+	for _, o := range report.Offenders {
+		buckets = append(buckets, o.Bucket)
+	}
+	if len(buckets) != 3 || buckets[0] != "versions" || buckets[1] != "empty" || buckets[2] != "markers" {
+		t.Fatalf("unexpected offenders %v", buckets)
+	}
+	if report.Offenders[0].NoncurrentVersions != 90 || report.Offenders[0].NoncurrentRatio != 9 {
+		t.Errorf("unexpected stats %+v", report.Offenders[0])
+	}
+
+	expected := `{"Rules":[{"ID":"expire-noncurrent-versions","Status":"Enabled","Filter":{"Prefix":""},"NoncurrentVersionExpiration":{"NoncurrentDays":30}}]}`
+	if ilm := string(report.Offenders[0].SuggestedLifecycle); ilm != expected {
+		t.Errorf("unexpected lifecycle %s", ilm)
+	}
+	expected = `{"Rules":[{"ID":"remove-expired-delete-markers","Status":"Enabled","Filter":{"Prefix":""},"Expiration":{"ExpiredObjectDeleteMarker":true}}]}`
+	if ilm := string(report.Offenders[2].SuggestedLifecycle); ilm != expected {
+		t.Errorf("unexpected lifecycle %s", ilm)
+	}
+
+	report, err = AnalyzeVersionSprawl(usage, VersionSprawlOpts{Top: 1})
+	if err != nil || len(report.Offenders) != 1 {
+		t.Fatalf("expected a single offender, got %v %v", report.Offenders, err)
+	}
+}